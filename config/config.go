@@ -12,6 +12,11 @@ import (
 var Database *DatabaseConfig
 var Server *ServerConfig
 var Auth *AuthConfig
+var Mail *MailConfig
+var Password *PasswordConfig
+var Login *LoginConfig
+var Search *SearchConfig
+var Events *EventsConfig
 
 type DatabaseConfig struct {
 	Driver   string
@@ -32,8 +37,58 @@ type ServerConfig struct {
 }
 
 type AuthConfig struct {
-	SecretKey     string
-	TokenDuration time.Duration
+	SecretKey                  string
+	TokenDuration              time.Duration
+	RefreshTokenDuration       time.Duration
+	RevocationRefreshInterval  time.Duration
+	VerificationTokenDuration  time.Duration
+	PasswordResetTokenDuration time.Duration
+	MFAChallengeDuration       time.Duration
+	TOTPIssuer                 string
+	RequireAdminMFA            bool
+	KeyDir                     string
+	SigningKeyID               string
+	KeyRefreshInterval         time.Duration
+	PermissionRefreshInterval  time.Duration
+}
+
+type PasswordConfig struct {
+	MinLength        int
+	MaxLength        int
+	RequireUpper     bool
+	RequireLower     bool
+	RequireDigit     bool
+	RequireSymbol    bool
+	BlocklistFile    string
+	DisallowIdentity bool
+}
+
+type LoginConfig struct {
+	MaxAttempts      int
+	MaxAttemptsPerIP int
+	AttemptWindow    time.Duration
+	LockoutBase      time.Duration
+	LockoutMax       time.Duration
+}
+
+// SearchConfig selects the full-text search. The memory driver keeps an index
+// in the process, rebuilt every RefreshInterval.
+type SearchConfig struct {
+	Driver          string
+	RefreshInterval time.Duration
+}
+
+// EventsConfig selects how the events of questions reach their watchers. The
+// memory driver only reaches watchers of the same instance, the postgres driver
+// relays them with LISTEN/NOTIFY to every instance.
+type EventsConfig struct {
+	Driver string
+}
+
+type MailConfig struct {
+	Driver   string
+	FilePath string
+	From     string
 }
 
 func init() {
@@ -62,8 +117,53 @@ func Load() {
 	}
 	
 	Auth = &AuthConfig{
-		SecretKey:     getEnvAsString("AUTH_SECRET_KEY", "secret"),
-		TokenDuration: time.Duration(getEnvAsInt("AUTH_TOKEN_DURATION", 900)),
+		SecretKey:                  getEnvAsString("AUTH_SECRET_KEY", ""),
+		TokenDuration:              time.Duration(getEnvAsInt("AUTH_TOKEN_DURATION", 900)) * time.Second,
+		RefreshTokenDuration:       time.Duration(getEnvAsInt("AUTH_REFRESH_TOKEN_DURATION", 2592000)) * time.Second,
+		RevocationRefreshInterval:  time.Duration(getEnvAsInt("AUTH_REVOCATION_REFRESH_INTERVAL", 30)) * time.Second,
+		VerificationTokenDuration:  time.Duration(getEnvAsInt("AUTH_VERIFICATION_TOKEN_DURATION", 86400)) * time.Second,
+		PasswordResetTokenDuration: time.Duration(getEnvAsInt("AUTH_PASSWORD_RESET_TOKEN_DURATION", 3600)) * time.Second,
+		MFAChallengeDuration:       time.Duration(getEnvAsInt("AUTH_MFA_CHALLENGE_DURATION", 300)) * time.Second,
+		TOTPIssuer:                 getEnvAsString("AUTH_TOTP_ISSUER", "project-qa"),
+		RequireAdminMFA:            getEnvAsBool("AUTH_REQUIRE_ADMIN_MFA", false),
+		KeyDir:                     getEnvAsString("AUTH_KEY_DIR", ""),
+		SigningKeyID:               getEnvAsString("AUTH_SIGNING_KEY_ID", ""),
+		KeyRefreshInterval:         time.Duration(getEnvAsInt("AUTH_KEY_REFRESH_INTERVAL", 60)) * time.Second,
+		PermissionRefreshInterval:  time.Duration(getEnvAsInt("AUTH_PERMISSION_REFRESH_INTERVAL", 30)) * time.Second,
+	}
+	
+	Password = &PasswordConfig{
+		MinLength:        getEnvAsInt("PASSWORD_MIN_LENGTH", 8),
+		MaxLength:        getEnvAsInt("PASSWORD_MAX_LENGTH", 72),
+		RequireUpper:     getEnvAsBool("PASSWORD_REQUIRE_UPPER", false),
+		RequireLower:     getEnvAsBool("PASSWORD_REQUIRE_LOWER", false),
+		RequireDigit:     getEnvAsBool("PASSWORD_REQUIRE_DIGIT", false),
+		RequireSymbol:    getEnvAsBool("PASSWORD_REQUIRE_SYMBOL", false),
+		BlocklistFile:    getEnvAsString("PASSWORD_BLOCKLIST_FILE", ""),
+		DisallowIdentity: getEnvAsBool("PASSWORD_DISALLOW_IDENTITY", true),
+	}
+	
+	Login = &LoginConfig{
+		MaxAttempts:      getEnvAsInt("LOGIN_MAX_ATTEMPTS", 5),
+		MaxAttemptsPerIP: getEnvAsInt("LOGIN_MAX_ATTEMPTS_PER_IP", 20),
+		AttemptWindow:    time.Duration(getEnvAsInt("LOGIN_ATTEMPT_WINDOW", 900)) * time.Second,
+		LockoutBase:      time.Duration(getEnvAsInt("LOGIN_LOCKOUT_BASE", 30)) * time.Second,
+		LockoutMax:       time.Duration(getEnvAsInt("LOGIN_LOCKOUT_MAX", 3600)) * time.Second,
+	}
+	
+	Search = &SearchConfig{
+		Driver:          getEnvAsString("SEARCH_DRIVER", "postgres"),
+		RefreshInterval: time.Duration(getEnvAsInt("SEARCH_REFRESH_INTERVAL", 60)) * time.Second,
+	}
+	
+	Events = &EventsConfig{
+		Driver: getEnvAsString("EVENTS_DRIVER", "memory"),
+	}
+	
+	Mail = &MailConfig{
+		Driver:   getEnvAsString("MAIL_DRIVER", "stdout"),
+		FilePath: getEnvAsString("MAIL_FILE_PATH", "mail.log"),
+		From:     getEnvAsString("MAIL_FROM", "no-reply@localhost"),
 	}
 }
 
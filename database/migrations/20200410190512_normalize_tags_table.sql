@@ -0,0 +1,58 @@
+-- +goose Up
+-- SQL in this section is executed when the migration is applied.
+ALTER TABLE tags RENAME TO legacy_tags;
+DROP INDEX IF EXISTS idx_tags_name;
+
+CREATE TABLE IF NOT EXISTS tags
+(
+    id         serial             not null,
+    name       varchar(40) unique not null,
+    created_at timestamp default current_timestamp,
+
+    primary key (id),
+    check (name = lower(name) and length(name) > 0)
+);
+
+CREATE TABLE IF NOT EXISTS question_tags
+(
+    question_id int not null,
+    tag_id      int not null,
+
+    primary key (question_id, tag_id),
+    foreign key (question_id) references questions (id) on delete cascade,
+    foreign key (tag_id) references tags (id) on delete cascade
+);
+
+CREATE INDEX idx_question_tags_tag_id ON question_tags (tag_id);
+
+INSERT INTO tags (name)
+SELECT DISTINCT lower(trim(name))
+FROM legacy_tags
+WHERE trim(coalesce(name, '')) <> '';
+
+INSERT INTO question_tags (question_id, tag_id)
+SELECT DISTINCT l.questions_id, t.id
+FROM legacy_tags l
+         INNER JOIN tags t ON t.name = lower(trim(l.name))
+         INNER JOIN questions q ON q.id = l.questions_id;
+
+DROP TABLE legacy_tags;
+
+-- +goose Down
+-- SQL in this section is executed when the migration is rolled back.
+CREATE TABLE IF NOT EXISTS legacy_tags
+(
+    questions_id int not null,
+    name         varchar(40)
+);
+
+INSERT INTO legacy_tags (questions_id, name)
+SELECT qt.question_id, t.name
+FROM question_tags qt
+         INNER JOIN tags t ON t.id = qt.tag_id;
+
+DROP TABLE IF EXISTS question_tags;
+DROP TABLE IF EXISTS tags;
+
+ALTER TABLE legacy_tags RENAME TO tags;
+CREATE INDEX idx_tags_name ON tags (name);
@@ -0,0 +1,11 @@
+-- +goose Up
+-- SQL in this section is executed when the migration is applied.
+CREATE INDEX idx_users_created_at_id ON users (created_at, id);
+CREATE INDEX idx_users_username_pattern ON users (username varchar_pattern_ops);
+CREATE INDEX idx_users_email_pattern ON users (email varchar_pattern_ops);
+
+-- +goose Down
+-- SQL in this section is executed when the migration is rolled back.
+DROP INDEX IF EXISTS idx_users_email_pattern;
+DROP INDEX IF EXISTS idx_users_username_pattern;
+DROP INDEX IF EXISTS idx_users_created_at_id;
@@ -0,0 +1,22 @@
+-- +goose Up
+-- SQL in this section is executed when the migration is applied.
+CREATE TABLE IF NOT EXISTS refresh_tokens
+(
+    id         serial             not null,
+    user_id    int                not null,
+    family_id  varchar(64)        not null,
+    token_hash varchar(64) unique not null,
+    expires_at timestamp          not null,
+    used_at    timestamp          null,
+    revoked_at timestamp          null,
+    created_at timestamp default current_timestamp,
+
+    primary key (id),
+    foreign key (user_id) references users (id) on delete cascade
+);
+
+CREATE INDEX idx_refresh_tokens_family_id ON refresh_tokens (family_id);
+
+-- +goose Down
+-- SQL in this section is executed when the migration is rolled back.
+DROP TABLE IF EXISTS refresh_tokens;
@@ -0,0 +1,27 @@
+-- +goose Up
+-- SQL in this section is executed when the migration is applied.
+CREATE TABLE IF NOT EXISTS revoked_tokens
+(
+    jti        varchar(64) not null,
+    username   varchar(20) not null,
+    expires_at timestamp   not null,
+    revoked_at timestamp default current_timestamp,
+
+    primary key (jti)
+);
+
+CREATE INDEX idx_revoked_tokens_expires_at ON revoked_tokens (expires_at);
+
+-- Every token of the user issued at or before revoked_before is invalid.
+CREATE TABLE IF NOT EXISTS user_token_revocations
+(
+    username       varchar(20) not null,
+    revoked_before timestamp   not null,
+
+    primary key (username)
+);
+
+-- +goose Down
+-- SQL in this section is executed when the migration is rolled back.
+DROP TABLE IF EXISTS user_token_revocations;
+DROP TABLE IF EXISTS revoked_tokens;
@@ -0,0 +1,10 @@
+-- +goose Up
+-- SQL in this section is executed when the migration is applied.
+ALTER TABLE users ADD COLUMN is_email_verified boolean not null default false;
+
+-- users created before self-service registration were created by admins
+UPDATE users SET is_email_verified = true;
+
+-- +goose Down
+-- SQL in this section is executed when the migration is rolled back.
+ALTER TABLE users DROP COLUMN IF EXISTS is_email_verified;
@@ -0,0 +1,20 @@
+-- +goose Up
+-- SQL in this section is executed when the migration is applied.
+CREATE TABLE IF NOT EXISTS password_reset_tokens
+(
+    id         serial             not null,
+    user_id    int                not null,
+    token_hash varchar(64) unique not null,
+    expires_at timestamp          not null,
+    used_at    timestamp          null,
+    created_at timestamp default current_timestamp,
+
+    primary key (id),
+    foreign key (user_id) references users (id) on delete cascade
+);
+
+CREATE INDEX idx_password_reset_tokens_user_id ON password_reset_tokens (user_id);
+
+-- +goose Down
+-- SQL in this section is executed when the migration is rolled back.
+DROP TABLE IF EXISTS password_reset_tokens;
@@ -0,0 +1,16 @@
+-- +goose Up
+-- SQL in this section is executed when the migration is applied.
+-- Failed logins are tracked per username ("user:<username>") and per peer address ("ip:<address>").
+CREATE TABLE IF NOT EXISTS login_failures
+(
+    key             varchar(128) not null,
+    failures        int          not null default 0,
+    last_failure_at timestamp    not null,
+    locked_until    timestamp    null,
+
+    primary key (key)
+);
+
+-- +goose Down
+-- SQL in this section is executed when the migration is rolled back.
+DROP TABLE IF EXISTS login_failures;
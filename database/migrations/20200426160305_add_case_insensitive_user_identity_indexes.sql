@@ -0,0 +1,19 @@
+-- +goose Up
+-- SQL in this section is executed when the migration is applied.
+-- Fails when two users only differ in the case of their username or email,
+-- those have to be merged or renamed by hand first.
+UPDATE users SET email = lower(trim(email)) WHERE email <> lower(trim(email));
+
+CREATE UNIQUE INDEX idx_users_username_lower ON users (lower(username));
+CREATE UNIQUE INDEX idx_users_email_lower ON users (lower(email));
+
+DROP INDEX IF EXISTS idx_users_username_pattern;
+CREATE INDEX idx_users_username_lower_pattern ON users (lower(username) varchar_pattern_ops);
+
+-- +goose Down
+-- SQL in this section is executed when the migration is rolled back.
+DROP INDEX IF EXISTS idx_users_username_lower_pattern;
+CREATE INDEX idx_users_username_pattern ON users (username varchar_pattern_ops);
+
+DROP INDEX IF EXISTS idx_users_email_lower;
+DROP INDEX IF EXISTS idx_users_username_lower;
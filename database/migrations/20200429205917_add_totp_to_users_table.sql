@@ -0,0 +1,31 @@
+-- +goose Up
+-- SQL in this section is executed when the migration is applied.
+ALTER TABLE users
+    ADD COLUMN totp_secret    varchar(64) null,
+    ADD COLUMN totp_enabled   boolean     not null default false,
+    ADD COLUMN totp_last_step bigint      not null default 0;
+
+CREATE TABLE IF NOT EXISTS recovery_codes
+(
+    id         serial      not null,
+    user_id    int         not null,
+    code_hash  varchar(64) not null,
+    used_at    timestamp   null,
+    created_at timestamp default current_timestamp,
+
+    primary key (id),
+    unique (user_id, code_hash),
+    foreign key (user_id) references users (id) on delete cascade
+);
+
+-- refreshed access tokens keep the second factor of the login they descend from
+ALTER TABLE refresh_tokens ADD COLUMN mfa boolean not null default false;
+
+-- +goose Down
+-- SQL in this section is executed when the migration is rolled back.
+ALTER TABLE refresh_tokens DROP COLUMN IF EXISTS mfa;
+DROP TABLE IF EXISTS recovery_codes;
+ALTER TABLE users
+    DROP COLUMN IF EXISTS totp_last_step,
+    DROP COLUMN IF EXISTS totp_enabled,
+    DROP COLUMN IF EXISTS totp_secret;
@@ -0,0 +1,126 @@
+-- +goose Up
+-- SQL in this section is executed when the migration is applied.
+CREATE TABLE IF NOT EXISTS roles
+(
+    id          serial       not null,
+    name        varchar(50)  not null,
+    description varchar(255) not null default '',
+    created_at  timestamp default current_timestamp,
+
+    primary key (id),
+    unique (name)
+);
+
+-- Permissions are checked by the code, so they are only added by migrations.
+CREATE TABLE IF NOT EXISTS permissions
+(
+    id          serial       not null,
+    name        varchar(100) not null,
+    description varchar(255) not null default '',
+
+    primary key (id),
+    unique (name)
+);
+
+CREATE TABLE IF NOT EXISTS role_permissions
+(
+    role_id       int not null,
+    permission_id int not null,
+
+    primary key (role_id, permission_id),
+    foreign key (role_id) references roles (id) on delete cascade,
+    foreign key (permission_id) references permissions (id) on delete cascade
+);
+
+CREATE TABLE IF NOT EXISTS user_roles
+(
+    user_id int not null,
+    role_id int not null,
+
+    primary key (user_id, role_id),
+    foreign key (user_id) references users (id) on delete cascade,
+    foreign key (role_id) references roles (id) on delete cascade
+);
+CREATE INDEX IF NOT EXISTS user_roles_role_id_index ON user_roles (role_id);
+
+-- The permission required to call an RPC, by full method name. RPCs without a
+-- row can be called without an access token.
+CREATE TABLE IF NOT EXISTS method_permissions
+(
+    method        varchar(255) not null,
+    permission_id int          not null,
+
+    primary key (method),
+    foreign key (permission_id) references permissions (id) on delete cascade
+);
+
+INSERT INTO roles (name, description)
+VALUES ('user', 'Every registered user'),
+       ('admin', 'Administrators');
+
+INSERT INTO permissions (name, description)
+VALUES ('account.manage', 'Manage the own account and sessions'),
+       ('users.manage', 'Manage the accounts and sessions of all users'),
+       ('roles.manage', 'Manage roles and their assignment to users'),
+       ('questions.write', 'Ask questions and manage the own questions'),
+       ('questions.moderate', 'Manage the questions of all users'),
+       ('answers.write', 'Answer questions and manage the own answers'),
+       ('answers.moderate', 'Manage the answers of all users');
+
+INSERT INTO role_permissions (role_id, permission_id)
+SELECT r.id, p.id
+FROM roles r,
+     permissions p
+WHERE r.name = 'admin'
+   OR (r.name = 'user' AND p.name IN ('account.manage', 'questions.write', 'answers.write'));
+
+INSERT INTO user_roles (user_id, role_id)
+SELECT u.id, r.id
+FROM users u,
+     roles r
+WHERE r.name = 'user'
+   OR (r.name = 'admin' AND u.is_admin);
+
+INSERT INTO method_permissions (method, permission_id)
+SELECT m.method, p.id
+FROM (VALUES ('/ranabd36.qaengine.AuthService/Logout', 'account.manage'),
+             ('/ranabd36.qaengine.AuthService/RevokeUserSessions', 'users.manage'),
+             ('/ranabd36.qaengine.AuthService/UnlockUser', 'users.manage'),
+             ('/ranabd36.qaengine.AuthService/BeginTOTPEnrollment', 'account.manage'),
+             ('/ranabd36.qaengine.AuthService/ConfirmTOTP', 'account.manage'),
+             ('/ranabd36.qaengine.UserServiceServer/FindUser', 'account.manage'),
+             ('/ranabd36.qaengine.UserServiceServer/UpdateUser', 'account.manage'),
+             ('/ranabd36.qaengine.UserServiceServer/ChangePassword', 'account.manage'),
+             ('/ranabd36.qaengine.UserServiceServer/DeleteUser', 'users.manage'),
+             ('/ranabd36.qaengine.UserServiceServer/ToggleAdmin', 'roles.manage'),
+             ('/ranabd36.qaengine.UserServiceServer/ToggleActive', 'users.manage'),
+             ('/ranabd36.qaengine.UserServiceServer/CreateUser', 'users.manage'),
+             ('/ranabd36.qaengine.UserServiceServer/ListUsers', 'users.manage'),
+             ('/ranabd36.qaengine.QuestionService/CreateQuestion', 'questions.write'),
+             ('/ranabd36.qaengine.QuestionService/GetQuestion', 'questions.write'),
+             ('/ranabd36.qaengine.QuestionService/UpdateQuestion', 'questions.write'),
+             ('/ranabd36.qaengine.QuestionService/DeleteQuestion', 'questions.write'),
+             ('/ranabd36.qaengine.QuestionService/PublishQuestion', 'questions.write'),
+             ('/ranabd36.qaengine.QuestionService/UnpublishQuestion', 'questions.write'),
+             ('/ranabd36.qaengine.AnswerService/PostAnswer', 'answers.write'),
+             ('/ranabd36.qaengine.AnswerService/PostReply', 'answers.write'),
+             ('/ranabd36.qaengine.AnswerService/ListAnswers', 'answers.write'),
+             ('/ranabd36.qaengine.AnswerService/UpdateAnswer', 'answers.write'),
+             ('/ranabd36.qaengine.AnswerService/DeleteAnswer', 'answers.write'),
+             ('/ranabd36.qaengine.AnswerService/AcceptAnswer', 'answers.write'),
+             ('/ranabd36.qaengine.RoleService/ListRoles', 'roles.manage'),
+             ('/ranabd36.qaengine.RoleService/ListPermissions', 'roles.manage'),
+             ('/ranabd36.qaengine.RoleService/CreateRole', 'roles.manage'),
+             ('/ranabd36.qaengine.RoleService/UpdateRole', 'roles.manage'),
+             ('/ranabd36.qaengine.RoleService/DeleteRole', 'roles.manage'),
+             ('/ranabd36.qaengine.RoleService/AssignRole', 'roles.manage'),
+             ('/ranabd36.qaengine.RoleService/UnassignRole', 'roles.manage')) AS m (method, permission)
+         INNER JOIN permissions p ON p.name = m.permission;
+
+-- +goose Down
+-- SQL in this section is executed when the migration is rolled back.
+DROP TABLE IF EXISTS method_permissions;
+DROP TABLE IF EXISTS user_roles;
+DROP TABLE IF EXISTS role_permissions;
+DROP TABLE IF EXISTS permissions;
+DROP TABLE IF EXISTS roles;
@@ -0,0 +1,15 @@
+-- +goose Up
+-- SQL in this section is executed when the migration is applied.
+-- The permission required by each RPC is declared by the auth option of the proto method.
+DROP TABLE IF EXISTS method_permissions;
+
+-- +goose Down
+-- SQL in this section is executed when the migration is rolled back.
+CREATE TABLE IF NOT EXISTS method_permissions
+(
+    method        varchar(255) not null,
+    permission_id int          not null,
+
+    primary key (method),
+    foreign key (permission_id) references permissions (id) on delete cascade
+);
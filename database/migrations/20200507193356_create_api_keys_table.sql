@@ -0,0 +1,28 @@
+-- +goose Up
+-- SQL in this section is executed when the migration is applied.
+-- Service accounts are users for machine clients, they authenticate with API keys only.
+ALTER TABLE users ADD COLUMN is_service_account boolean not null default false;
+
+CREATE TABLE IF NOT EXISTS api_keys
+(
+    id           serial         not null,
+    user_id      int            not null,
+    name         varchar(100)   not null,
+    prefix       varchar(16)    not null,
+    key_hash     varchar(64)    not null,
+    scopes       varchar(100)[] not null,
+    expires_at   timestamp      null,
+    last_used_at timestamp      null,
+    revoked_at   timestamp      null,
+    created_at   timestamp      default current_timestamp,
+
+    primary key (id),
+    unique (key_hash),
+    foreign key (user_id) references users (id) on delete cascade
+);
+CREATE INDEX IF NOT EXISTS api_keys_user_id_index ON api_keys (user_id);
+
+-- +goose Down
+-- SQL in this section is executed when the migration is rolled back.
+DROP TABLE IF EXISTS api_keys;
+ALTER TABLE users DROP COLUMN IF EXISTS is_service_account;
@@ -0,0 +1,54 @@
+-- +goose Up
+-- SQL in this section is executed when the migration is applied.
+-- Actor and target IDs are no foreign keys, the trail of deleted users is kept.
+CREATE TABLE IF NOT EXISTS audit_events
+(
+    id             bigserial    not null,
+    occurred_at    timestamp    not null default current_timestamp,
+    actor_id       int          null,
+    actor_username varchar(20)  null,
+    action         varchar(255) not null,
+    target_type    varchar(50)  null,
+    target_id      varchar(50)  null,
+    outcome        varchar(50)  not null,
+    peer_address   varchar(64)  null,
+    request_id     varchar(64)  null,
+    before_value   jsonb        null,
+    after_value    jsonb        null,
+
+    primary key (id)
+);
+CREATE INDEX IF NOT EXISTS audit_events_actor_id_index ON audit_events (actor_id, id);
+CREATE INDEX IF NOT EXISTS audit_events_target_index ON audit_events (target_type, target_id, id);
+CREATE INDEX IF NOT EXISTS audit_events_occurred_at_index ON audit_events (occurred_at);
+
+-- +goose StatementBegin
+CREATE OR REPLACE FUNCTION reject_audit_event_change() RETURNS trigger AS
+$$
+BEGIN
+    RAISE EXCEPTION 'audit events are append-only';
+END;
+$$ LANGUAGE plpgsql;
+-- +goose StatementEnd
+
+CREATE TRIGGER audit_events_append_only
+    BEFORE UPDATE OR DELETE OR TRUNCATE
+    ON audit_events
+    FOR EACH STATEMENT
+EXECUTE PROCEDURE reject_audit_event_change();
+
+INSERT INTO permissions (name, description)
+VALUES ('audit.read', 'Read the audit log');
+
+INSERT INTO role_permissions (role_id, permission_id)
+SELECT r.id, p.id
+FROM roles r,
+     permissions p
+WHERE r.name = 'admin'
+  AND p.name = 'audit.read';
+
+-- +goose Down
+-- SQL in this section is executed when the migration is rolled back.
+DELETE FROM permissions WHERE name = 'audit.read';
+DROP TABLE IF EXISTS audit_events;
+DROP FUNCTION IF EXISTS reject_audit_event_change();
@@ -0,0 +1,19 @@
+-- +goose Up
+-- SQL in this section is executed when the migration is applied.
+-- Deleted users keep their rows, so the questions and answers they authored
+-- stay attributed to them. Purged users are deleted users whose personal data
+-- was anonymized, they cannot be restored.
+ALTER TABLE users
+    ADD COLUMN deleted_at timestamp null,
+    ADD COLUMN purged_at  timestamp null;
+
+CREATE INDEX IF NOT EXISTS idx_users_deleted_at ON users (deleted_at) WHERE deleted_at IS NOT NULL;
+
+-- +goose Down
+-- SQL in this section is executed when the migration is rolled back.
+-- Fails while there are questions or answers of deleted users.
+DELETE FROM users WHERE deleted_at IS NOT NULL;
+DROP INDEX IF EXISTS idx_users_deleted_at;
+ALTER TABLE users
+    DROP COLUMN IF EXISTS purged_at,
+    DROP COLUMN IF EXISTS deleted_at;
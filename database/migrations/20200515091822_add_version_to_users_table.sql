@@ -0,0 +1,32 @@
+-- +goose Up
+-- SQL in this section is executed when the migration is applied.
+-- The version is bumped, and update_at set, whenever the profile, the flags or
+-- the deletion of a user change. Clients send it back to detect concurrent
+-- changes. Sign-in bookkeeping like totp_last_step does not count.
+ALTER TABLE users ADD COLUMN version int not null default 1;
+
+-- +goose StatementBegin
+CREATE OR REPLACE FUNCTION bump_user_version() RETURNS trigger AS
+$$
+BEGIN
+    NEW.version = OLD.version + 1;
+    NEW.update_at = current_timestamp;
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+-- +goose StatementEnd
+
+CREATE TRIGGER users_bump_version
+    BEFORE UPDATE
+    ON users
+    FOR EACH ROW
+    WHEN ((OLD.first_name, OLD.last_name, OLD.username, OLD.email, OLD.is_active, OLD.is_admin, OLD.deleted_at)
+        IS DISTINCT FROM
+          (NEW.first_name, NEW.last_name, NEW.username, NEW.email, NEW.is_active, NEW.is_admin, NEW.deleted_at))
+EXECUTE PROCEDURE bump_user_version();
+
+-- +goose Down
+-- SQL in this section is executed when the migration is rolled back.
+DROP TRIGGER IF EXISTS users_bump_version ON users;
+DROP FUNCTION IF EXISTS bump_user_version();
+ALTER TABLE users DROP COLUMN IF EXISTS version;
@@ -0,0 +1,58 @@
+-- +goose Up
+-- SQL in this section is executed when the migration is applied.
+-- Question titles weigh more than descriptions and answers.
+ALTER TABLE questions ADD COLUMN search_vector tsvector;
+ALTER TABLE answers ADD COLUMN search_vector tsvector;
+
+-- +goose StatementBegin
+CREATE OR REPLACE FUNCTION update_question_search_vector() RETURNS trigger AS
+$$
+BEGIN
+    NEW.search_vector = setweight(to_tsvector('english', coalesce(NEW.title, '')), 'A') ||
+                        setweight(to_tsvector('english', coalesce(NEW.description, '')), 'B');
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+CREATE OR REPLACE FUNCTION update_answer_search_vector() RETURNS trigger AS
+$$
+BEGIN
+    NEW.search_vector = setweight(to_tsvector('english', coalesce(NEW.description, '')), 'B');
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+-- +goose StatementEnd
+
+CREATE TRIGGER questions_search_vector_update
+    BEFORE INSERT OR UPDATE OF title, description
+    ON questions
+    FOR EACH ROW
+EXECUTE PROCEDURE update_question_search_vector();
+
+CREATE TRIGGER answers_search_vector_update
+    BEFORE INSERT OR UPDATE OF description
+    ON answers
+    FOR EACH ROW
+EXECUTE PROCEDURE update_answer_search_vector();
+
+UPDATE questions
+SET search_vector = setweight(to_tsvector('english', coalesce(title, '')), 'A') ||
+                    setweight(to_tsvector('english', coalesce(description, '')), 'B');
+UPDATE answers
+SET search_vector = setweight(to_tsvector('english', coalesce(description, '')), 'B');
+
+CREATE INDEX IF NOT EXISTS idx_questions_search_vector ON questions USING gin (search_vector);
+CREATE INDEX IF NOT EXISTS idx_answers_search_vector ON answers USING gin (search_vector);
+
+-- +goose Down
+-- SQL in this section is executed when the migration is rolled back.
+DROP INDEX IF EXISTS idx_answers_search_vector;
+DROP INDEX IF EXISTS idx_questions_search_vector;
+DROP TRIGGER IF EXISTS answers_search_vector_update ON answers;
+DROP TRIGGER IF EXISTS questions_search_vector_update ON questions;
+DROP FUNCTION IF EXISTS update_answer_search_vector();
+DROP FUNCTION IF EXISTS update_question_search_vector();
+ALTER TABLE answers DROP COLUMN IF EXISTS search_vector;
+ALTER TABLE questions DROP COLUMN IF EXISTS search_vector;
@@ -0,0 +1,75 @@
+-- +goose Up
+-- SQL in this section is executed when the migration is applied.
+-- A vote targets either a question or an answer, a user votes once per target.
+CREATE TABLE IF NOT EXISTS votes
+(
+    id          serial    not null,
+    user_id     int       not null,
+    question_id int       null,
+    answer_id   int       null,
+    value       smallint  not null,
+    created_at  timestamp default current_timestamp,
+    updated_at  timestamp default current_timestamp,
+
+    primary key (id),
+    unique (user_id, question_id),
+    unique (user_id, answer_id),
+    check ((question_id IS NULL) <> (answer_id IS NULL)),
+    check (value IN (-1, 1)),
+    foreign key (user_id) references users (id) on delete cascade,
+    foreign key (question_id) references questions (id) on delete cascade,
+    foreign key (answer_id) references answers (id) on delete cascade
+);
+
+-- sum of the vote values, kept up to date with the votes
+ALTER TABLE questions ADD COLUMN score int not null default 0;
+ALTER TABLE answers ADD COLUMN score int not null default 0;
+
+-- The reputation of a user is the sum of their ledger entries. Entries go away
+-- with the vote, question or answer they were earned for.
+CREATE TABLE IF NOT EXISTS reputation_events
+(
+    id          bigserial   not null,
+    user_id     int         not null,
+    reason      varchar(30) not null,
+    points      int         not null,
+    question_id int         null,
+    answer_id   int         null,
+    vote_id     int         null,
+    created_at  timestamp default current_timestamp,
+
+    primary key (id),
+    unique (vote_id),
+    foreign key (user_id) references users (id) on delete cascade,
+    foreign key (question_id) references questions (id) on delete cascade,
+    foreign key (answer_id) references answers (id) on delete cascade,
+    foreign key (vote_id) references votes (id) on delete cascade
+);
+CREATE INDEX IF NOT EXISTS reputation_events_user_id_index ON reputation_events (user_id);
+CREATE INDEX IF NOT EXISTS reputation_events_question_id_index ON reputation_events (question_id);
+
+-- answers accepted so far earn their points too, except self-answers
+INSERT INTO reputation_events (user_id, reason, points, question_id, answer_id)
+SELECT a.user_id, 'answer_accepted', 15, a.question_id, a.id
+FROM answers a
+         INNER JOIN questions q ON q.id = a.question_id
+WHERE a.is_accepted
+  AND a.user_id <> q.user_id;
+
+INSERT INTO permissions (name, description)
+VALUES ('votes.cast', 'Vote on questions and answers of other users');
+
+INSERT INTO role_permissions (role_id, permission_id)
+SELECT r.id, p.id
+FROM roles r,
+     permissions p
+WHERE r.name IN ('user', 'admin')
+  AND p.name = 'votes.cast';
+
+-- +goose Down
+-- SQL in this section is executed when the migration is rolled back.
+DELETE FROM permissions WHERE name = 'votes.cast';
+DROP TABLE IF EXISTS reputation_events;
+ALTER TABLE answers DROP COLUMN IF EXISTS score;
+ALTER TABLE questions DROP COLUMN IF EXISTS score;
+DROP TABLE IF EXISTS votes;
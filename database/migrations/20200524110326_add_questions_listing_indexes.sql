@@ -0,0 +1,11 @@
+-- +goose Up
+-- SQL in this section is executed when the migration is applied.
+CREATE INDEX idx_questions_published_at_id ON questions (published_at, id) WHERE published_at IS NOT NULL;
+CREATE INDEX idx_questions_user_id_published_at_id ON questions (user_id, published_at, id);
+CREATE INDEX idx_answers_question_id_accepted ON answers (question_id) WHERE is_accepted;
+
+-- +goose Down
+-- SQL in this section is executed when the migration is rolled back.
+DROP INDEX IF EXISTS idx_answers_question_id_accepted;
+DROP INDEX IF EXISTS idx_questions_user_id_published_at_id;
+DROP INDEX IF EXISTS idx_questions_published_at_id;
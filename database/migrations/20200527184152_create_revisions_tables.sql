@@ -0,0 +1,48 @@
+-- +goose Up
+-- SQL in this section is executed when the migration is applied.
+-- Every saved version of a question or answer, numbered from 1 per question or
+-- answer. user_id is the user who wrote the version, not necessarily the owner.
+CREATE TABLE IF NOT EXISTS question_revisions
+(
+    id          serial       not null,
+    question_id int          not null,
+    revision    int          not null,
+    user_id     int          not null,
+    title       varchar(255) not null,
+    description text         not null,
+    created_at  timestamp default current_timestamp,
+
+    primary key (id),
+    unique (question_id, revision),
+    foreign key (question_id) references questions (id) on delete cascade,
+    foreign key (user_id) references users (id)
+);
+
+CREATE TABLE IF NOT EXISTS answer_revisions
+(
+    id          serial not null,
+    answer_id   int    not null,
+    revision    int    not null,
+    user_id     int    not null,
+    description text   not null,
+    created_at  timestamp default current_timestamp,
+
+    primary key (id),
+    unique (answer_id, revision),
+    foreign key (answer_id) references answers (id) on delete cascade,
+    foreign key (user_id) references users (id)
+);
+
+-- the current text of existing questions and answers is their first revision
+INSERT INTO question_revisions (question_id, revision, user_id, title, description, created_at)
+SELECT id, 1, user_id, title, description, updated_at
+FROM questions;
+
+INSERT INTO answer_revisions (answer_id, revision, user_id, description, created_at)
+SELECT id, 1, user_id, description, updated_at
+FROM answers;
+
+-- +goose Down
+-- SQL in this section is executed when the migration is rolled back.
+DROP TABLE IF EXISTS answer_revisions;
+DROP TABLE IF EXISTS question_revisions;
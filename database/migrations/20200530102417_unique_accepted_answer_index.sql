@@ -0,0 +1,23 @@
+-- +goose Up
+-- SQL in this section is executed when the migration is applied.
+-- a question has at most one accepted answer, keep the latest accepted one
+UPDATE answers a
+SET is_accepted = false
+WHERE a.is_accepted
+  AND EXISTS(SELECT 1
+             FROM answers b
+             WHERE b.question_id = a.question_id
+               AND b.is_accepted
+               AND (b.updated_at, b.id) > (a.updated_at, a.id));
+
+DELETE FROM reputation_events r
+WHERE r.reason = 'answer_accepted'
+  AND NOT EXISTS(SELECT 1 FROM answers a WHERE a.id = r.answer_id AND a.is_accepted);
+
+DROP INDEX IF EXISTS idx_answers_question_id_accepted;
+CREATE UNIQUE INDEX idx_answers_question_id_accepted ON answers (question_id) WHERE is_accepted;
+
+-- +goose Down
+-- SQL in this section is executed when the migration is rolled back.
+DROP INDEX IF EXISTS idx_answers_question_id_accepted;
+CREATE INDEX idx_answers_question_id_accepted ON answers (question_id) WHERE is_accepted;
@@ -0,0 +1,10 @@
+-- +goose Up
+-- SQL in this section is executed when the migration is applied.
+-- Registered users wait for the verification of their email to be activated.
+-- Deactivating them clears the flag, so verifying no longer activates them.
+ALTER TABLE users ADD COLUMN pending_verification boolean not null default false;
+UPDATE users SET pending_verification = true WHERE not is_active and not is_email_verified and not is_service_account and deleted_at is null;
+
+-- +goose Down
+-- SQL in this section is executed when the migration is rolled back.
+ALTER TABLE users DROP COLUMN IF EXISTS pending_verification;
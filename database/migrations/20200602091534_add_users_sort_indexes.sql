@@ -0,0 +1,11 @@
+-- +goose Up
+-- SQL in this section is executed when the migration is applied.
+-- The pattern indexes serve the prefix filters but not the ORDER BY of the
+-- username and email sorts, these match it including the id tie breaker.
+CREATE INDEX idx_users_username_lower_id ON users (lower(username), id);
+CREATE INDEX idx_users_email_id ON users (email, id);
+
+-- +goose Down
+-- SQL in this section is executed when the migration is rolled back.
+DROP INDEX IF EXISTS idx_users_email_id;
+DROP INDEX IF EXISTS idx_users_username_lower_id;
@@ -0,0 +1,15 @@
+-- +goose Up
+-- SQL in this section is executed when the migration is applied.
+-- Targets are emails, composite keys and names of any length, events that did
+-- not fit were lost. Changing varchar to text does not rewrite the table.
+ALTER TABLE audit_events
+    ALTER COLUMN actor_username TYPE text,
+    ALTER COLUMN target_type TYPE text,
+    ALTER COLUMN target_id TYPE text;
+
+-- +goose Down
+-- SQL in this section is executed when the migration is rolled back.
+ALTER TABLE audit_events
+    ALTER COLUMN actor_username TYPE varchar(20) USING left(actor_username, 20),
+    ALTER COLUMN target_type TYPE varchar(50) USING left(target_type, 50),
+    ALTER COLUMN target_id TYPE varchar(50) USING left(target_id, 50);
@@ -0,0 +1,20 @@
+package store
+
+import "time"
+
+// AuditEvent records an administrative or security-relevant action. Before
+// and After hold JSON encoded values of the changed target, if any.
+type AuditEvent struct {
+	ID            int64
+	OccurredAt    time.Time
+	ActorID       int32
+	ActorUsername string
+	Action        string
+	TargetType    string
+	TargetID      string
+	Outcome       string
+	PeerAddress   string
+	RequestID     string
+	Before        []byte
+	After         []byte
+}
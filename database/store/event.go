@@ -0,0 +1,28 @@
+package store
+
+// Types of the events of a question.
+const (
+	EventAnswerPosted        = "answer_posted"
+	EventAnswerUpdated       = "answer_updated"
+	EventAnswerDeleted       = "answer_deleted"
+	EventAnswerAccepted      = "answer_accepted"
+	EventQuestionUpdated     = "question_updated"
+	EventQuestionDeleted     = "question_deleted"
+	EventQuestionPublished   = "question_published"
+	EventQuestionUnpublished = "question_unpublished"
+	EventScoreChanged        = "score_changed"
+)
+
+// QuestionEvent is a committed change of a question or one of its answers. It
+// only carries IDs, watchers load the changed rows themselves.
+type QuestionEvent struct {
+	Type       string `json:"type"`
+	QuestionID int32  `json:"question_id"`
+	AnswerID   int32  `json:"answer_id,omitempty"` // 0 for events of the question itself
+	Score      int32  `json:"score,omitempty"`     // new score for EventScoreChanged
+}
+
+// EventPublisher delivers the events of questions to their watchers.
+type EventPublisher interface {
+	Publish(event *QuestionEvent)
+}
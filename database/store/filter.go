@@ -0,0 +1,75 @@
+package store
+
+import "time"
+
+type QuestionFeed int
+
+const (
+	QuestionFeedNewest QuestionFeed = iota
+	QuestionFeedUnanswered
+	QuestionFeedTrending
+	QuestionFeedByUser
+)
+
+// TrendingVoteSeconds is how much newer every net upvote makes a question look
+// in the trending feed, so the score of questions decays linearly with age.
+const TrendingVoteSeconds = 12 * 60 * 60
+
+// QuestionFilter narrows down and orders the questions returned by a listing.
+type QuestionFilter struct {
+	Feed         QuestionFeed
+	UserID       int32 // author of the questions of QuestionFeedByUser
+	Drafts       bool  // include unpublished questions, ordered by their creation
+	Tags         []string
+	MatchAllTags bool
+	After        *Cursor
+	Limit        int32
+}
+
+type UserSortField int
+
+const (
+	SortUsersByCreatedAt UserSortField = iota
+	SortUsersByUsername
+	SortUsersByEmail
+	SortUsersByID
+)
+
+// CursorTimeLayout is the layout of timestamp values in a Cursor.
+const CursorTimeLayout = "2006-01-02 15:04:05.999999"
+
+// Cursor points at the last row of the previous page in a keyset pagination.
+type Cursor struct {
+	Value string
+	ID    int64
+}
+
+// UserFilter narrows down and orders the users returned by a listing.
+// Nil and zero valued fields are not applied.
+type UserFilter struct {
+	IsActive       *bool
+	IsAdmin        *bool
+	UsernamePrefix string
+	EmailPrefix    string
+	CreatedAfter   time.Time
+	CreatedBefore  time.Time
+	SortBy         UserSortField
+	Descending     bool
+	Deleted        bool // list deleted users instead of the others
+	After          *Cursor
+	Limit          int32
+}
+
+// AuditEventFilter narrows down the audit events returned by a listing, newest
+// first. Zero valued fields are not applied.
+type AuditEventFilter struct {
+	ActorID        int32
+	Action         string
+	TargetType     string
+	TargetID       string
+	Outcome        string
+	OccurredAfter  time.Time
+	OccurredBefore time.Time
+	BeforeID       int64
+	Limit          int32
+}
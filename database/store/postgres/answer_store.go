@@ -0,0 +1,187 @@
+package postgres
+
+import (
+	"database/sql"
+	"fmt"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/ranabd36/project-qa/database/store"
+	"github.com/ranabd36/project-qa/pb"
+	"time"
+)
+
+const answerColumns = `id, user_id, question_id, answer_id, description, is_accepted, created_at, updated_at, score`
+
+func (s *Store) SaveAnswer(answer *pb.Answer) error {
+	const insertStatement = `INSERT INTO answers (user_id, question_id, answer_id, description) VALUES ($1, $2, $3, $4) RETURNING id`
+
+	var parentID sql.NullInt32
+	if answer.GetAnswerId() > 0 {
+		parentID = sql.NullInt32{Int32: answer.GetAnswerId(), Valid: true}
+	}
+
+	err := s.withTx(func(tx *sql.Tx) error {
+		if err := tx.QueryRow(insertStatement,
+			answer.GetUserId(),
+			answer.GetQuestionId(),
+			parentID,
+			answer.GetDescription(),
+		).Scan(&answer.Id); err != nil {
+			return err
+		}
+		_, err := saveRevision(tx, pb.Revision_ANSWER, answer.GetId(), answer.GetUserId(), "", answer.GetDescription())
+		return err
+	})
+	if err != nil {
+		return fmt.Errorf("failed to save row: %w", err)
+	}
+	s.publish(store.EventAnswerPosted, answer.GetQuestionId(), answer.GetId(), 0)
+	return nil
+}
+
+func (s *Store) FindAnswer(id int32) (*pb.Answer, error) {
+	const statement = `SELECT ` + answerColumns + ` FROM answers where id = $1;`
+	return scanAnswer(s.db.QueryRow(statement, id))
+}
+
+// ListAnswers returns every answer and reply of a question in posting order.
+func (s *Store) ListAnswers(questionID int32) ([]*pb.Answer, error) {
+	const statement = `SELECT ` + answerColumns + ` FROM answers where question_id = $1 order by created_at, id;`
+	rows, err := s.db.Query(statement, questionID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var answers []*pb.Answer
+	for rows.Next() {
+		answer, err := scanAnswer(rows)
+		if err != nil {
+			return nil, err
+		}
+		answers = append(answers, answer)
+	}
+	return answers, rows.Err()
+}
+
+// UpdateAnswer saves the answer and records its text as a new revision by the
+// editor.
+func (s *Store) UpdateAnswer(answer *pb.Answer, editorID int32) error {
+	var questionID int32
+	err := s.withTx(func(tx *sql.Tx) error {
+		const updateStatement = `Update answers set description = $2, updated_at = current_timestamp where id = $1 RETURNING question_id;`
+		if err := tx.QueryRow(updateStatement, answer.GetId(), answer.GetDescription()).Scan(&questionID); err != nil {
+			return err
+		}
+		_, err := saveRevision(tx, pb.Revision_ANSWER, answer.GetId(), editorID, "", answer.GetDescription())
+		return err
+	})
+	if err != nil {
+		return err
+	}
+	s.publish(store.EventAnswerUpdated, questionID, answer.GetId(), 0)
+	return nil
+}
+
+// DeleteAnswer removes the answer together with all of its nested replies.
+func (s *Store) DeleteAnswer(id int32) error {
+	const deleteStatement = `WITH RECURSIVE thread AS (
+    SELECT id FROM answers WHERE id = $1
+    UNION ALL
+    SELECT a.id FROM answers a INNER JOIN thread t ON a.answer_id = t.id
+)
+DELETE FROM answers WHERE id IN (SELECT id FROM thread) RETURNING id, question_id;`
+	rows, err := s.db.Query(deleteStatement, id)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	var events []*store.QuestionEvent
+	for rows.Next() {
+		event := &store.QuestionEvent{Type: store.EventAnswerDeleted}
+		if err := rows.Scan(&event.AnswerID, &event.QuestionID); err != nil {
+			return err
+		}
+		events = append(events, event)
+	}
+	if err := rows.Err(); err != nil {
+		return err
+	}
+	if len(events) == 0 {
+		return sql.ErrNoRows
+	}
+	for _, event := range events {
+		s.publish(event.Type, event.QuestionID, event.AnswerID, 0)
+	}
+	return nil
+}
+
+// AcceptAnswer marks the answer as the accepted one of its question,
+// un-accepting any previously accepted answer in the same transaction. The
+// reputation for the acceptance moves to the author of the answer, unless
+// they answered their own question.
+func (s *Store) AcceptAnswer(questionID int32, answerID int32) error {
+	err := s.withTx(func(tx *sql.Tx) error {
+		// locking the question serializes concurrent accepts of its answers
+		var locked int32
+		if err := tx.QueryRow(`SELECT id FROM questions where id = $1 FOR UPDATE;`, questionID).Scan(&locked); err != nil {
+			return err
+		}
+		const clearStatement = `Update answers set is_accepted = false where question_id = $1 and is_accepted;`
+		if _, err := tx.Exec(clearStatement, questionID); err != nil {
+			return err
+		}
+		const acceptStatement = `Update answers set is_accepted = true where id = $1 and question_id = $2;`
+		if err := executeTxStatement(tx, acceptStatement, answerID, questionID); err != nil {
+			return err
+		}
+		
+		const clearReputationStatement = `DELETE FROM reputation_events where reason = $2 and answer_id IN (SELECT id FROM answers where question_id = $1);`
+		if _, err := tx.Exec(clearReputationStatement, questionID, store.ReputationAnswerAccepted); err != nil {
+			return err
+		}
+		const reputationStatement = `INSERT INTO reputation_events (user_id, reason, points, answer_id)
+SELECT a.user_id, $2, $3, a.id
+FROM answers a
+         INNER JOIN questions q ON q.id = a.question_id
+WHERE a.id = $1
+  AND a.user_id <> q.user_id;`
+		_, err := tx.Exec(reputationStatement, answerID, store.ReputationAnswerAccepted, store.ReputationPoints[store.ReputationAnswerAccepted])
+		return err
+	})
+	if err != nil {
+		return err
+	}
+	s.publish(store.EventAnswerAccepted, questionID, answerID, 0)
+	return nil
+}
+
+type rowScanner interface {
+	Scan(dest ...interface{}) error
+}
+
+func scanAnswer(row rowScanner) (*pb.Answer, error) {
+	answer := &pb.Answer{}
+	var parentID sql.NullInt32
+	var createdAt time.Time
+	var updatedAt time.Time
+	if err := row.Scan(
+		&answer.Id,
+		&answer.UserId,
+		&answer.QuestionId,
+		&parentID,
+		&answer.Description,
+		&answer.IsAccepted,
+		&createdAt,
+		&updatedAt,
+		&answer.Score,
+	); err != nil {
+		return nil, err
+	}
+	answer.AnswerId = parentID.Int32
+	c, _ := ptypes.TimestampProto(createdAt)
+	u, _ := ptypes.TimestampProto(updatedAt)
+	answer.CreatedAt = c
+	answer.UpdatedAt = u
+	return answer, nil
+}
@@ -0,0 +1,105 @@
+package postgres
+
+import (
+	"database/sql"
+	"fmt"
+	"github.com/lib/pq"
+	"github.com/ranabd36/project-qa/database/store"
+	"time"
+)
+
+const apiKeyColumns = `id, user_id, name, prefix, key_hash, scopes, expires_at, last_used_at, revoked_at, created_at`
+
+// SaveAPIKey stores a new key. The creation time is set in UTC here rather than
+// by the database, whose current_timestamp follows its time zone, since it is
+// compared with the user revocations.
+func (s *Store) SaveAPIKey(key *store.APIKey) error {
+	key.CreatedAt = time.Now().UTC()
+	const insertStatement = `INSERT INTO api_keys (user_id, name, prefix, key_hash, scopes, expires_at, created_at) VALUES ($1, $2, $3, $4, $5, $6, $7) RETURNING id`
+	if err := s.db.QueryRow(insertStatement,
+		key.UserID,
+		key.Name,
+		key.Prefix,
+		key.KeyHash,
+		pq.Array(key.Scopes),
+		key.ExpiresAt,
+		key.CreatedAt,
+	).Scan(&key.ID); err != nil {
+		return fmt.Errorf("failed to save row: %w", err)
+	}
+	return nil
+}
+
+func (s *Store) FindAPIKey(keyHash string) (*store.APIKey, error) {
+	const statement = `SELECT ` + apiKeyColumns + ` FROM api_keys where key_hash = $1;`
+	return scanAPIKey(s.db.QueryRow(statement, keyHash))
+}
+
+func (s *Store) FindAPIKeyByID(id int32) (*store.APIKey, error) {
+	const statement = `SELECT ` + apiKeyColumns + ` FROM api_keys where id = $1;`
+	return scanAPIKey(s.db.QueryRow(statement, id))
+}
+
+// ListAPIKeys returns every key of the user, including revoked and expired ones.
+func (s *Store) ListAPIKeys(userID int32) ([]*store.APIKey, error) {
+	const statement = `SELECT ` + apiKeyColumns + ` FROM api_keys where user_id = $1 ORDER BY id;`
+	rows, err := s.db.Query(statement, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var keys []*store.APIKey
+	for rows.Next() {
+		key, err := scanAPIKey(rows)
+		if err != nil {
+			return nil, err
+		}
+		keys = append(keys, key)
+	}
+	return keys, rows.Err()
+}
+
+func (s *Store) RevokeAPIKey(id int32, now time.Time) error {
+	const updateStatement = `Update api_keys set revoked_at = $2 where id = $1 and revoked_at is null;`
+	return s.executeStatement(updateStatement, id, now)
+}
+
+// TouchAPIKey records the use of the key. The timestamp is written at most
+// once per minute, so busy clients do not cause a write on every request.
+func (s *Store) TouchAPIKey(id int32, now time.Time) error {
+	const updateStatement = `Update api_keys set last_used_at = $2 where id = $1 and (last_used_at is null or last_used_at < $2 - interval '1 minute');`
+	_, err := s.db.Exec(updateStatement, id, now)
+	return err
+}
+
+func scanAPIKey(row rowScanner) (*store.APIKey, error) {
+	key := &store.APIKey{}
+	var expiresAt sql.NullTime
+	var lastUsedAt sql.NullTime
+	var revokedAt sql.NullTime
+	if err := row.Scan(
+		&key.ID,
+		&key.UserID,
+		&key.Name,
+		&key.Prefix,
+		&key.KeyHash,
+		pq.Array(&key.Scopes),
+		&expiresAt,
+		&lastUsedAt,
+		&revokedAt,
+		&key.CreatedAt,
+	); err != nil {
+		return nil, err
+	}
+	if expiresAt.Valid {
+		key.ExpiresAt = &expiresAt.Time
+	}
+	if lastUsedAt.Valid {
+		key.LastUsedAt = &lastUsedAt.Time
+	}
+	if revokedAt.Valid {
+		key.RevokedAt = &revokedAt.Time
+	}
+	return key, nil
+}
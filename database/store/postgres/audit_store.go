@@ -0,0 +1,125 @@
+package postgres
+
+import (
+	"database/sql"
+	"fmt"
+	"github.com/ranabd36/project-qa/database/store"
+	"strings"
+)
+
+const auditEventColumns = `id, occurred_at, actor_id, actor_username, action, target_type, target_id, outcome, peer_address, request_id, before_value, after_value`
+
+func (s *Store) SaveAuditEvent(event *store.AuditEvent) error {
+	const insertStatement = `INSERT INTO audit_events (occurred_at, actor_id, actor_username, action, target_type, target_id, outcome, peer_address, request_id, before_value, after_value)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11) RETURNING id`
+	if err := s.db.QueryRow(insertStatement,
+		event.OccurredAt,
+		nullInt32(event.ActorID),
+		nullString(event.ActorUsername),
+		event.Action,
+		nullString(event.TargetType),
+		nullString(event.TargetID),
+		event.Outcome,
+		nullString(event.PeerAddress),
+		nullString(event.RequestID),
+		nullJSON(event.Before),
+		nullJSON(event.After),
+	).Scan(&event.ID); err != nil {
+		return fmt.Errorf("failed to save row: %w", err)
+	}
+	return nil
+}
+
+// ListAuditEvents returns a page of audit events, newest first.
+func (s *Store) ListAuditEvents(filter store.AuditEventFilter) ([]*store.AuditEvent, error) {
+	var conditions []string
+	var args []interface{}
+	addArg := func(value interface{}) string {
+		args = append(args, value)
+		return fmt.Sprintf("$%d", len(args))
+	}
+
+	if filter.ActorID != 0 {
+		conditions = append(conditions, "actor_id = "+addArg(filter.ActorID))
+	}
+	if filter.Action != "" {
+		conditions = append(conditions, "action = "+addArg(filter.Action))
+	}
+	if filter.TargetType != "" {
+		conditions = append(conditions, "target_type = "+addArg(filter.TargetType))
+	}
+	if filter.TargetID != "" {
+		conditions = append(conditions, "target_id = "+addArg(filter.TargetID))
+	}
+	if filter.Outcome != "" {
+		conditions = append(conditions, "outcome = "+addArg(filter.Outcome))
+	}
+	if !filter.OccurredAfter.IsZero() {
+		conditions = append(conditions, "occurred_at >= "+addArg(filter.OccurredAfter))
+	}
+	if !filter.OccurredBefore.IsZero() {
+		conditions = append(conditions, "occurred_at < "+addArg(filter.OccurredBefore))
+	}
+	if filter.BeforeID != 0 {
+		conditions = append(conditions, "id < "+addArg(filter.BeforeID))
+	}
+
+	where := ""
+	if len(conditions) > 0 {
+		where = "WHERE " + strings.Join(conditions, " AND ")
+	}
+	statement := fmt.Sprintf(`SELECT %s FROM audit_events %s ORDER BY id DESC LIMIT %s;`, auditEventColumns, where, addArg(filter.Limit))
+
+	rows, err := s.db.Query(statement, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var events []*store.AuditEvent
+	for rows.Next() {
+		event := &store.AuditEvent{}
+		var actorID sql.NullInt32
+		var actorUsername, targetType, targetID, peerAddress, requestID sql.NullString
+		if err := rows.Scan(
+			&event.ID,
+			&event.OccurredAt,
+			&actorID,
+			&actorUsername,
+			&event.Action,
+			&targetType,
+			&targetID,
+			&event.Outcome,
+			&peerAddress,
+			&requestID,
+			&event.Before,
+			&event.After,
+		); err != nil {
+			return nil, err
+		}
+		event.ActorID = actorID.Int32
+		event.ActorUsername = actorUsername.String
+		event.TargetType = targetType.String
+		event.TargetID = targetID.String
+		event.PeerAddress = peerAddress.String
+		event.RequestID = requestID.String
+		events = append(events, event)
+	}
+	return events, rows.Err()
+}
+
+func nullInt32(value int32) sql.NullInt32 {
+	return sql.NullInt32{Int32: value, Valid: value != 0}
+}
+
+func nullString(value string) sql.NullString {
+	return sql.NullString{String: value, Valid: value != ""}
+}
+
+// nullJSON keeps empty values NULL, an empty string is no valid jsonb.
+func nullJSON(value []byte) interface{} {
+	if len(value) == 0 {
+		return nil
+	}
+	return string(value)
+}
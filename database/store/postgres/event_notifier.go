@@ -0,0 +1,80 @@
+package postgres
+
+import (
+	"database/sql"
+	"encoding/json"
+	"github.com/lib/pq"
+	"github.com/ranabd36/project-qa/database/store"
+	"log"
+	"time"
+)
+
+const questionEventsChannel = "question_events"
+
+// EventNotifier publishes question events with NOTIFY, so the EventListener of
+// every instance sharing the database receives them.
+type EventNotifier struct {
+	db *sql.DB
+}
+
+func NewEventNotifier(db *sql.DB) *EventNotifier {
+	return &EventNotifier{db}
+}
+
+func (notifier *EventNotifier) Publish(event *store.QuestionEvent) {
+	payload, err := json.Marshal(event)
+	if err != nil {
+		log.Printf("failed to encode question event: %v", err)
+		return
+	}
+	if _, err := notifier.db.Exec(`SELECT pg_notify($1, $2);`, questionEventsChannel, string(payload)); err != nil {
+		log.Printf("failed to notify question event: %v", err)
+	}
+}
+
+// EventListener forwards the question events notified by any instance to the
+// publisher of this instance.
+type EventListener struct {
+	listener  *pq.Listener
+	publisher store.EventPublisher
+}
+
+func NewEventListener(dataSource string, publisher store.EventPublisher) (*EventListener, error) {
+	listener := pq.NewListener(dataSource, 10*time.Second, time.Minute, func(_ pq.ListenerEventType, err error) {
+		if err != nil {
+			log.Printf("question event listener: %v", err)
+		}
+	})
+	if err := listener.Listen(questionEventsChannel); err != nil {
+		_ = listener.Close()
+		return nil, err
+	}
+	return &EventListener{listener, publisher}, nil
+}
+
+// Watch forwards the notified events until stop is closed. Events notified
+// while the connection is re-established are lost.
+func (listener *EventListener) Watch(stop <-chan struct{}) {
+	defer listener.listener.Close()
+	ticker := time.NewTicker(90 * time.Second)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-stop:
+			return
+		case <-ticker.C:
+			// detects dead connections the server did not close
+			go listener.listener.Ping()
+		case notification := <-listener.listener.Notify:
+			if notification == nil {
+				continue
+			}
+			event := &store.QuestionEvent{}
+			if err := json.Unmarshal([]byte(notification.Extra), event); err != nil {
+				log.Printf("failed to decode question event: %v", err)
+				continue
+			}
+			listener.publisher.Publish(event)
+		}
+	}
+}
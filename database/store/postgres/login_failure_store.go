@@ -0,0 +1,42 @@
+package postgres
+
+import (
+	"database/sql"
+	"github.com/lib/pq"
+	"time"
+)
+
+// RecordLoginFailure counts a failed login for the key and returns the number
+// of failures since windowStart. Older failures are forgotten.
+func (s *Store) RecordLoginFailure(key string, now time.Time, windowStart time.Time) (int32, error) {
+	const upsertStatement = `INSERT INTO login_failures (key, failures, last_failure_at) VALUES ($1, 1, $2)
+ON CONFLICT (key) DO UPDATE SET
+    failures        = CASE WHEN login_failures.last_failure_at < $3 THEN 1 ELSE login_failures.failures + 1 END,
+    last_failure_at = EXCLUDED.last_failure_at
+RETURNING failures;`
+	var failures int32
+	err := s.db.QueryRow(upsertStatement, key, now, windowStart).Scan(&failures)
+	return failures, err
+}
+
+func (s *Store) LockLogin(key string, until time.Time) error {
+	const updateStatement = `Update login_failures set locked_until = $2 where key = $1;`
+	return s.executeStatement(updateStatement, key, until)
+}
+
+// FindLoginLock returns the latest time until which any of the keys is locked,
+// or the zero time if none of them is locked at now.
+func (s *Store) FindLoginLock(keys []string, now time.Time) (time.Time, error) {
+	const statement = `SELECT max(locked_until) FROM login_failures where key = ANY($1) and locked_until > $2;`
+	var lockedUntil sql.NullTime
+	if err := s.db.QueryRow(statement, pq.Array(keys), now).Scan(&lockedUntil); err != nil {
+		return time.Time{}, err
+	}
+	return lockedUntil.Time, nil
+}
+
+func (s *Store) ClearLoginFailures(key string) error {
+	const deleteStatement = `DELETE FROM login_failures where key = $1;`
+	_, err := s.db.Exec(deleteStatement, key)
+	return err
+}
@@ -0,0 +1,63 @@
+package postgres
+
+import (
+	"database/sql"
+	"fmt"
+	"github.com/ranabd36/project-qa/database/store"
+	"golang.org/x/crypto/bcrypt"
+	"time"
+)
+
+func (s *Store) SavePasswordResetToken(token *store.PasswordResetToken) error {
+	const insertStatement = `INSERT INTO password_reset_tokens (user_id, token_hash, expires_at) VALUES ($1, $2, $3) RETURNING id`
+	if err := s.db.QueryRow(insertStatement,
+		token.UserID,
+		token.TokenHash,
+		token.ExpiresAt,
+	).Scan(&token.ID); err != nil {
+		return fmt.Errorf("failed to save row: %w", err)
+	}
+	return nil
+}
+
+// FindPasswordResetToken returns the token if it is neither used nor expired.
+func (s *Store) FindPasswordResetToken(tokenHash string, now time.Time) (*store.PasswordResetToken, error) {
+	const statement = `SELECT id, user_id, token_hash, expires_at FROM password_reset_tokens where token_hash = $1 and used_at is null and expires_at > $2;`
+	token := &store.PasswordResetToken{}
+	if err := s.db.QueryRow(statement, tokenHash, now).Scan(
+		&token.ID,
+		&token.UserID,
+		&token.TokenHash,
+		&token.ExpiresAt,
+	); err != nil {
+		return nil, err
+	}
+	return token, nil
+}
+
+// ResetPassword consumes the reset token and sets the new password of its user.
+// Other outstanding reset tokens of the user are consumed as well.
+// It returns sql.ErrNoRows when the token is unknown, used or expired.
+func (s *Store) ResetPassword(tokenHash string, newPassword string, now time.Time) (int32, error) {
+	hasPassword, err := bcrypt.GenerateFromPassword([]byte(newPassword), bcrypt.DefaultCost)
+	if err != nil {
+		return 0, err
+	}
+
+	var userID int32
+	err = s.withTx(func(tx *sql.Tx) error {
+		const consumeStatement = `Update password_reset_tokens set used_at = $2 where token_hash = $1 and used_at is null and expires_at > $2 RETURNING user_id;`
+		if err := tx.QueryRow(consumeStatement, tokenHash, now).Scan(&userID); err != nil {
+			return err
+		}
+
+		const consumeOthersStatement = `Update password_reset_tokens set used_at = $2 where user_id = $1 and used_at is null;`
+		if _, err := tx.Exec(consumeOthersStatement, userID, now); err != nil {
+			return err
+		}
+
+		const updateStatement = `Update users set password = $2 where id = $1;`
+		return executeTxStatement(tx, updateStatement, userID, hasPassword)
+	})
+	return userID, err
+}
@@ -1,11 +1,61 @@
 package postgres
 
-import "database/sql"
+import (
+	"database/sql"
+	"errors"
+	"github.com/ranabd36/project-qa/database/store"
+)
 
 type Store struct {
-	db *sql.DB
+	db     *sql.DB
+	events store.EventPublisher
 }
 
-func NewStore(db *sql.DB) *Store {
-	return &Store{db}
+// NewStore returns a store publishing the events of committed changes to
+// questions and answers to events, which may be nil.
+func NewStore(db *sql.DB, events store.EventPublisher) *Store {
+	return &Store{db, events}
+}
+
+// publish hands the event of a committed change to the event publisher.
+func (s *Store) publish(eventType string, questionID int32, answerID int32, score int32) {
+	if s.events == nil {
+		return
+	}
+	s.events.Publish(&store.QuestionEvent{
+		Type:       eventType,
+		QuestionID: questionID,
+		AnswerID:   answerID,
+		Score:      score,
+	})
+}
+
+// withTx runs fn inside a transaction, committing on success and rolling back on error.
+func (s *Store) withTx(fn func(tx *sql.Tx) error) error {
+	tx, err := s.db.Begin()
+	if err != nil {
+		return err
+	}
+	if err := fn(tx); err != nil {
+		_ = tx.Rollback()
+		return err
+	}
+	return tx.Commit()
+}
+
+func executeTxStatement(tx *sql.Tx, statement string, args ...interface{}) error {
+	rows, err := tx.Exec(statement, args...)
+	if err != nil {
+		return err
+	}
+
+	updateCount, err := rows.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if updateCount > 0 {
+		return nil
+	}
+	return errors.New("unknown error")
 }
@@ -0,0 +1,248 @@
+package postgres
+
+import (
+	"database/sql"
+	"fmt"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/lib/pq"
+	"github.com/ranabd36/project-qa/database/store"
+	"github.com/ranabd36/project-qa/pb"
+	"strings"
+	"time"
+)
+
+const questionColumns = `q.id, q.user_id, q.title, q.description, q.published_at, q.created_at, q.updated_at, q.score,
+       ARRAY(SELECT t.name FROM question_tags qt INNER JOIN tags t ON t.id = qt.tag_id WHERE qt.question_id = q.id ORDER BY t.name)`
+
+func (s *Store) SaveQuestion(question *pb.Question) error {
+	err := s.withTx(func(tx *sql.Tx) error {
+		const insertStatement = `INSERT INTO questions (user_id, title, description) VALUES ($1, $2, $3) RETURNING id`
+		if err := tx.QueryRow(insertStatement,
+			question.GetUserId(),
+			question.GetTitle(),
+			question.GetDescription(),
+		).Scan(&question.Id); err != nil {
+			return err
+		}
+		if _, err := saveRevision(tx, pb.Revision_QUESTION, question.GetId(), question.GetUserId(), question.GetTitle(), question.GetDescription()); err != nil {
+			return err
+		}
+		return setQuestionTags(tx, question.GetId(), question.GetTags())
+	})
+
+	if err != nil {
+		pqErr, ok := err.(*pq.Error)
+		if ok && pqErr.Code == "23505" {
+			return store.ErrAlreadyExists
+		}
+		return fmt.Errorf("failed to save row: %w", err)
+	}
+	return nil
+}
+
+func (s *Store) FindQuestion(id int32) (*pb.Question, error) {
+	const statement = `SELECT ` + questionColumns + ` FROM questions q where q.id = $1;`
+	return scanQuestion(s.db.QueryRow(statement, id))
+}
+
+// UpdateQuestion saves the question and records its text as a new revision
+// by the editor.
+func (s *Store) UpdateQuestion(question *pb.Question, editorID int32) error {
+	err := s.withTx(func(tx *sql.Tx) error {
+		const updateStatement = `Update questions set title = $2, description = $3, updated_at = current_timestamp where id = $1;`
+		if err := executeTxStatement(tx, updateStatement, question.GetId(), question.GetTitle(), question.GetDescription()); err != nil {
+			return err
+		}
+		if _, err := saveRevision(tx, pb.Revision_QUESTION, question.GetId(), editorID, question.GetTitle(), question.GetDescription()); err != nil {
+			return err
+		}
+		return setQuestionTags(tx, question.GetId(), question.GetTags())
+	})
+	if pqErr, ok := err.(*pq.Error); ok && pqErr.Code == "23505" {
+		return store.ErrAlreadyExists
+	}
+	if err != nil {
+		return err
+	}
+	s.publish(store.EventQuestionUpdated, question.GetId(), 0, 0)
+	return nil
+}
+
+// DeleteQuestion removes the question and all of its answers.
+func (s *Store) DeleteQuestion(id int32) error {
+	err := s.withTx(func(tx *sql.Tx) error {
+		const deleteAnswersStatement = `DELETE from answers where question_id = $1;`
+		if _, err := tx.Exec(deleteAnswersStatement, id); err != nil {
+			return err
+		}
+		const deleteStatement = `DELETE from questions where id = $1;`
+		return executeTxStatement(tx, deleteStatement, id)
+	})
+	if err != nil {
+		return err
+	}
+	s.publish(store.EventQuestionDeleted, id, 0, 0)
+	return nil
+}
+
+func (s *Store) PublishQuestion(id int32) error {
+	const updateStatement = `Update questions set published_at = current_timestamp where id = $1;`
+	if err := s.executeStatement(updateStatement, id); err != nil {
+		return err
+	}
+	s.publish(store.EventQuestionPublished, id, 0, 0)
+	return nil
+}
+
+func (s *Store) UnpublishQuestion(id int32) error {
+	const updateStatement = `Update questions set published_at = null where id = $1;`
+	if err := s.executeStatement(updateStatement, id); err != nil {
+		return err
+	}
+	s.publish(store.EventQuestionUnpublished, id, 0, 0)
+	return nil
+}
+
+// ListQuestions returns the questions of the feed matching the filter, newest
+// first except for the trending feed. Unpublished questions are only listed for
+// filter.Drafts.
+func (s *Store) ListQuestions(filter store.QuestionFilter) ([]*pb.Question, error) {
+	var args []interface{}
+	addArg := func(value interface{}) string {
+		args = append(args, value)
+		return fmt.Sprintf("$%d", len(args))
+	}
+
+	var conditions []string
+	if !filter.Drafts {
+		conditions = append(conditions, `q.published_at IS NOT NULL`)
+	}
+	column, cast := `q.published_at`, `::timestamp`
+	switch filter.Feed {
+	case store.QuestionFeedNewest:
+	case store.QuestionFeedUnanswered:
+		conditions = append(conditions, `NOT EXISTS (SELECT 1 FROM answers a WHERE a.question_id = q.id AND a.is_accepted)`)
+	case store.QuestionFeedTrending:
+		column = fmt.Sprintf(`(floor(extract(epoch FROM q.published_at))::bigint + q.score * %d)`, store.TrendingVoteSeconds)
+		cast = `::bigint`
+	case store.QuestionFeedByUser:
+		conditions = append(conditions, `q.user_id = `+addArg(filter.UserID))
+		if filter.Drafts {
+			column = `coalesce(q.published_at, q.created_at)`
+		}
+	default:
+		return nil, fmt.Errorf("unknown question feed: %v", filter.Feed)
+	}
+
+	if len(filter.Tags) > 0 {
+		tagged := `q.id IN (SELECT qt.question_id FROM question_tags qt INNER JOIN tags t ON t.id = qt.tag_id WHERE t.name = ANY(` + addArg(pq.Array(filter.Tags)) + `)`
+		if filter.MatchAllTags {
+			tagged += ` GROUP BY qt.question_id HAVING count(DISTINCT t.id) = ` + addArg(len(filter.Tags))
+		}
+		conditions = append(conditions, tagged+`)`)
+	}
+	if filter.After != nil {
+		conditions = append(conditions, fmt.Sprintf("(%s, q.id) < (%s%s, %s)", column, addArg(filter.After.Value), cast, addArg(filter.After.ID)))
+	}
+
+	where := ""
+	if len(conditions) > 0 {
+		where = "WHERE " + strings.Join(conditions, " AND ")
+	}
+	statement := fmt.Sprintf(`SELECT %s FROM questions q %s ORDER BY %s DESC, q.id DESC LIMIT %s;`,
+		questionColumns, where, column, addArg(filter.Limit))
+	rows, err := s.db.Query(statement, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var questions []*pb.Question
+	for rows.Next() {
+		question, err := scanQuestion(rows)
+		if err != nil {
+			return nil, err
+		}
+		questions = append(questions, question)
+	}
+	return questions, rows.Err()
+}
+
+// ListTags returns the tags starting with prefix, most used first.
+func (s *Store) ListTags(prefix string, limit int32) ([]*pb.Tag, error) {
+	const statement = `SELECT t.name, count(qt.question_id) AS usage_count
+FROM tags t
+         LEFT JOIN question_tags qt ON qt.tag_id = t.id
+WHERE t.name LIKE $1 ESCAPE '\'
+GROUP BY t.name
+ORDER BY usage_count DESC, t.name
+LIMIT $2;`
+	rows, err := s.db.Query(statement, escapeLike(prefix)+"%", limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var tags []*pb.Tag
+	for rows.Next() {
+		tag := &pb.Tag{}
+		if err := rows.Scan(&tag.Name, &tag.UsageCount); err != nil {
+			return nil, err
+		}
+		tags = append(tags, tag)
+	}
+	return tags, rows.Err()
+}
+
+// setQuestionTags replaces the tags of a question, creating missing tags on the way.
+func setQuestionTags(tx *sql.Tx, questionID int32, tags []string) error {
+	const deleteStatement = `DELETE FROM question_tags WHERE question_id = $1;`
+	if _, err := tx.Exec(deleteStatement, questionID); err != nil {
+		return err
+	}
+	if len(tags) == 0 {
+		return nil
+	}
+
+	const insertTagsStatement = `INSERT INTO tags (name) SELECT unnest($1::varchar[]) ON CONFLICT (name) DO NOTHING;`
+	if _, err := tx.Exec(insertTagsStatement, pq.Array(tags)); err != nil {
+		return err
+	}
+
+	const linkStatement = `INSERT INTO question_tags (question_id, tag_id) SELECT $1, id FROM tags WHERE name = ANY($2);`
+	_, err := tx.Exec(linkStatement, questionID, pq.Array(tags))
+	return err
+}
+
+func escapeLike(value string) string {
+	return strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`).Replace(value)
+}
+
+func scanQuestion(row rowScanner) (*pb.Question, error) {
+	question := &pb.Question{}
+	var publishedAt sql.NullTime
+	var createdAt time.Time
+	var updatedAt time.Time
+	if err := row.Scan(
+		&question.Id,
+		&question.UserId,
+		&question.Title,
+		&question.Description,
+		&publishedAt,
+		&createdAt,
+		&updatedAt,
+		&question.Score,
+		pq.Array(&question.Tags),
+	); err != nil {
+		return nil, err
+	}
+	if publishedAt.Valid {
+		p, _ := ptypes.TimestampProto(publishedAt.Time)
+		question.PublishedAt = p
+	}
+	c, _ := ptypes.TimestampProto(createdAt)
+	u, _ := ptypes.TimestampProto(updatedAt)
+	question.CreatedAt = c
+	question.UpdatedAt = u
+	return question, nil
+}
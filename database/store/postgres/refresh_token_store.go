@@ -0,0 +1,81 @@
+package postgres
+
+import (
+	"database/sql"
+	"fmt"
+	"github.com/ranabd36/project-qa/database/store"
+)
+
+func (s *Store) SaveRefreshToken(token *store.RefreshToken) error {
+	const insertStatement = `INSERT INTO refresh_tokens (user_id, family_id, token_hash, expires_at, mfa) VALUES ($1, $2, $3, $4, $5) RETURNING id, created_at`
+	if err := s.db.QueryRow(insertStatement,
+		token.UserID,
+		token.FamilyID,
+		token.TokenHash,
+		token.ExpiresAt,
+		token.MFA,
+	).Scan(&token.ID, &token.CreatedAt); err != nil {
+		return fmt.Errorf("failed to save row: %w", err)
+	}
+	return nil
+}
+
+func (s *Store) FindRefreshToken(tokenHash string) (*store.RefreshToken, error) {
+	const statement = `SELECT id, user_id, family_id, token_hash, expires_at, used_at, revoked_at, created_at, mfa FROM refresh_tokens where token_hash = $1;`
+	token := &store.RefreshToken{}
+	var usedAt sql.NullTime
+	var revokedAt sql.NullTime
+	if err := s.db.QueryRow(statement, tokenHash).Scan(
+		&token.ID,
+		&token.UserID,
+		&token.FamilyID,
+		&token.TokenHash,
+		&token.ExpiresAt,
+		&usedAt,
+		&revokedAt,
+		&token.CreatedAt,
+		&token.MFA,
+	); err != nil {
+		return nil, err
+	}
+	if usedAt.Valid {
+		token.UsedAt = &usedAt.Time
+	}
+	if revokedAt.Valid {
+		token.RevokedAt = &revokedAt.Time
+	}
+	return token, nil
+}
+
+// RotateRefreshToken marks the used token as consumed and stores its successor.
+// It returns store.ErrAlreadyUsed when the token was consumed or revoked meanwhile.
+func (s *Store) RotateRefreshToken(usedID int32, next *store.RefreshToken) error {
+	return s.withTx(func(tx *sql.Tx) error {
+		const updateStatement = `Update refresh_tokens set used_at = current_timestamp where id = $1 and used_at is null and revoked_at is null;`
+		result, err := tx.Exec(updateStatement, usedID)
+		if err != nil {
+			return err
+		}
+		if count, err := result.RowsAffected(); err != nil {
+			return err
+		} else if count == 0 {
+			return store.ErrAlreadyUsed
+		}
+
+		const insertStatement = `INSERT INTO refresh_tokens (user_id, family_id, token_hash, expires_at, mfa) VALUES ($1, $2, $3, $4, $5) RETURNING id, created_at`
+		return tx.QueryRow(insertStatement,
+			next.UserID,
+			next.FamilyID,
+			next.TokenHash,
+			next.ExpiresAt,
+			next.MFA,
+		).Scan(&next.ID, &next.CreatedAt)
+	})
+}
+
+// RevokeRefreshTokenFamily revokes every token descending from the same login.
+func (s *Store) RevokeRefreshTokenFamily(familyID string) error {
+	const updateStatement = `Update refresh_tokens set revoked_at = current_timestamp where family_id = $1 and revoked_at is null;`
+	_, err := s.db.Exec(updateStatement, familyID)
+	return err
+}
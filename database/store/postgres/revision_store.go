@@ -0,0 +1,149 @@
+package postgres
+
+import (
+	"database/sql"
+	"fmt"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/lib/pq"
+	"github.com/ranabd36/project-qa/database/store"
+	"github.com/ranabd36/project-qa/pb"
+	"time"
+)
+
+// revisionStatements select the revisions of each target, answers have no title.
+var revisionStatements = map[pb.Revision_Target]string{
+	pb.Revision_QUESTION: `SELECT question_id, revision, user_id, title, description, created_at FROM question_revisions`,
+	pb.Revision_ANSWER:   `SELECT answer_id, revision, user_id, '', description, created_at FROM answer_revisions`,
+}
+
+// ListRevisions returns the revisions of the question or answer older than
+// before, newest first. A before of 0 starts at the newest revision.
+func (s *Store) ListRevisions(target pb.Revision_Target, targetID int32, before int32, limit int32) ([]*pb.Revision, error) {
+	statement, ok := revisionStatements[target]
+	if !ok {
+		return nil, fmt.Errorf("unknown revision target: %v", target)
+	}
+	statement += fmt.Sprintf(` where %s = $1 and ($2 = 0 or revision < $2) order by revision desc limit $3;`, revisionColumn(target))
+	rows, err := s.db.Query(statement, targetID, before, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var revisions []*pb.Revision
+	for rows.Next() {
+		revision, err := scanRevision(rows, target)
+		if err != nil {
+			return nil, err
+		}
+		revisions = append(revisions, revision)
+	}
+	return revisions, rows.Err()
+}
+
+func (s *Store) FindRevision(target pb.Revision_Target, targetID int32, revision int32) (*pb.Revision, error) {
+	statement, ok := revisionStatements[target]
+	if !ok {
+		return nil, fmt.Errorf("unknown revision target: %v", target)
+	}
+	statement += fmt.Sprintf(` where %s = $1 and revision = $2;`, revisionColumn(target))
+	return scanRevision(s.db.QueryRow(statement, targetID, revision), target)
+}
+
+// RollbackToRevision restores the text of the revision and records it as a
+// new revision by the editor. It returns sql.ErrNoRows when the revision does
+// not exist.
+func (s *Store) RollbackToRevision(target pb.Revision_Target, targetID int32, revision int32, editorID int32) (*pb.Revision, error) {
+	var restored *pb.Revision
+	var questionID int32
+	err := s.withTx(func(tx *sql.Tx) error {
+		statement := revisionStatements[target] + fmt.Sprintf(` where %s = $1 and revision = $2;`, revisionColumn(target))
+		old, err := scanRevision(tx.QueryRow(statement, targetID, revision), target)
+		if err != nil {
+			return err
+		}
+
+		if target == pb.Revision_QUESTION {
+			const updateStatement = `Update questions set title = $2, description = $3, updated_at = current_timestamp where id = $1;`
+			if err := executeTxStatement(tx, updateStatement, targetID, old.GetTitle(), old.GetDescription()); err != nil {
+				return err
+			}
+			questionID = targetID
+		} else {
+			const updateStatement = `Update answers set description = $2, updated_at = current_timestamp where id = $1 RETURNING question_id;`
+			if err := tx.QueryRow(updateStatement, targetID, old.GetDescription()).Scan(&questionID); err != nil {
+				return err
+			}
+		}
+		restored, err = saveRevision(tx, target, targetID, editorID, old.GetTitle(), old.GetDescription())
+		return err
+	})
+
+	if err != nil {
+		if pqErr, ok := err.(*pq.Error); ok && pqErr.Code == "23505" {
+			return nil, store.ErrAlreadyExists
+		}
+		return nil, err
+	}
+	if target == pb.Revision_QUESTION {
+		s.publish(store.EventQuestionUpdated, questionID, 0, 0)
+	} else {
+		s.publish(store.EventAnswerUpdated, questionID, targetID, 0)
+	}
+	return restored, nil
+}
+
+// saveRevision records the text of the question or answer as its next
+// revision. It must run after the row was updated in tx, whose lock on the
+// row keeps the revision numbers of concurrent updates apart.
+func saveRevision(tx *sql.Tx, target pb.Revision_Target, targetID int32, editorID int32, title string, description string) (*pb.Revision, error) {
+	revision := &pb.Revision{
+		Target:      target,
+		TargetId:    targetID,
+		UserId:      editorID,
+		Title:       title,
+		Description: description,
+	}
+	var createdAt time.Time
+	var err error
+	if target == pb.Revision_QUESTION {
+		const insertStatement = `INSERT INTO question_revisions (question_id, revision, user_id, title, description)
+SELECT $1, coalesce(max(revision), 0) + 1, $2, $3, $4 FROM question_revisions WHERE question_id = $1
+RETURNING revision, created_at`
+		err = tx.QueryRow(insertStatement, targetID, editorID, title, description).Scan(&revision.Revision, &createdAt)
+	} else {
+		const insertStatement = `INSERT INTO answer_revisions (answer_id, revision, user_id, description)
+SELECT $1, coalesce(max(revision), 0) + 1, $2, $3 FROM answer_revisions WHERE answer_id = $1
+RETURNING revision, created_at`
+		err = tx.QueryRow(insertStatement, targetID, editorID, description).Scan(&revision.Revision, &createdAt)
+	}
+	if err != nil {
+		return nil, err
+	}
+	revision.CreatedAt, _ = ptypes.TimestampProto(createdAt)
+	return revision, nil
+}
+
+func revisionColumn(target pb.Revision_Target) string {
+	if target == pb.Revision_ANSWER {
+		return "answer_id"
+	}
+	return "question_id"
+}
+
+func scanRevision(row rowScanner, target pb.Revision_Target) (*pb.Revision, error) {
+	revision := &pb.Revision{Target: target}
+	var createdAt time.Time
+	if err := row.Scan(
+		&revision.TargetId,
+		&revision.Revision,
+		&revision.UserId,
+		&revision.Title,
+		&revision.Description,
+		&createdAt,
+	); err != nil {
+		return nil, err
+	}
+	revision.CreatedAt, _ = ptypes.TimestampProto(createdAt)
+	return revision, nil
+}
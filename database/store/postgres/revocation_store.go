@@ -0,0 +1,72 @@
+package postgres
+
+import (
+	"time"
+)
+
+func (s *Store) RevokeToken(jti string, username string, expiresAt time.Time) error {
+	const insertStatement = `INSERT INTO revoked_tokens (jti, username, expires_at) VALUES ($1, $2, $3) ON CONFLICT (jti) DO NOTHING;`
+	_, err := s.db.Exec(insertStatement, jti, username, expiresAt)
+	return err
+}
+
+func (s *Store) RevokeUserTokens(username string, before time.Time) error {
+	const upsertStatement = `INSERT INTO user_token_revocations (username, revoked_before) VALUES ($1, $2)
+ON CONFLICT (username) DO UPDATE SET revoked_before = greatest(user_token_revocations.revoked_before, EXCLUDED.revoked_before);`
+	_, err := s.db.Exec(upsertStatement, username, before)
+	return err
+}
+
+// ListRevokedTokens returns the expiry of every revoked token that has not expired yet, by token ID.
+func (s *Store) ListRevokedTokens(now time.Time) (map[string]time.Time, error) {
+	const statement = `SELECT jti, expires_at FROM revoked_tokens where expires_at > $1;`
+	rows, err := s.db.Query(statement, now)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	tokens := make(map[string]time.Time)
+	for rows.Next() {
+		var jti string
+		var expiresAt time.Time
+		if err := rows.Scan(&jti, &expiresAt); err != nil {
+			return nil, err
+		}
+		tokens[jti] = expiresAt
+	}
+	return tokens, rows.Err()
+}
+
+// ListUserTokenRevocations returns the revocation cut-off time by username.
+func (s *Store) ListUserTokenRevocations() (map[string]time.Time, error) {
+	const statement = `SELECT username, revoked_before FROM user_token_revocations;`
+	rows, err := s.db.Query(statement)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	revocations := make(map[string]time.Time)
+	for rows.Next() {
+		var username string
+		var revokedBefore time.Time
+		if err := rows.Scan(&username, &revokedBefore); err != nil {
+			return nil, err
+		}
+		revocations[username] = revokedBefore
+	}
+	return revocations, rows.Err()
+}
+
+func (s *Store) DeleteExpiredRevokedTokens(now time.Time) error {
+	const deleteStatement = `DELETE FROM revoked_tokens where expires_at <= $1;`
+	_, err := s.db.Exec(deleteStatement, now)
+	return err
+}
+
+func (s *Store) RevokeUserRefreshTokens(userID int32) error {
+	const updateStatement = `Update refresh_tokens set revoked_at = current_timestamp where user_id = $1 and revoked_at is null;`
+	_, err := s.db.Exec(updateStatement, userID)
+	return err
+}
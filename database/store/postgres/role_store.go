@@ -0,0 +1,193 @@
+package postgres
+
+import (
+	"database/sql"
+	"fmt"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/lib/pq"
+	"github.com/ranabd36/project-qa/database/store"
+	"github.com/ranabd36/project-qa/pb"
+	"time"
+)
+
+const roleColumns = `r.id, r.name, r.description, r.created_at,
+       ARRAY(SELECT p.name FROM role_permissions rp INNER JOIN permissions p ON p.id = rp.permission_id WHERE rp.role_id = r.id ORDER BY p.name)`
+
+func (s *Store) ListRoles() ([]*pb.Role, error) {
+	const statement = `SELECT ` + roleColumns + ` FROM roles r ORDER BY r.name;`
+	rows, err := s.db.Query(statement)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var roles []*pb.Role
+	for rows.Next() {
+		role, err := scanRole(rows)
+		if err != nil {
+			return nil, err
+		}
+		roles = append(roles, role)
+	}
+	return roles, rows.Err()
+}
+
+func (s *Store) FindRole(id int32) (*pb.Role, error) {
+	const statement = `SELECT ` + roleColumns + ` FROM roles r where r.id = $1;`
+	return scanRole(s.db.QueryRow(statement, id))
+}
+
+func (s *Store) ListPermissions() ([]*pb.Permission, error) {
+	const statement = `SELECT name, description FROM permissions ORDER BY name;`
+	rows, err := s.db.Query(statement)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var permissions []*pb.Permission
+	for rows.Next() {
+		permission := &pb.Permission{}
+		if err := rows.Scan(&permission.Name, &permission.Description); err != nil {
+			return nil, err
+		}
+		permissions = append(permissions, permission)
+	}
+	return permissions, rows.Err()
+}
+
+func (s *Store) SaveRole(role *pb.Role) error {
+	err := s.withTx(func(tx *sql.Tx) error {
+		const insertStatement = `INSERT INTO roles (name, description) VALUES ($1, $2) RETURNING id`
+		if err := tx.QueryRow(insertStatement, role.GetName(), role.GetDescription()).Scan(&role.Id); err != nil {
+			return err
+		}
+		return setRolePermissions(tx, role.GetId(), role.GetPermissions())
+	})
+
+	if err != nil {
+		pqErr, ok := err.(*pq.Error)
+		if ok && pqErr.Code == "23505" {
+			return store.ErrAlreadyExists
+		}
+		return fmt.Errorf("failed to save row: %w", err)
+	}
+	return nil
+}
+
+// UpdateRole updates the description and replaces the permissions of the role.
+func (s *Store) UpdateRole(role *pb.Role) error {
+	return s.withTx(func(tx *sql.Tx) error {
+		const updateStatement = `Update roles set description = $2 where id = $1;`
+		if err := executeTxStatement(tx, updateStatement, role.GetId(), role.GetDescription()); err != nil {
+			return err
+		}
+		return setRolePermissions(tx, role.GetId(), role.GetPermissions())
+	})
+}
+
+func (s *Store) DeleteRole(id int32) error {
+	const deleteStatement = `DELETE FROM roles where id = $1;`
+	return s.executeStatement(deleteStatement, id)
+}
+
+// AssignRole grants the role to the user. Assigning a role twice is no error.
+func (s *Store) AssignRole(userID int32, roleID int32) error {
+	return s.withTx(func(tx *sql.Tx) error {
+		const insertStatement = `INSERT INTO user_roles (user_id, role_id) VALUES ($1, $2) ON CONFLICT DO NOTHING;`
+		if _, err := tx.Exec(insertStatement, userID, roleID); err != nil {
+			return err
+		}
+		return syncAdminFlag(tx, userID)
+	})
+}
+
+// UnassignRole takes the role away from the user. Unassigning a role the user
+// does not have is no error.
+func (s *Store) UnassignRole(userID int32, roleID int32) error {
+	return s.withTx(func(tx *sql.Tx) error {
+		var isAdminRole bool
+		const roleStatement = `SELECT name = $2 FROM roles where id = $1;`
+		if err := tx.QueryRow(roleStatement, roleID, store.AdminRole).Scan(&isAdminRole); err != nil {
+			return err
+		}
+		if isAdminRole {
+			if err := ensureNotLastAdmin(tx, userID); err != nil {
+				return err
+			}
+		}
+		const deleteStatement = `DELETE FROM user_roles where user_id = $1 and role_id = $2;`
+		if _, err := tx.Exec(deleteStatement, userID, roleID); err != nil {
+			return err
+		}
+		return syncAdminFlag(tx, userID)
+	})
+}
+
+// ListRolePermissions returns the permission names of every role by role name.
+func (s *Store) ListRolePermissions() (map[string][]string, error) {
+	const statement = `SELECT r.name, p.name FROM role_permissions rp
+       INNER JOIN roles r ON r.id = rp.role_id
+       INNER JOIN permissions p ON p.id = rp.permission_id;`
+	rows, err := s.db.Query(statement)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	permissions := make(map[string][]string)
+	for rows.Next() {
+		var role, permission string
+		if err := rows.Scan(&role, &permission); err != nil {
+			return nil, err
+		}
+		permissions[role] = append(permissions[role], permission)
+	}
+	return permissions, rows.Err()
+}
+
+func setRolePermissions(tx *sql.Tx, roleID int32, permissions []string) error {
+	const deleteStatement = `DELETE FROM role_permissions where role_id = $1;`
+	if _, err := tx.Exec(deleteStatement, roleID); err != nil {
+		return err
+	}
+	const insertStatement = `INSERT INTO role_permissions (role_id, permission_id) SELECT $1, id FROM permissions where name = ANY($2);`
+	_, err := tx.Exec(insertStatement, roleID, pq.Array(permissions))
+	return err
+}
+
+// setUserRoles grants the built-in roles matching the admin flag of a new user.
+func setUserRoles(tx *sql.Tx, userID int32, isAdmin bool) error {
+	roles := []string{store.DefaultRole}
+	if isAdmin {
+		roles = append(roles, store.AdminRole)
+	}
+	const insertStatement = `INSERT INTO user_roles (user_id, role_id) SELECT $1, id FROM roles where name = ANY($2);`
+	_, err := tx.Exec(insertStatement, userID, pq.Array(roles))
+	return err
+}
+
+// syncAdminFlag sets the is_admin flag of the user to whether they have the admin role.
+func syncAdminFlag(tx *sql.Tx, userID int32) error {
+	const updateStatement = `Update users set is_admin = exists(
+    SELECT 1 FROM user_roles ur INNER JOIN roles r ON r.id = ur.role_id WHERE ur.user_id = users.id AND r.name = $2
+) where id = $1;`
+	return executeTxStatement(tx, updateStatement, userID, store.AdminRole)
+}
+
+func scanRole(row rowScanner) (*pb.Role, error) {
+	role := &pb.Role{}
+	var createdAt time.Time
+	if err := row.Scan(
+		&role.Id,
+		&role.Name,
+		&role.Description,
+		&createdAt,
+		pq.Array(&role.Permissions),
+	); err != nil {
+		return nil, err
+	}
+	c, _ := ptypes.TimestampProto(createdAt)
+	role.CreatedAt = c
+	return role, nil
+}
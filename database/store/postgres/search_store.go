@@ -0,0 +1,119 @@
+package postgres
+
+import (
+	"fmt"
+	"github.com/lib/pq"
+	"github.com/ranabd36/project-qa/database/store"
+	"strings"
+)
+
+// headlineOptions shape the snippets of search results.
+const headlineOptions = "StartSel=" + store.SnippetStart + ", StopSel=" + store.SnippetStop + ", MinWords=15, MaxWords=35, MaxFragments=2"
+
+// escapedBody HTML-escapes the body like html.EscapeString, before the headline
+// adds the only markup of the snippets.
+const escapedBody = `replace(replace(replace(replace(replace(r.body, '&', '&amp;'), '<', '&lt;'), '>', '&gt;'), '"', '&#34;'), '''', '&#39;')`
+
+const tagsOfQuestion = `ARRAY(SELECT t.name FROM question_tags qt INNER JOIN tags t ON t.id = qt.tag_id WHERE qt.question_id = q.id ORDER BY t.name)`
+
+// Search ranks the published questions and answers matching every term of the
+// query text. The search vectors are kept up to date by triggers.
+func (s *Store) Search(query store.SearchQuery) ([]*store.SearchResult, error) {
+	var args []interface{}
+	addArg := func(value interface{}) string {
+		args = append(args, value)
+		return fmt.Sprintf("$%d", len(args))
+	}
+
+	text := addArg(query.Text)
+	questionConditions := []string{"q.published_at IS NOT NULL", "q.search_vector @@ query.q"}
+	answerConditions := []string{"q.published_at IS NOT NULL", "a.search_vector @@ query.q"}
+	if query.AuthorID != 0 {
+		authorID := addArg(query.AuthorID)
+		questionConditions = append(questionConditions, "q.user_id = "+authorID)
+		answerConditions = append(answerConditions, "a.user_id = "+authorID)
+	}
+	if len(query.Tags) > 0 {
+		tagged := fmt.Sprintf(`q.id IN (SELECT qt.question_id FROM question_tags qt INNER JOIN tags t ON t.id = qt.tag_id WHERE t.name = ANY(%s) GROUP BY qt.question_id HAVING count(DISTINCT t.id) = %s)`,
+			addArg(pq.Array(query.Tags)), addArg(len(query.Tags)))
+		questionConditions = append(questionConditions, tagged)
+		answerConditions = append(answerConditions, tagged)
+	}
+
+	var parts []string
+	if query.Kind != store.SearchAnswer {
+		parts = append(parts, fmt.Sprintf(`SELECT '%s' AS kind, q.id, q.id AS question_id, q.user_id, q.title, q.description AS body, ts_rank(q.search_vector, query.q) AS rank
+    FROM questions q CROSS JOIN query WHERE %s`, store.SearchQuestion, strings.Join(questionConditions, " AND ")))
+	}
+	if query.Kind != store.SearchQuestion {
+		parts = append(parts, fmt.Sprintf(`SELECT '%s', a.id, a.question_id, a.user_id, q.title, a.description, ts_rank(a.search_vector, query.q)
+    FROM answers a INNER JOIN questions q ON q.id = a.question_id CROSS JOIN query WHERE %s`, store.SearchAnswer, strings.Join(answerConditions, " AND ")))
+	}
+
+	// the headlines are only computed for the rows of the page
+	statement := fmt.Sprintf(`WITH query AS (SELECT plainto_tsquery('english', %s) AS q)
+SELECT r.kind, r.id, r.question_id, r.user_id, r.title, ts_headline('english', %s, query.q, %s), r.rank
+FROM (%s) r CROSS JOIN query
+ORDER BY r.rank DESC, r.kind, r.id
+LIMIT %s OFFSET %s;`, text, escapedBody, addArg(headlineOptions), strings.Join(parts, " UNION ALL "), addArg(query.Limit), addArg(query.Offset))
+
+	rows, err := s.db.Query(statement, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var results []*store.SearchResult
+	for rows.Next() {
+		result := &store.SearchResult{}
+		if err := rows.Scan(
+			&result.Kind,
+			&result.ID,
+			&result.QuestionID,
+			&result.AuthorID,
+			&result.Title,
+			&result.Snippet,
+			&result.Rank,
+		); err != nil {
+			return nil, err
+		}
+		results = append(results, result)
+	}
+	return results, rows.Err()
+}
+
+// ListSearchDocuments returns every published question and answer, for search
+// indexes kept outside the database.
+func (s *Store) ListSearchDocuments() ([]*store.SearchDocument, error) {
+	const statement = `SELECT '` + store.SearchQuestion + `', q.id, q.id, q.user_id, q.title, q.description, ` + tagsOfQuestion + `
+FROM questions q
+WHERE q.published_at IS NOT NULL
+UNION ALL
+SELECT '` + store.SearchAnswer + `', a.id, a.question_id, a.user_id, q.title, a.description, ` + tagsOfQuestion + `
+FROM answers a
+         INNER JOIN questions q ON q.id = a.question_id
+WHERE q.published_at IS NOT NULL;`
+	rows, err := s.db.Query(statement)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var documents []*store.SearchDocument
+	for rows.Next() {
+		document := &store.SearchDocument{}
+		if err := rows.Scan(
+			&document.Kind,
+			&document.ID,
+			&document.QuestionID,
+			&document.AuthorID,
+			&document.Title,
+			&document.Body,
+			pq.Array(&document.Tags),
+		); err != nil {
+			return nil, err
+		}
+		documents = append(documents, document)
+	}
+	return documents, rows.Err()
+}
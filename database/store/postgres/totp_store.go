@@ -0,0 +1,79 @@
+package postgres
+
+import (
+	"database/sql"
+	"github.com/lib/pq"
+	"github.com/ranabd36/project-qa/database/store"
+	"time"
+)
+
+func (s *Store) FindTOTP(userID int32) (*store.TOTP, error) {
+	const statement = `SELECT id, coalesce(totp_secret, ''), totp_enabled, totp_last_step FROM users where id = $1;`
+	totp := &store.TOTP{}
+	if err := s.db.QueryRow(statement, userID).Scan(
+		&totp.UserID,
+		&totp.Secret,
+		&totp.Enabled,
+		&totp.LastStep,
+	); err != nil {
+		return nil, err
+	}
+	return totp, nil
+}
+
+// SaveTOTPSecret stores the secret of a new enrollment, replacing any
+// unconfirmed one. It fails for users who already enabled TOTP.
+func (s *Store) SaveTOTPSecret(userID int32, secret string) error {
+	const updateStatement = `Update users set totp_secret = $2, totp_last_step = 0 where id = $1 and not totp_enabled;`
+	return s.executeStatement(updateStatement, userID, secret)
+}
+
+// EnableTOTP confirms the enrollment and replaces the recovery codes of the user.
+func (s *Store) EnableTOTP(userID int32, step int64, recoveryCodeHashes []string) error {
+	return s.withTx(func(tx *sql.Tx) error {
+		const updateStatement = `Update users set totp_enabled = true, totp_last_step = $2 where id = $1 and totp_secret is not null and not totp_enabled;`
+		if err := executeTxStatement(tx, updateStatement, userID, step); err != nil {
+			return err
+		}
+		const deleteStatement = `DELETE FROM recovery_codes where user_id = $1;`
+		if _, err := tx.Exec(deleteStatement, userID); err != nil {
+			return err
+		}
+		const insertStatement = `INSERT INTO recovery_codes (user_id, code_hash) SELECT $1, unnest($2::varchar[]);`
+		_, err := tx.Exec(insertStatement, userID, pq.Array(recoveryCodeHashes))
+		return err
+	})
+}
+
+// UseTOTPStep records the time step of an accepted code. It returns
+// store.ErrAlreadyUsed when that step or a later one was used before, so a
+// code cannot be replayed.
+func (s *Store) UseTOTPStep(userID int32, step int64) error {
+	const updateStatement = `Update users set totp_last_step = $2 where id = $1 and totp_last_step < $2;`
+	result, err := s.db.Exec(updateStatement, userID, step)
+	if err != nil {
+		return err
+	}
+	if count, err := result.RowsAffected(); err != nil {
+		return err
+	} else if count == 0 {
+		return store.ErrAlreadyUsed
+	}
+	return nil
+}
+
+// UseRecoveryCode consumes a recovery code of the user. It returns
+// sql.ErrNoRows when the code does not exist or was used already.
+func (s *Store) UseRecoveryCode(userID int32, codeHash string, now time.Time) error {
+	const updateStatement = `Update recovery_codes set used_at = $3 where user_id = $1 and code_hash = $2 and used_at is null;`
+	result, err := s.db.Exec(updateStatement, userID, codeHash, now)
+	if err != nil {
+		return err
+	}
+	if count, err := result.RowsAffected(); err != nil {
+		return err
+	} else if count == 0 {
+		return sql.ErrNoRows
+	}
+	return nil
+}
@@ -1,6 +1,7 @@
 package postgres
 
 import (
+	"database/sql"
 	"errors"
 	"fmt"
 	"github.com/golang/protobuf/ptypes"
@@ -8,27 +9,130 @@ import (
 	"github.com/ranabd36/project-qa/database/store"
 	"github.com/ranabd36/project-qa/pb"
 	"golang.org/x/crypto/bcrypt"
+	"strings"
 	"time"
 )
 
+const userColumns = `id, first_name, last_name, username, email, password, is_active, is_admin, is_email_verified, totp_enabled, is_service_account, created_at, update_at, deleted_at, version,
+       ARRAY(SELECT r.name FROM user_roles ur INNER JOIN roles r ON r.id = ur.role_id WHERE ur.user_id = users.id ORDER BY r.name),
+       (SELECT ARRAY[coalesce(sum(points), 0),
+                     count(*) FILTER (WHERE reason IN ('` + store.ReputationQuestionUpvoted + `', '` + store.ReputationAnswerUpvoted + `')),
+                     count(*) FILTER (WHERE reason IN ('` + store.ReputationQuestionDownvoted + `', '` + store.ReputationAnswerDownvoted + `')),
+                     count(*) FILTER (WHERE reason = '` + store.ReputationAnswerAccepted + `')]
+        FROM reputation_events WHERE user_id = users.id)`
+
+var userSortColumns = map[store.UserSortField]string{
+	store.SortUsersByCreatedAt: "created_at",
+	store.SortUsersByUsername:  "lower(username)",
+	store.SortUsersByEmail:     "email",
+	store.SortUsersByID:        "id",
+}
+
 func (s *Store) FindByEmail(email string) (*pb.User, error) {
-	const statement = `SELECT id, first_name, last_name, username, email,password,is_active, is_admin, created_at, update_at FROM users where email = $1;`
+	const statement = `SELECT ` + userColumns + ` FROM users where lower(email) = lower($1) and deleted_at is null;`
 	return s.selectUser(statement, email)
 }
 
 func (s *Store) FindByUsername(username string) (*pb.User, error) {
-	const statement = `SELECT id, first_name, last_name, username, email,password,is_active, is_admin, created_at, update_at FROM users where username = $1;`
+	const statement = `SELECT ` + userColumns + ` FROM users where lower(username) = lower($1) and deleted_at is null;`
 	return s.selectUser(statement, username)
 }
 
 func (s *Store) ToggleActive(id int32) error {
-	const updateStatement = `Update users set is_active = not is_active where id = $1;`
-	return s.executeStatement(updateStatement, id)
+	return s.withTx(func(tx *sql.Tx) error {
+		if err := ensureNotLastAdmin(tx, id); err != nil {
+			return err
+		}
+		const updateStatement = `Update users set is_active = not is_active, pending_verification = false where id = $1;`
+		return executeTxStatement(tx, updateStatement, id)
+	})
 }
 
+// ToggleAdmin flips the admin flag of the user and grants or takes away the admin role with it.
 func (s *Store) ToggleAdmin(id int32) error {
-	const updateStatement = `Update users set is_admin = not is_admin where id = $1;`
-	return s.executeStatement(updateStatement, id)
+	return s.withTx(func(tx *sql.Tx) error {
+		if err := ensureNotLastAdmin(tx, id); err != nil {
+			return err
+		}
+		var isAdmin bool
+		const updateStatement = `Update users set is_admin = not is_admin where id = $1 RETURNING is_admin;`
+		if err := tx.QueryRow(updateStatement, id).Scan(&isAdmin); err != nil {
+			return err
+		}
+		return setAdminRole(tx, id, isAdmin)
+	})
+}
+
+// SetUserFlags sets the flags given as non-nil values. With a non-zero version
+// it fails with store.ErrVersionConflict when the user was changed since.
+// Setting a flag to its current value is no error.
+func (s *Store) SetUserFlags(id int32, isActive *bool, isAdmin *bool, version int32) error {
+	return s.withTx(func(tx *sql.Tx) error {
+		var current struct {
+			isActive bool
+			isAdmin  bool
+			version  int32
+		}
+		const selectStatement = `SELECT is_active, is_admin, version FROM users where id = $1 and deleted_at is null FOR UPDATE;`
+		if err := tx.QueryRow(selectStatement, id).Scan(&current.isActive, &current.isAdmin, &current.version); err != nil {
+			return err
+		}
+		if version != 0 && version != current.version {
+			return store.ErrVersionConflict
+		}
+		
+		active, admin := current.isActive, current.isAdmin
+		if isActive != nil {
+			active = *isActive
+		}
+		if isAdmin != nil {
+			admin = *isAdmin
+		}
+		if (current.isActive && !active) || (current.isAdmin && !admin) {
+			if err := ensureNotLastAdmin(tx, id); err != nil {
+				return err
+			}
+		}
+		
+		// setting the active flag overrides the activation by email verification
+		const updateStatement = `Update users set is_active = $2, is_admin = $3, pending_verification = pending_verification and not $4 where id = $1;`
+		if _, err := tx.Exec(updateStatement, id, active, admin, isActive != nil); err != nil {
+			return err
+		}
+		return setAdminRole(tx, id, admin)
+	})
+}
+
+// setAdminRole grants or takes away the admin role to match the admin flag.
+func setAdminRole(tx *sql.Tx, id int32, isAdmin bool) error {
+	if isAdmin {
+		const insertStatement = `INSERT INTO user_roles (user_id, role_id) SELECT $1, id FROM roles where name = $2 ON CONFLICT DO NOTHING;`
+		_, err := tx.Exec(insertStatement, id, store.AdminRole)
+		return err
+	}
+	const deleteStatement = `DELETE FROM user_roles where user_id = $1 and role_id = (SELECT id FROM roles where name = $2);`
+	_, err := tx.Exec(deleteStatement, id, store.AdminRole)
+	return err
+}
+
+// ensureNotLastAdmin fails with store.ErrLastAdmin when the user is the only
+// active admin left. It locks the admin role, so concurrent changes cannot
+// each remove one of the last two admins.
+func ensureNotLastAdmin(tx *sql.Tx, id int32) error {
+	const lockStatement = `SELECT id FROM roles where name = $1 FOR UPDATE;`
+	if _, err := tx.Exec(lockStatement, store.AdminRole); err != nil {
+		return err
+	}
+	var last bool
+	const statement = `SELECT exists(SELECT 1 FROM users where id = $1 and is_admin and is_active and deleted_at is null)
+   and not exists(SELECT 1 FROM users where id <> $1 and is_admin and is_active and deleted_at is null);`
+	if err := tx.QueryRow(statement, id).Scan(&last); err != nil {
+		return err
+	}
+	if last {
+		return store.ErrLastAdmin
+	}
+	return nil
 }
 
 func (s *Store) UpdatePassword(id int32, newPassword string) error {
@@ -40,18 +144,70 @@ func (s *Store) UpdatePassword(id int32, newPassword string) error {
 	return s.executeStatement(updateStatement, id, hasPassword)
 }
 
-func (s *Store) Delete(id int32) error {
-	const deleteStatement = `DELETE from users where id = $1;`
+// VerifyEmail marks the email of the user verified, activating users that
+// registered and were not deactivated since.
+func (s *Store) VerifyEmail(id int32) error {
+	const updateStatement = `Update users set is_email_verified = true, is_active = is_active or pending_verification, pending_verification = false where id = $1;`
+	return s.executeStatement(updateStatement, id)
+}
+
+// DeleteUnverified removes a user that never verified their email, freeing
+// their username and email. It is meant for registrations that could not be
+// completed, before the user authored anything.
+func (s *Store) DeleteUnverified(id int32) error {
+	const deleteStatement = `DELETE FROM users where id = $1 and not is_email_verified;`
 	return s.executeStatement(deleteStatement, id)
 }
 
+// Delete marks the user as deleted. Deleted users are left out by every Find
+// and can be brought back by Restore until they are purged.
+func (s *Store) Delete(id int32) error {
+	return s.withTx(func(tx *sql.Tx) error {
+		if err := ensureNotLastAdmin(tx, id); err != nil {
+			return err
+		}
+		const updateStatement = `Update users set deleted_at = current_timestamp where id = $1 and deleted_at is null;`
+		return executeTxStatement(tx, updateStatement, id)
+	})
+}
+
+// Restore brings back a deleted user that was not purged.
+func (s *Store) Restore(id int32) error {
+	const updateStatement = `Update users set deleted_at = null where id = $1 and deleted_at is not null and purged_at is null;`
+	return s.executeStatement(updateStatement, id)
+}
+
+// Purge anonymizes a deleted user and removes everything they could sign in
+// with. The row is kept as "Deleted User", so authored questions and answers
+// stay attributed to it.
+func (s *Store) Purge(id int32) error {
+	return s.withTx(func(tx *sql.Tx) error {
+		const failuresStatement = `DELETE FROM login_failures where key = (SELECT 'user:' || lower(username) FROM users where id = $1);`
+		if _, err := tx.Exec(failuresStatement, id); err != nil {
+			return err
+		}
+		const updateStatement = `Update users set first_name = 'Deleted', last_name = 'User', username = 'deleted_' || id, email = 'deleted_' || id || '@deleted.invalid',
+    password = '', is_active = false, is_admin = false, is_email_verified = false, totp_secret = null, totp_enabled = false, purged_at = current_timestamp
+where id = $1 and deleted_at is not null and purged_at is null;`
+		if err := executeTxStatement(tx, updateStatement, id); err != nil {
+			return err
+		}
+		for _, table := range []string{"user_roles", "refresh_tokens", "password_reset_tokens", "recovery_codes", "api_keys"} {
+			if _, err := tx.Exec(`DELETE FROM `+table+` where user_id = $1;`, id); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
 func (s *Store) Update(user *pb.User) error {
 	const updateStatement = `Update users set first_name = $2, last_name = $3 where id = $1;`
 	return s.executeStatement(updateStatement, user.GetId(), user.GetFirstName(), user.GetLastName())
 }
 
 func (s *Store) Find(id int32) (*pb.User, error) {
-	const statement = `SELECT id, first_name, last_name, username, email, password, is_active, is_admin, created_at, update_at FROM users where id = $1;`
+	const statement = `SELECT ` + userColumns + ` FROM users where id = $1 and deleted_at is null;`
 	return s.selectUser(statement, id)
 }
 
@@ -60,17 +216,26 @@ func (s *Store) Save(user *pb.User) error {
 	if err != nil {
 		return err
 	}
-	const insertStatement = `INSERT INTO users (first_name, last_name, username, email, password, is_active, is_admin) VALUES ($1, $2, $3, $4, $5, $6, $7) RETURNING id`
+	const insertStatement = `INSERT INTO users (first_name, last_name, username, email, password, is_active, is_admin, is_email_verified, is_service_account, pending_verification) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10) RETURNING id`
 	
-	err = s.db.QueryRow(insertStatement,
-		user.FirstName,
-		user.FirstName,
-		user.Username,
-		user.Email,
-		hasPassword,
-		user.IsActive,
-		user.IsAdmin,
-	).Scan(&user.Id)
+	err = s.withTx(func(tx *sql.Tx) error {
+		if err := tx.QueryRow(insertStatement,
+			user.FirstName,
+			user.LastName,
+			user.Username,
+			user.Email,
+			hasPassword,
+			user.IsActive,
+			user.IsAdmin,
+			user.IsEmailVerified,
+			user.IsServiceAccount,
+			// inactive users with an unverified email are activated by verifying it
+			!user.IsActive && !user.IsEmailVerified && !user.IsServiceAccount,
+		).Scan(&user.Id); err != nil {
+			return err
+		}
+		return setUserRoles(tx, user.GetId(), user.GetIsAdmin())
+	})
 	
 	if err != nil {
 		pqErr, ok := err.(*pq.Error)
@@ -82,11 +247,96 @@ func (s *Store) Save(user *pb.User) error {
 	return nil
 }
 
+// ListUsers returns a page of users using keyset pagination, so deep pages
+// cost the same as the first one.
+func (s *Store) ListUsers(filter store.UserFilter) ([]*pb.User, error) {
+	var conditions []string
+	var args []interface{}
+	addArg := func(value interface{}) string {
+		args = append(args, value)
+		return fmt.Sprintf("$%d", len(args))
+	}
+
+	if filter.Deleted {
+		conditions = append(conditions, "deleted_at is not null")
+	} else {
+		conditions = append(conditions, "deleted_at is null")
+	}
+	if filter.IsActive != nil {
+		conditions = append(conditions, "is_active = "+addArg(*filter.IsActive))
+	}
+	if filter.IsAdmin != nil {
+		conditions = append(conditions, "is_admin = "+addArg(*filter.IsAdmin))
+	}
+	if filter.UsernamePrefix != "" {
+		conditions = append(conditions, `lower(username) LIKE `+addArg(escapeLike(strings.ToLower(filter.UsernamePrefix))+"%")+` ESCAPE '\'`)
+	}
+	if filter.EmailPrefix != "" {
+		conditions = append(conditions, `email LIKE `+addArg(escapeLike(strings.ToLower(filter.EmailPrefix))+"%")+` ESCAPE '\'`)
+	}
+	if !filter.CreatedAfter.IsZero() {
+		conditions = append(conditions, "created_at >= "+addArg(filter.CreatedAfter))
+	}
+	if !filter.CreatedBefore.IsZero() {
+		conditions = append(conditions, "created_at < "+addArg(filter.CreatedBefore))
+	}
+
+	column, ok := userSortColumns[filter.SortBy]
+	if !ok {
+		return nil, fmt.Errorf("unknown sort field: %v", filter.SortBy)
+	}
+	direction, comparison := "ASC", ">"
+	if filter.Descending {
+		direction, comparison = "DESC", "<"
+	}
+
+	if filter.After != nil {
+		if filter.SortBy == store.SortUsersByID {
+			conditions = append(conditions, "id "+comparison+" "+addArg(filter.After.ID))
+		} else {
+			value := addArg(filter.After.Value)
+			if filter.SortBy == store.SortUsersByCreatedAt {
+				value += "::timestamp"
+			}
+			conditions = append(conditions, fmt.Sprintf("(%s, id) %s (%s, %s)", column, comparison, value, addArg(filter.After.ID)))
+		}
+	}
+
+	where := "WHERE " + strings.Join(conditions, " AND ")
+	order := "id " + direction
+	if filter.SortBy != store.SortUsersByID {
+		order = column + " " + direction + ", " + order
+	}
+	statement := fmt.Sprintf(`SELECT %s FROM users %s ORDER BY %s LIMIT %s;`, userColumns, where, order, addArg(filter.Limit))
+
+	rows, err := s.db.Query(statement, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var users []*pb.User
+	for rows.Next() {
+		user, err := scanUser(rows)
+		if err != nil {
+			return nil, err
+		}
+		users = append(users, user)
+	}
+	return users, rows.Err()
+}
+
 func (s *Store) selectUser(statement string, args ...interface{}) (*pb.User, error) {
+	return scanUser(s.db.QueryRow(statement, args...))
+}
+
+func scanUser(row rowScanner) (*pb.User, error) {
 	user := &pb.User{}
 	var createdAt time.Time
 	var updatedAt time.Time
-	if err := s.db.QueryRow(statement, args...).Scan(
+	var deletedAt sql.NullTime
+	var reputation []int64
+	if err := row.Scan(
 		&user.Id,
 		&user.FirstName,
 		&user.LastName,
@@ -95,8 +345,15 @@ func (s *Store) selectUser(statement string, args ...interface{}) (*pb.User, err
 		&user.Password,
 		&user.IsActive,
 		&user.IsAdmin,
+		&user.IsEmailVerified,
+		&user.IsTotpEnabled,
+		&user.IsServiceAccount,
 		&createdAt,
 		&updatedAt,
+		&deletedAt,
+		&user.Version,
+		pq.Array(&user.Roles),
+		pq.Array(&reputation),
 	); err != nil {
 		return nil, err
 	}
@@ -104,6 +361,17 @@ func (s *Store) selectUser(statement string, args ...interface{}) (*pb.User, err
 	u, _ := ptypes.TimestampProto(updatedAt)
 	user.CreatedAt = c
 	user.UpdatedAt = u
+	if deletedAt.Valid {
+		user.DeletedAt, _ = ptypes.TimestampProto(deletedAt.Time)
+	}
+	if len(reputation) == 4 {
+		user.Reputation = &pb.Reputation{
+			Score:             int32(reputation[0]),
+			UpvotesReceived:   int32(reputation[1]),
+			DownvotesReceived: int32(reputation[2]),
+			AcceptedAnswers:   int32(reputation[3]),
+		}
+	}
 	return user, nil
 }
 
@@ -0,0 +1,116 @@
+package postgres
+
+import (
+	"database/sql"
+	"fmt"
+	"github.com/lib/pq"
+	"github.com/ranabd36/project-qa/database/store"
+)
+
+// SaveVote casts or changes the vote of the user on its target, updating the
+// score of the target and the reputation of its author in the same
+// transaction. Casting the same vote twice changes nothing.
+func (s *Store) SaveVote(vote *store.Vote) error {
+	table, column, targetID := voteTarget(vote)
+	var questionID, score int32
+	err := s.withTx(func(tx *sql.Tx) error {
+		// locking the target serializes the votes on it
+		var authorID int32
+		if err := tx.QueryRow(`SELECT user_id FROM `+table+` where id = $1 FOR UPDATE;`, targetID).Scan(&authorID); err != nil {
+			return err
+		}
+
+		var previous int32
+		err := tx.QueryRow(`SELECT id, value FROM votes where user_id = $1 and `+column+` = $2;`, vote.UserID, targetID).Scan(&vote.ID, &previous)
+		switch {
+		case err == sql.ErrNoRows:
+			insertStatement := `INSERT INTO votes (user_id, ` + column + `, value) VALUES ($1, $2, $3) RETURNING id`
+			if err := tx.QueryRow(insertStatement, vote.UserID, targetID, vote.Value).Scan(&vote.ID); err != nil {
+				return err
+			}
+		case err != nil:
+			return err
+		case previous == vote.Value:
+			return nil
+		default:
+			const updateStatement = `Update votes set value = $2, updated_at = current_timestamp where id = $1;`
+			if err := executeTxStatement(tx, updateStatement, vote.ID, vote.Value); err != nil {
+				return err
+			}
+		}
+
+		if err := tx.QueryRow(`Update `+table+` set score = score + $2 where id = $1 RETURNING score, `+questionColumnOf(table)+`;`, targetID, vote.Value-previous).Scan(&score, &questionID); err != nil {
+			return err
+		}
+		reason := voteReason(vote)
+		insertStatement := `INSERT INTO reputation_events (user_id, reason, points, ` + column + `, vote_id) VALUES ($1, $2, $3, $4, $5)
+ON CONFLICT (vote_id) DO UPDATE SET reason = EXCLUDED.reason, points = EXCLUDED.points;`
+		_, err = tx.Exec(insertStatement, authorID, reason, store.ReputationPoints[reason], targetID, vote.ID)
+		return err
+	})
+
+	if err != nil {
+		if pqErr, ok := err.(*pq.Error); ok && pqErr.Code == "23505" {
+			return store.ErrAlreadyExists
+		}
+		if err == sql.ErrNoRows {
+			return err
+		}
+		return fmt.Errorf("failed to save row: %w", err)
+	}
+	// an unchanged vote changes no score
+	if questionID != 0 {
+		s.publish(store.EventScoreChanged, questionID, vote.AnswerID, score)
+	}
+	return nil
+}
+
+// DeleteVote retracts the vote of the user on its target. The reputation
+// earned with the vote goes away with it. It returns sql.ErrNoRows when the
+// user did not vote on the target.
+func (s *Store) DeleteVote(vote *store.Vote) error {
+	table, column, targetID := voteTarget(vote)
+	var questionID, score int32
+	err := s.withTx(func(tx *sql.Tx) error {
+		deleteStatement := `DELETE FROM votes where user_id = $1 and ` + column + ` = $2 RETURNING id, value;`
+		if err := tx.QueryRow(deleteStatement, vote.UserID, targetID).Scan(&vote.ID, &vote.Value); err != nil {
+			return err
+		}
+		return tx.QueryRow(`Update `+table+` set score = score - $2 where id = $1 RETURNING score, `+questionColumnOf(table)+`;`, targetID, vote.Value).Scan(&score, &questionID)
+	})
+	if err != nil {
+		return err
+	}
+	s.publish(store.EventScoreChanged, questionID, vote.AnswerID, score)
+	return nil
+}
+
+// voteTarget returns the table, the votes column and the ID of the voted on target.
+func voteTarget(vote *store.Vote) (string, string, int32) {
+	if vote.AnswerID != 0 {
+		return "answers", "answer_id", vote.AnswerID
+	}
+	return "questions", "question_id", vote.QuestionID
+}
+
+// questionColumnOf returns the column holding the ID of the question of the
+// rows of the voted on table.
+func questionColumnOf(table string) string {
+	if table == "answers" {
+		return "question_id"
+	}
+	return "id"
+}
+
+// voteReason returns the reason of the reputation the vote earns the author of its target.
+func voteReason(vote *store.Vote) string {
+	switch {
+	case vote.AnswerID != 0 && vote.Value < 0:
+		return store.ReputationAnswerDownvoted
+	case vote.AnswerID != 0:
+		return store.ReputationAnswerUpvoted
+	case vote.Value < 0:
+		return store.ReputationQuestionDownvoted
+	}
+	return store.ReputationQuestionUpvoted
+}
@@ -0,0 +1,49 @@
+package store
+
+// Kinds of searchable documents.
+const (
+	SearchQuestion = "question"
+	SearchAnswer   = "answer"
+)
+
+// SnippetStart and SnippetStop surround the matched terms in search snippets.
+// The text of snippets is HTML-escaped, these are the only markup in them.
+const (
+	SnippetStart = "<b>"
+	SnippetStop  = "</b>"
+)
+
+// SearchQuery is a full-text search over published questions and their
+// answers. Results are ordered by rank, paged by Offset. Empty filters are
+// not applied.
+type SearchQuery struct {
+	Text     string
+	Kind     string   // SearchQuestion or SearchAnswer, both when empty
+	Tags     []string // the question must have every one of the tags
+	AuthorID int32
+	Offset   int32
+	Limit    int32
+}
+
+// SearchResult is a question or answer matching a SearchQuery.
+type SearchResult struct {
+	Kind       string
+	ID         int32
+	QuestionID int32
+	AuthorID   int32
+	Title      string // title of the question, for answers too
+	Snippet    string
+	Rank       float64
+}
+
+// SearchDocument is the searchable content of a published question or answer.
+// Answers carry the title and tags of their question.
+type SearchDocument struct {
+	Kind       string
+	ID         int32
+	QuestionID int32
+	AuthorID   int32
+	Title      string
+	Body       string
+	Tags       []string
+}
@@ -3,3 +3,13 @@ package store
 import "errors"
 
 var ErrAlreadyExists = errors.New("already exists")
+var ErrAlreadyUsed = errors.New("already used")
+var ErrVersionConflict = errors.New("version conflict")
+var ErrLastAdmin = errors.New("last active admin")
+
+// Names of the built-in roles. Every user has the DefaultRole, the AdminRole
+// is kept in sync with the is_admin flag of the user.
+const (
+	DefaultRole = "user"
+	AdminRole   = "admin"
+)
@@ -0,0 +1,51 @@
+package store
+
+import "time"
+
+// RefreshToken is a server-side record of an issued refresh token.
+// Tokens rotated from the same login share a FamilyID, MFA tells whether that
+// login passed a second factor.
+type RefreshToken struct {
+	ID        int32
+	UserID    int32
+	FamilyID  string
+	TokenHash string
+	ExpiresAt time.Time
+	UsedAt    *time.Time
+	RevokedAt *time.Time
+	CreatedAt time.Time
+	MFA       bool
+}
+
+// PasswordResetToken is a single-use token allowing to set a new password
+// without knowing the old one.
+type PasswordResetToken struct {
+	ID        int32
+	UserID    int32
+	TokenHash string
+	ExpiresAt time.Time
+}
+
+// TOTP is the time-based one-time password setup of a user. A secret that is
+// not enabled yet belongs to an unconfirmed enrollment.
+type TOTP struct {
+	UserID   int32
+	Secret   string
+	Enabled  bool
+	LastStep int64
+}
+
+// APIKey is a long-lived credential of a machine client. Requests made with
+// it only get the permissions listed in Scopes.
+type APIKey struct {
+	ID         int32
+	UserID     int32
+	Name       string
+	Prefix     string
+	KeyHash    string
+	Scopes     []string
+	ExpiresAt  *time.Time
+	LastUsedAt *time.Time
+	RevokedAt  *time.Time
+	CreatedAt  time.Time
+}
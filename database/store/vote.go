@@ -0,0 +1,28 @@
+package store
+
+// Reasons of reputation changes, the author of the target earns the points.
+const (
+	ReputationQuestionUpvoted   = "question_upvoted"
+	ReputationQuestionDownvoted = "question_downvoted"
+	ReputationAnswerUpvoted     = "answer_upvoted"
+	ReputationAnswerDownvoted   = "answer_downvoted"
+	ReputationAnswerAccepted    = "answer_accepted"
+)
+
+// ReputationPoints are the points earned for each reason.
+var ReputationPoints = map[string]int32{
+	ReputationQuestionUpvoted:   5,
+	ReputationQuestionDownvoted: -2,
+	ReputationAnswerUpvoted:     10,
+	ReputationAnswerDownvoted:   -2,
+	ReputationAnswerAccepted:    15,
+}
+
+// Vote is an up (1) or down (-1) vote of a user on either a question or an answer.
+type Vote struct {
+	ID         int32
+	UserID     int32
+	QuestionID int32
+	AnswerID   int32
+	Value      int32
+}
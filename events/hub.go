@@ -0,0 +1,69 @@
+package events
+
+import (
+	"github.com/ranabd36/project-qa/database/store"
+	"sync"
+)
+
+// subscriptionBuffer is how many events a watcher may fall behind before its
+// subscription is dropped.
+const subscriptionBuffer = 64
+
+// Hub is an in-process pub/sub of question events. Publishing never blocks,
+// a subscriber falling too far behind has its channel closed instead.
+type Hub struct {
+	mutex       sync.Mutex
+	subscribers map[int32]map[chan *store.QuestionEvent]bool
+}
+
+func NewHub() *Hub {
+	return &Hub{
+		subscribers: make(map[int32]map[chan *store.QuestionEvent]bool),
+	}
+}
+
+// Subscribe returns the channel of the events of the question and the
+// function ending the subscription. The channel is closed when the
+// subscriber fell behind.
+func (hub *Hub) Subscribe(questionID int32) (<-chan *store.QuestionEvent, func()) {
+	events := make(chan *store.QuestionEvent, subscriptionBuffer)
+
+	hub.mutex.Lock()
+	defer hub.mutex.Unlock()
+	if hub.subscribers[questionID] == nil {
+		hub.subscribers[questionID] = make(map[chan *store.QuestionEvent]bool)
+	}
+	hub.subscribers[questionID][events] = true
+
+	return events, func() {
+		hub.mutex.Lock()
+		defer hub.mutex.Unlock()
+		hub.remove(questionID, events)
+	}
+}
+
+// Publish sends the event to the subscribers of its question.
+func (hub *Hub) Publish(event *store.QuestionEvent) {
+	hub.mutex.Lock()
+	defer hub.mutex.Unlock()
+	for events := range hub.subscribers[event.QuestionID] {
+		select {
+		case events <- event:
+		default:
+			hub.remove(event.QuestionID, events)
+		}
+	}
+}
+
+// remove closes the channel of the subscription unless it was removed before.
+func (hub *Hub) remove(questionID int32, events chan *store.QuestionEvent) {
+	subscribers := hub.subscribers[questionID]
+	if !subscribers[events] {
+		return
+	}
+	delete(subscribers, events)
+	close(events)
+	if len(subscribers) == 0 {
+		delete(hub.subscribers, questionID)
+	}
+}
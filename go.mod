@@ -8,7 +8,9 @@ require (
 	github.com/joho/godotenv v1.3.0
 	github.com/lib/pq v1.3.0
 	github.com/pkg/errors v0.9.1 // indirect
+	github.com/pquerna/otp v1.2.0
 	github.com/pressly/goose v2.6.0+incompatible
 	golang.org/x/crypto v0.0.0-20190308221718-c2843e01d9a2
+	google.golang.org/genproto v0.0.0-20190819201941-24fa4b261c55
 	google.golang.org/grpc v1.28.0
 )
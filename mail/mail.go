@@ -0,0 +1,47 @@
+package mail
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"sync"
+	"time"
+)
+
+// WriterMailer writes every email to an io.Writer instead of delivering it.
+// It is meant for local development, where the messages end up on stdout or in a file.
+type WriterMailer struct {
+	from   string
+	mutex  sync.Mutex
+	writer io.Writer
+}
+
+func NewWriterMailer(from string, writer io.Writer) *WriterMailer {
+	return &WriterMailer{from: from, writer: writer}
+}
+
+func NewStdoutMailer(from string) *WriterMailer {
+	return NewWriterMailer(from, os.Stdout)
+}
+
+// NewFileMailer appends the emails to the file at path, creating it if needed.
+func NewFileMailer(from string, path string) (*WriterMailer, error) {
+	file, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0600)
+	if err != nil {
+		return nil, err
+	}
+	return NewWriterMailer(from, file), nil
+}
+
+func (mailer *WriterMailer) Send(to string, subject string, body string) error {
+	mailer.mutex.Lock()
+	defer mailer.mutex.Unlock()
+	_, err := fmt.Fprintf(mailer.writer, "Date: %v\nFrom: %v\nTo: %v\nSubject: %v\n\n%v\n\n",
+		time.Now().Format(time.RFC1123Z),
+		mailer.from,
+		to,
+		subject,
+		body,
+	)
+	return err
+}
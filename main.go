@@ -1,11 +1,16 @@
 package main
 
 import (
+	"database/sql"
 	"fmt"
 	"github.com/ranabd36/project-qa/config"
 	"github.com/ranabd36/project-qa/database"
+	"github.com/ranabd36/project-qa/database/store"
 	"github.com/ranabd36/project-qa/database/store/postgres"
+	"github.com/ranabd36/project-qa/events"
+	"github.com/ranabd36/project-qa/mail"
 	"github.com/ranabd36/project-qa/pb"
+	"github.com/ranabd36/project-qa/search"
 	"github.com/ranabd36/project-qa/services"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials"
@@ -46,25 +51,114 @@ func main() {
 		opts = append(opts, grpc.Creds(creds))
 	}
 	
+	hub := events.NewHub()
+	stopEventWatch := make(chan struct{})
+	publisher, err := newEventPublisher(db, hub, stopEventWatch)
+	if err != nil {
+		log.Fatalf("Failed to create event publisher: %v", err)
+	}
+	
 	//Register USer Service Server
-	store := postgres.NewStore(db)
-	jwtManager := services.NewJWTManager(config.Auth.SecretKey, config.Auth.TokenDuration)
-	authServer := services.NewAuthServer(store, jwtManager)
-	userServiceServer := services.NewUserServiceServer(store)
-	authInterceptor := services.NewAuthInterceptor(jwtManager, accessibleRoles())
+	store := postgres.NewStore(db, publisher)
+	stopKeyWatch := make(chan struct{})
+	var keySet *services.KeySet
+	if config.Auth.KeyDir != "" {
+		keySet = services.NewKeySet(config.Auth.KeyDir, config.Auth.SigningKeyID)
+		if err := keySet.Load(); err != nil {
+			log.Fatalf("Failed to load signing keys: %v", err)
+		}
+		go keySet.Watch(config.Auth.KeyRefreshInterval, stopKeyWatch)
+	}
+	jwtManager, err := services.NewJWTManager(config.Auth.SecretKey, config.Auth.TokenDuration, keySet)
+	if err != nil {
+		log.Fatalf("Failed to create token manager: %v", err)
+	}
+	revocationList := services.NewRevocationList(store)
+	if err := revocationList.Load(); err != nil {
+		log.Fatalf("Failed to load revoked tokens: %v", err)
+	}
+	stopRevocationWatch := make(chan struct{})
+	go revocationList.Watch(config.Auth.RevocationRefreshInterval, stopRevocationWatch)
 	
-	opts = append(opts, grpc.UnaryInterceptor(authInterceptor.Unary()))
+	accessPolicy := services.NewAccessPolicy(store)
+	if err := accessPolicy.Load(); err != nil {
+		log.Fatalf("Failed to load permissions: %v", err)
+	}
+	stopPolicyWatch := make(chan struct{})
+	go accessPolicy.Watch(config.Auth.PermissionRefreshInterval, stopPolicyWatch)
+	
+	passwordPolicy, err := services.NewPasswordPolicy(config.Password)
+	if err != nil {
+		log.Fatalf("Failed to create password policy: %v", err)
+	}
+	
+	mailer, err := newMailer()
+	if err != nil {
+		log.Fatalf("Failed to create mailer: %v", err)
+	}
+	
+	stopSearchWatch := make(chan struct{})
+	searcher, err := newSearcher(store, stopSearchWatch)
+	if err != nil {
+		log.Fatalf("Failed to create search: %v", err)
+	}
+	
+	authServer := services.NewAuthServer(
+		store,
+		store,
+		store,
+		jwtManager,
+		revocationList,
+		mailer,
+		passwordPolicy,
+		services.NewLoginThrottler(store, config.Login),
+		services.NewTOTPManager(store, config.Auth.TOTPIssuer),
+		services.TokenDurations{
+			RefreshToken:      config.Auth.RefreshTokenDuration,
+			EmailVerification: config.Auth.VerificationTokenDuration,
+			PasswordReset:     config.Auth.PasswordResetTokenDuration,
+			MFAChallenge:      config.Auth.MFAChallengeDuration,
+		},
+	)
+	stopPasswordResets := make(chan struct{})
+	go authServer.SendPasswordResets(stopPasswordResets)
+	userServiceServer := services.NewUserServiceServer(store, revocationList, passwordPolicy)
+	questionServiceServer := services.NewQuestionServiceServer(store, store, store, hub)
+	answerServiceServer := services.NewAnswerServiceServer(store, store, store)
+	roleServiceServer := services.NewRoleServiceServer(store, store, revocationList, accessPolicy)
+	apiKeyManager := services.NewAPIKeyManager(store, store, revocationList)
+	apiKeyServiceServer := services.NewAPIKeyServiceServer(store, store, apiKeyManager, accessPolicy)
+	authInterceptor := services.NewAuthInterceptor(jwtManager, apiKeyManager, revocationList, accessPolicy, config.Auth.RequireAdminMFA)
+	auditor := services.NewAuditor(store, authInterceptor)
+	auditServiceServer := services.NewAuditServiceServer(store)
+	searchServiceServer := services.NewSearchServiceServer(searcher)
+	voteServiceServer := services.NewVoteServiceServer(store, store, store, store)
+	revisionServiceServer := services.NewRevisionServiceServer(store, store, store, store)
+	
+	// the auditor runs first, so calls denied by the auth interceptor are audited too
+	opts = append(opts, grpc.ChainUnaryInterceptor(auditor.Unary(), authInterceptor.Unary()))
 	opts = append(opts, grpc.StreamInterceptor(authInterceptor.Stream()))
 	
 	s := grpc.NewServer(opts...)
 	
 	
-	//questionServiceServer := services.NewNewQuestionServiceServer(store)
 	pb.RegisterAuthServiceServer(s, authServer)
 	pb.RegisterUserServiceServerServer(s, userServiceServer)
+	pb.RegisterQuestionServiceServer(s, questionServiceServer)
+	pb.RegisterAnswerServiceServer(s, answerServiceServer)
+	pb.RegisterRoleServiceServer(s, roleServiceServer)
+	pb.RegisterAPIKeyServiceServer(s, apiKeyServiceServer)
+	pb.RegisterAuditServiceServer(s, auditServiceServer)
+	pb.RegisterSearchServiceServer(s, searchServiceServer)
+	pb.RegisterVoteServiceServer(s, voteServiceServer)
+	pb.RegisterRevisionServiceServer(s, revisionServiceServer)
 	
 	reflection.Register(s)
 	
+	if err := authInterceptor.LoadMethodPolicies(s.GetServiceInfo(), "grpc.reflection.v1alpha.ServerReflection"); err != nil {
+		log.Fatalf("Failed to load access policies: %v", err)
+	}
+	
 	go func() {
 		if err := s.Serve(lis); err != nil {
 			log.Fatalf("failed to serve: %v", err)
@@ -72,11 +166,17 @@ func main() {
 	}()
 	
 	// Wait for Control C to exit
-	ch := make(chan os.Signal)
+	ch := make(chan os.Signal, 1)
 	signal.Notify(ch, os.Interrupt)
 	
 	// Block until a signal is received
 	<-ch
+	close(stopRevocationWatch)
+	close(stopKeyWatch)
+	close(stopPolicyWatch)
+	close(stopSearchWatch)
+	close(stopEventWatch)
+	close(stopPasswordResets)
 	
 	//Close database connection
 	if err := db.Close(); err != nil {
@@ -92,15 +192,47 @@ func main() {
 	
 }
 
-func accessibleRoles() map[string][]string {
-	const userServicePath = "/ranabd36.qaengine.UserService/"
-	return map[string][]string{
-		userServicePath + "FindUser":       {"admin", "user"},
-		userServicePath + "UpdateUser":     {"admin", "user"},
-		userServicePath + "ChangePassword": {"admin", "user"},
-		userServicePath + "DeleteUser":     {"admin"},
-		userServicePath + "ToggleAdmin":    {"admin"},
-		userServicePath + "ToggleActive":   {"admin"},
-		userServicePath + "CreateUser":     {"admin"},
+func newMailer() (services.Mailer, error) {
+	switch config.Mail.Driver {
+	case "stdout":
+		return mail.NewStdoutMailer(config.Mail.From), nil
+	case "file":
+		return mail.NewFileMailer(config.Mail.From, config.Mail.FilePath)
+	}
+	return nil, fmt.Errorf("unknown mail driver: %v", config.Mail.Driver)
+}
+
+// newSearcher returns the configured full-text search. The memory index is
+// rebuilt in the background until stop is closed.
+func newSearcher(store *postgres.Store, stop <-chan struct{}) (services.Searcher, error) {
+	switch config.Search.Driver {
+	case "postgres":
+		return store, nil
+	case "memory":
+		index := search.NewMemoryIndex(store)
+		if err := index.Load(); err != nil {
+			return nil, err
+		}
+		go index.Watch(config.Search.RefreshInterval, stop)
+		return index, nil
+	}
+	return nil, fmt.Errorf("unknown search driver: %v", config.Search.Driver)
+}
+
+// newEventPublisher returns the configured publisher of question events. With
+// the postgres driver the events reach the hub through a listener running
+// until stop is closed.
+func newEventPublisher(db *sql.DB, hub *events.Hub, stop <-chan struct{}) (store.EventPublisher, error) {
+	switch config.Events.Driver {
+	case "memory":
+		return hub, nil
+	case "postgres":
+		listener, err := postgres.NewEventListener(database.GetDBString(), hub)
+		if err != nil {
+			return nil, err
+		}
+		go listener.Watch(stop)
+		return postgres.NewEventNotifier(db), nil
 	}
+	return nil, fmt.Errorf("unknown events driver: %v", config.Events.Driver)
 }
@@ -0,0 +1,952 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: answer_service_message.proto
+
+package pb
+
+import (
+	context "context"
+	fmt "fmt"
+	proto "github.com/golang/protobuf/proto"
+	timestamp "github.com/golang/protobuf/ptypes/timestamp"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	math "math"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
+
+type Answer struct {
+	Id                   int32                `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	UserId               int32                `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	QuestionId           int32                `protobuf:"varint,3,opt,name=question_id,json=questionId,proto3" json:"question_id,omitempty"`
+	AnswerId             int32                `protobuf:"varint,4,opt,name=answer_id,json=answerId,proto3" json:"answer_id,omitempty"`
+	Description          string               `protobuf:"bytes,5,opt,name=description,proto3" json:"description,omitempty"`
+	IsAccepted           bool                 `protobuf:"varint,6,opt,name=is_accepted,json=isAccepted,proto3" json:"is_accepted,omitempty"`
+	CreatedAt            *timestamp.Timestamp `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt            *timestamp.Timestamp `protobuf:"bytes,8,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	Replies              []*Answer            `protobuf:"bytes,9,rep,name=replies,proto3" json:"replies,omitempty"`
+	Score                int32                `protobuf:"varint,10,opt,name=score,proto3" json:"score,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *Answer) Reset()         { *m = Answer{} }
+func (m *Answer) String() string { return proto.CompactTextString(m) }
+func (*Answer) ProtoMessage()    {}
+func (*Answer) Descriptor() ([]byte, []int) {
+	return fileDescriptor_06b71796254db90a, []int{0}
+}
+
+func (m *Answer) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Answer.Unmarshal(m, b)
+}
+func (m *Answer) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Answer.Marshal(b, m, deterministic)
+}
+func (m *Answer) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Answer.Merge(m, src)
+}
+func (m *Answer) XXX_Size() int {
+	return xxx_messageInfo_Answer.Size(m)
+}
+func (m *Answer) XXX_DiscardUnknown() {
+	xxx_messageInfo_Answer.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Answer proto.InternalMessageInfo
+
+func (m *Answer) GetId() int32 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *Answer) GetUserId() int32 {
+	if m != nil {
+		return m.UserId
+	}
+	return 0
+}
+
+func (m *Answer) GetQuestionId() int32 {
+	if m != nil {
+		return m.QuestionId
+	}
+	return 0
+}
+
+func (m *Answer) GetAnswerId() int32 {
+	if m != nil {
+		return m.AnswerId
+	}
+	return 0
+}
+
+func (m *Answer) GetDescription() string {
+	if m != nil {
+		return m.Description
+	}
+	return ""
+}
+
+func (m *Answer) GetIsAccepted() bool {
+	if m != nil {
+		return m.IsAccepted
+	}
+	return false
+}
+
+func (m *Answer) GetCreatedAt() *timestamp.Timestamp {
+	if m != nil {
+		return m.CreatedAt
+	}
+	return nil
+}
+
+func (m *Answer) GetUpdatedAt() *timestamp.Timestamp {
+	if m != nil {
+		return m.UpdatedAt
+	}
+	return nil
+}
+
+func (m *Answer) GetReplies() []*Answer {
+	if m != nil {
+		return m.Replies
+	}
+	return nil
+}
+
+func (m *Answer) GetScore() int32 {
+	if m != nil {
+		return m.Score
+	}
+	return 0
+}
+
+type PostAnswerRequest struct {
+	QuestionId           int32    `protobuf:"varint,1,opt,name=question_id,json=questionId,proto3" json:"question_id,omitempty"`
+	Description          string   `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PostAnswerRequest) Reset()         { *m = PostAnswerRequest{} }
+func (m *PostAnswerRequest) String() string { return proto.CompactTextString(m) }
+func (*PostAnswerRequest) ProtoMessage()    {}
+func (*PostAnswerRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_06b71796254db90a, []int{1}
+}
+
+func (m *PostAnswerRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PostAnswerRequest.Unmarshal(m, b)
+}
+func (m *PostAnswerRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PostAnswerRequest.Marshal(b, m, deterministic)
+}
+func (m *PostAnswerRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PostAnswerRequest.Merge(m, src)
+}
+func (m *PostAnswerRequest) XXX_Size() int {
+	return xxx_messageInfo_PostAnswerRequest.Size(m)
+}
+func (m *PostAnswerRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_PostAnswerRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PostAnswerRequest proto.InternalMessageInfo
+
+func (m *PostAnswerRequest) GetQuestionId() int32 {
+	if m != nil {
+		return m.QuestionId
+	}
+	return 0
+}
+
+func (m *PostAnswerRequest) GetDescription() string {
+	if m != nil {
+		return m.Description
+	}
+	return ""
+}
+
+type PostAnswerResponse struct {
+	Id                   int32    `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PostAnswerResponse) Reset()         { *m = PostAnswerResponse{} }
+func (m *PostAnswerResponse) String() string { return proto.CompactTextString(m) }
+func (*PostAnswerResponse) ProtoMessage()    {}
+func (*PostAnswerResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_06b71796254db90a, []int{2}
+}
+
+func (m *PostAnswerResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PostAnswerResponse.Unmarshal(m, b)
+}
+func (m *PostAnswerResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PostAnswerResponse.Marshal(b, m, deterministic)
+}
+func (m *PostAnswerResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PostAnswerResponse.Merge(m, src)
+}
+func (m *PostAnswerResponse) XXX_Size() int {
+	return xxx_messageInfo_PostAnswerResponse.Size(m)
+}
+func (m *PostAnswerResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_PostAnswerResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PostAnswerResponse proto.InternalMessageInfo
+
+func (m *PostAnswerResponse) GetId() int32 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+type PostReplyRequest struct {
+	AnswerId             int32    `protobuf:"varint,1,opt,name=answer_id,json=answerId,proto3" json:"answer_id,omitempty"`
+	Description          string   `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PostReplyRequest) Reset()         { *m = PostReplyRequest{} }
+func (m *PostReplyRequest) String() string { return proto.CompactTextString(m) }
+func (*PostReplyRequest) ProtoMessage()    {}
+func (*PostReplyRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_06b71796254db90a, []int{3}
+}
+
+func (m *PostReplyRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PostReplyRequest.Unmarshal(m, b)
+}
+func (m *PostReplyRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PostReplyRequest.Marshal(b, m, deterministic)
+}
+func (m *PostReplyRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PostReplyRequest.Merge(m, src)
+}
+func (m *PostReplyRequest) XXX_Size() int {
+	return xxx_messageInfo_PostReplyRequest.Size(m)
+}
+func (m *PostReplyRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_PostReplyRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PostReplyRequest proto.InternalMessageInfo
+
+func (m *PostReplyRequest) GetAnswerId() int32 {
+	if m != nil {
+		return m.AnswerId
+	}
+	return 0
+}
+
+func (m *PostReplyRequest) GetDescription() string {
+	if m != nil {
+		return m.Description
+	}
+	return ""
+}
+
+type PostReplyResponse struct {
+	Id                   int32    `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PostReplyResponse) Reset()         { *m = PostReplyResponse{} }
+func (m *PostReplyResponse) String() string { return proto.CompactTextString(m) }
+func (*PostReplyResponse) ProtoMessage()    {}
+func (*PostReplyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_06b71796254db90a, []int{4}
+}
+
+func (m *PostReplyResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PostReplyResponse.Unmarshal(m, b)
+}
+func (m *PostReplyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PostReplyResponse.Marshal(b, m, deterministic)
+}
+func (m *PostReplyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PostReplyResponse.Merge(m, src)
+}
+func (m *PostReplyResponse) XXX_Size() int {
+	return xxx_messageInfo_PostReplyResponse.Size(m)
+}
+func (m *PostReplyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_PostReplyResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PostReplyResponse proto.InternalMessageInfo
+
+func (m *PostReplyResponse) GetId() int32 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+type ListAnswersRequest struct {
+	QuestionId           int32    `protobuf:"varint,1,opt,name=question_id,json=questionId,proto3" json:"question_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListAnswersRequest) Reset()         { *m = ListAnswersRequest{} }
+func (m *ListAnswersRequest) String() string { return proto.CompactTextString(m) }
+func (*ListAnswersRequest) ProtoMessage()    {}
+func (*ListAnswersRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_06b71796254db90a, []int{5}
+}
+
+func (m *ListAnswersRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListAnswersRequest.Unmarshal(m, b)
+}
+func (m *ListAnswersRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListAnswersRequest.Marshal(b, m, deterministic)
+}
+func (m *ListAnswersRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListAnswersRequest.Merge(m, src)
+}
+func (m *ListAnswersRequest) XXX_Size() int {
+	return xxx_messageInfo_ListAnswersRequest.Size(m)
+}
+func (m *ListAnswersRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListAnswersRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListAnswersRequest proto.InternalMessageInfo
+
+func (m *ListAnswersRequest) GetQuestionId() int32 {
+	if m != nil {
+		return m.QuestionId
+	}
+	return 0
+}
+
+type ListAnswersResponse struct {
+	Answers              []*Answer `protobuf:"bytes,1,rep,name=answers,proto3" json:"answers,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
+	XXX_unrecognized     []byte    `json:"-"`
+	XXX_sizecache        int32     `json:"-"`
+}
+
+func (m *ListAnswersResponse) Reset()         { *m = ListAnswersResponse{} }
+func (m *ListAnswersResponse) String() string { return proto.CompactTextString(m) }
+func (*ListAnswersResponse) ProtoMessage()    {}
+func (*ListAnswersResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_06b71796254db90a, []int{6}
+}
+
+func (m *ListAnswersResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListAnswersResponse.Unmarshal(m, b)
+}
+func (m *ListAnswersResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListAnswersResponse.Marshal(b, m, deterministic)
+}
+func (m *ListAnswersResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListAnswersResponse.Merge(m, src)
+}
+func (m *ListAnswersResponse) XXX_Size() int {
+	return xxx_messageInfo_ListAnswersResponse.Size(m)
+}
+func (m *ListAnswersResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListAnswersResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListAnswersResponse proto.InternalMessageInfo
+
+func (m *ListAnswersResponse) GetAnswers() []*Answer {
+	if m != nil {
+		return m.Answers
+	}
+	return nil
+}
+
+type UpdateAnswerRequest struct {
+	Id                   int32    `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Description          string   `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *UpdateAnswerRequest) Reset()         { *m = UpdateAnswerRequest{} }
+func (m *UpdateAnswerRequest) String() string { return proto.CompactTextString(m) }
+func (*UpdateAnswerRequest) ProtoMessage()    {}
+func (*UpdateAnswerRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_06b71796254db90a, []int{7}
+}
+
+func (m *UpdateAnswerRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UpdateAnswerRequest.Unmarshal(m, b)
+}
+func (m *UpdateAnswerRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UpdateAnswerRequest.Marshal(b, m, deterministic)
+}
+func (m *UpdateAnswerRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateAnswerRequest.Merge(m, src)
+}
+func (m *UpdateAnswerRequest) XXX_Size() int {
+	return xxx_messageInfo_UpdateAnswerRequest.Size(m)
+}
+func (m *UpdateAnswerRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateAnswerRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpdateAnswerRequest proto.InternalMessageInfo
+
+func (m *UpdateAnswerRequest) GetId() int32 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *UpdateAnswerRequest) GetDescription() string {
+	if m != nil {
+		return m.Description
+	}
+	return ""
+}
+
+type UpdateAnswerResponse struct {
+	IsUpdated            bool     `protobuf:"varint,1,opt,name=is_updated,json=isUpdated,proto3" json:"is_updated,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *UpdateAnswerResponse) Reset()         { *m = UpdateAnswerResponse{} }
+func (m *UpdateAnswerResponse) String() string { return proto.CompactTextString(m) }
+func (*UpdateAnswerResponse) ProtoMessage()    {}
+func (*UpdateAnswerResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_06b71796254db90a, []int{8}
+}
+
+func (m *UpdateAnswerResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UpdateAnswerResponse.Unmarshal(m, b)
+}
+func (m *UpdateAnswerResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UpdateAnswerResponse.Marshal(b, m, deterministic)
+}
+func (m *UpdateAnswerResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateAnswerResponse.Merge(m, src)
+}
+func (m *UpdateAnswerResponse) XXX_Size() int {
+	return xxx_messageInfo_UpdateAnswerResponse.Size(m)
+}
+func (m *UpdateAnswerResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateAnswerResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpdateAnswerResponse proto.InternalMessageInfo
+
+func (m *UpdateAnswerResponse) GetIsUpdated() bool {
+	if m != nil {
+		return m.IsUpdated
+	}
+	return false
+}
+
+type DeleteAnswerRequest struct {
+	Id                   int32    `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DeleteAnswerRequest) Reset()         { *m = DeleteAnswerRequest{} }
+func (m *DeleteAnswerRequest) String() string { return proto.CompactTextString(m) }
+func (*DeleteAnswerRequest) ProtoMessage()    {}
+func (*DeleteAnswerRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_06b71796254db90a, []int{9}
+}
+
+func (m *DeleteAnswerRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DeleteAnswerRequest.Unmarshal(m, b)
+}
+func (m *DeleteAnswerRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DeleteAnswerRequest.Marshal(b, m, deterministic)
+}
+func (m *DeleteAnswerRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteAnswerRequest.Merge(m, src)
+}
+func (m *DeleteAnswerRequest) XXX_Size() int {
+	return xxx_messageInfo_DeleteAnswerRequest.Size(m)
+}
+func (m *DeleteAnswerRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteAnswerRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeleteAnswerRequest proto.InternalMessageInfo
+
+func (m *DeleteAnswerRequest) GetId() int32 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+type DeleteAnswerResponse struct {
+	IsDeleted            bool     `protobuf:"varint,1,opt,name=is_deleted,json=isDeleted,proto3" json:"is_deleted,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DeleteAnswerResponse) Reset()         { *m = DeleteAnswerResponse{} }
+func (m *DeleteAnswerResponse) String() string { return proto.CompactTextString(m) }
+func (*DeleteAnswerResponse) ProtoMessage()    {}
+func (*DeleteAnswerResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_06b71796254db90a, []int{10}
+}
+
+func (m *DeleteAnswerResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DeleteAnswerResponse.Unmarshal(m, b)
+}
+func (m *DeleteAnswerResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DeleteAnswerResponse.Marshal(b, m, deterministic)
+}
+func (m *DeleteAnswerResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteAnswerResponse.Merge(m, src)
+}
+func (m *DeleteAnswerResponse) XXX_Size() int {
+	return xxx_messageInfo_DeleteAnswerResponse.Size(m)
+}
+func (m *DeleteAnswerResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteAnswerResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeleteAnswerResponse proto.InternalMessageInfo
+
+func (m *DeleteAnswerResponse) GetIsDeleted() bool {
+	if m != nil {
+		return m.IsDeleted
+	}
+	return false
+}
+
+type AcceptAnswerRequest struct {
+	Id                   int32    `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AcceptAnswerRequest) Reset()         { *m = AcceptAnswerRequest{} }
+func (m *AcceptAnswerRequest) String() string { return proto.CompactTextString(m) }
+func (*AcceptAnswerRequest) ProtoMessage()    {}
+func (*AcceptAnswerRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_06b71796254db90a, []int{11}
+}
+
+func (m *AcceptAnswerRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AcceptAnswerRequest.Unmarshal(m, b)
+}
+func (m *AcceptAnswerRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AcceptAnswerRequest.Marshal(b, m, deterministic)
+}
+func (m *AcceptAnswerRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AcceptAnswerRequest.Merge(m, src)
+}
+func (m *AcceptAnswerRequest) XXX_Size() int {
+	return xxx_messageInfo_AcceptAnswerRequest.Size(m)
+}
+func (m *AcceptAnswerRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_AcceptAnswerRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AcceptAnswerRequest proto.InternalMessageInfo
+
+func (m *AcceptAnswerRequest) GetId() int32 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+type AcceptAnswerResponse struct {
+	IsAccepted           bool     `protobuf:"varint,1,opt,name=is_accepted,json=isAccepted,proto3" json:"is_accepted,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AcceptAnswerResponse) Reset()         { *m = AcceptAnswerResponse{} }
+func (m *AcceptAnswerResponse) String() string { return proto.CompactTextString(m) }
+func (*AcceptAnswerResponse) ProtoMessage()    {}
+func (*AcceptAnswerResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_06b71796254db90a, []int{12}
+}
+
+func (m *AcceptAnswerResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AcceptAnswerResponse.Unmarshal(m, b)
+}
+func (m *AcceptAnswerResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AcceptAnswerResponse.Marshal(b, m, deterministic)
+}
+func (m *AcceptAnswerResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AcceptAnswerResponse.Merge(m, src)
+}
+func (m *AcceptAnswerResponse) XXX_Size() int {
+	return xxx_messageInfo_AcceptAnswerResponse.Size(m)
+}
+func (m *AcceptAnswerResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_AcceptAnswerResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AcceptAnswerResponse proto.InternalMessageInfo
+
+func (m *AcceptAnswerResponse) GetIsAccepted() bool {
+	if m != nil {
+		return m.IsAccepted
+	}
+	return false
+}
+
+func init() {
+	proto.RegisterType((*Answer)(nil), "ranabd36.qaengine.Answer")
+	proto.RegisterType((*PostAnswerRequest)(nil), "ranabd36.qaengine.PostAnswerRequest")
+	proto.RegisterType((*PostAnswerResponse)(nil), "ranabd36.qaengine.PostAnswerResponse")
+	proto.RegisterType((*PostReplyRequest)(nil), "ranabd36.qaengine.PostReplyRequest")
+	proto.RegisterType((*PostReplyResponse)(nil), "ranabd36.qaengine.PostReplyResponse")
+	proto.RegisterType((*ListAnswersRequest)(nil), "ranabd36.qaengine.ListAnswersRequest")
+	proto.RegisterType((*ListAnswersResponse)(nil), "ranabd36.qaengine.ListAnswersResponse")
+	proto.RegisterType((*UpdateAnswerRequest)(nil), "ranabd36.qaengine.UpdateAnswerRequest")
+	proto.RegisterType((*UpdateAnswerResponse)(nil), "ranabd36.qaengine.UpdateAnswerResponse")
+	proto.RegisterType((*DeleteAnswerRequest)(nil), "ranabd36.qaengine.DeleteAnswerRequest")
+	proto.RegisterType((*DeleteAnswerResponse)(nil), "ranabd36.qaengine.DeleteAnswerResponse")
+	proto.RegisterType((*AcceptAnswerRequest)(nil), "ranabd36.qaengine.AcceptAnswerRequest")
+	proto.RegisterType((*AcceptAnswerResponse)(nil), "ranabd36.qaengine.AcceptAnswerResponse")
+}
+
+func init() {
+	proto.RegisterFile("answer_service_message.proto", fileDescriptor_06b71796254db90a)
+}
+
+var fileDescriptor_06b71796254db90a = []byte{
+	// 611 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x54, 0xcd, 0x6e, 0xd3, 0x4c,
+	0x14, 0x95, 0xdd, 0xe6, 0xc7, 0x37, 0x5f, 0x3f, 0xc8, 0xa4, 0x12, 0xc6, 0x80, 0x6a, 0xb9, 0x2d,
+	0xcd, 0xca, 0x95, 0x1a, 0x15, 0xc4, 0x32, 0x08, 0x09, 0x15, 0xb1, 0x00, 0xf3, 0xb3, 0x60, 0x63,
+	0x39, 0x9e, 0x4b, 0x18, 0x91, 0xd8, 0x8e, 0x67, 0x42, 0xd5, 0x57, 0xe0, 0x7d, 0x78, 0x00, 0x9e,
+	0x89, 0x17, 0x40, 0x9e, 0xb1, 0xc1, 0x76, 0x5c, 0x9b, 0xe5, 0xdc, 0x39, 0xf7, 0x9c, 0xfb, 0x73,
+	0x66, 0xe0, 0x61, 0x10, 0xf1, 0x6b, 0x4c, 0x7d, 0x8e, 0xe9, 0x37, 0x16, 0xa2, 0xbf, 0x46, 0xce,
+	0x83, 0x25, 0xba, 0x49, 0x1a, 0x8b, 0x98, 0x8c, 0xd3, 0x20, 0x0a, 0x16, 0x74, 0xf6, 0xc4, 0xdd,
+	0x04, 0x18, 0x2d, 0x59, 0x84, 0xd6, 0x38, 0xd8, 0x8a, 0x2f, 0x7e, 0x12, 0xaf, 0x58, 0x78, 0xa3,
+	0x50, 0xd6, 0xd1, 0x32, 0x8e, 0x97, 0x2b, 0x3c, 0x97, 0xa7, 0xc5, 0xf6, 0xf3, 0xb9, 0x60, 0x6b,
+	0xe4, 0x22, 0x58, 0x27, 0x0a, 0xe0, 0xfc, 0xd2, 0xa1, 0x3f, 0x97, 0x3a, 0xe4, 0x7f, 0xd0, 0x19,
+	0x35, 0x35, 0x5b, 0x9b, 0xf6, 0x3c, 0x9d, 0x51, 0x72, 0x0f, 0x06, 0x5b, 0x8e, 0xa9, 0xcf, 0xa8,
+	0xa9, 0xcb, 0x60, 0x3f, 0x3b, 0x5e, 0x51, 0x72, 0x04, 0xa3, 0xcd, 0x16, 0xb9, 0x60, 0x71, 0x94,
+	0x5d, 0xee, 0xc9, 0x4b, 0x28, 0x42, 0x57, 0x94, 0x3c, 0x00, 0x23, 0xaf, 0x9d, 0x51, 0x73, 0x5f,
+	0x5e, 0x0f, 0x55, 0xe0, 0x8a, 0x12, 0x1b, 0x46, 0x14, 0x79, 0x98, 0xb2, 0x24, 0x43, 0x9b, 0x3d,
+	0x5b, 0x9b, 0x1a, 0x5e, 0x39, 0x94, 0xf1, 0x33, 0xee, 0x07, 0x61, 0x88, 0x89, 0x40, 0x6a, 0xf6,
+	0x6d, 0x6d, 0x3a, 0xf4, 0x80, 0xf1, 0x79, 0x1e, 0x21, 0xcf, 0x00, 0xc2, 0x14, 0x03, 0x81, 0xd4,
+	0x0f, 0x84, 0x39, 0xb0, 0xb5, 0xe9, 0xe8, 0xc2, 0x72, 0x55, 0xab, 0x6e, 0xd1, 0xaa, 0xfb, 0xbe,
+	0x68, 0xd5, 0x33, 0x72, 0xf4, 0x5c, 0x64, 0xa9, 0xdb, 0x84, 0x16, 0xa9, 0xc3, 0xee, 0xd4, 0x1c,
+	0x3d, 0x17, 0x64, 0x06, 0x83, 0x14, 0x93, 0x15, 0x43, 0x6e, 0x1a, 0xf6, 0xde, 0x74, 0x74, 0x71,
+	0xdf, 0xdd, 0xd9, 0x81, 0xab, 0x66, 0xe9, 0x15, 0x48, 0x72, 0x08, 0x3d, 0x1e, 0xc6, 0x29, 0x9a,
+	0x20, 0xc7, 0xa0, 0x0e, 0xce, 0x47, 0x18, 0xbf, 0x89, 0xb9, 0xc8, 0xc1, 0x28, 0x47, 0x57, 0x1f,
+	0xab, 0xb6, 0x33, 0xd6, 0xda, 0xe4, 0xf4, 0x9d, 0xc9, 0x39, 0x27, 0x40, 0xca, 0xbc, 0x3c, 0x89,
+	0x23, 0x8e, 0xf5, 0xc5, 0x3a, 0x6f, 0xe1, 0x6e, 0x86, 0xf2, 0x30, 0x59, 0xdd, 0x14, 0xe2, 0x95,
+	0x95, 0x69, 0xed, 0x2b, 0x6b, 0x10, 0x3e, 0x86, 0x71, 0x89, 0xf2, 0x16, 0xdd, 0x4b, 0x20, 0xaf,
+	0x59, 0x51, 0x1d, 0xff, 0xd7, 0xb6, 0x9d, 0x57, 0x30, 0xa9, 0xa4, 0xe5, 0xec, 0x33, 0x18, 0xa8,
+	0x02, 0xb9, 0xa9, 0x75, 0xae, 0x23, 0x47, 0x3a, 0x2f, 0x61, 0xf2, 0x41, 0x2e, 0xb4, 0x3a, 0xfa,
+	0xba, 0xf5, 0xbb, 0x1b, 0xbe, 0x84, 0xc3, 0x2a, 0x51, 0x5e, 0xd5, 0x23, 0x00, 0xc6, 0xfd, 0xdc,
+	0x34, 0x92, 0x71, 0xe8, 0x19, 0x8c, 0x2b, 0x2c, 0x75, 0x4e, 0x61, 0xf2, 0x02, 0x57, 0xd8, 0xa1,
+	0x9f, 0xb1, 0x57, 0x61, 0x15, 0x76, 0x2a, 0xaf, 0x4a, 0xec, 0x0a, 0x2b, 0xd9, 0xd5, 0x1b, 0x69,
+	0x67, 0x7f, 0x0a, 0x87, 0x55, 0x58, 0xce, 0x5e, 0x7b, 0x77, 0x5a, 0xfd, 0xdd, 0x5d, 0xfc, 0xec,
+	0xc1, 0x81, 0xca, 0x79, 0xa7, 0xfe, 0x24, 0x12, 0x01, 0xfc, 0x35, 0x1c, 0x39, 0x69, 0xd8, 0xc0,
+	0x8e, 0xcf, 0xad, 0xd3, 0x0e, 0x94, 0xaa, 0xc6, 0x99, 0x7c, 0xff, 0x61, 0xde, 0x21, 0x07, 0xf9,
+	0xe6, 0xdc, 0xeb, 0x94, 0x09, 0x24, 0x5f, 0xc1, 0xf8, 0xe3, 0x33, 0x72, 0x7c, 0x0b, 0x51, 0xd9,
+	0xd8, 0xd6, 0x49, 0x3b, 0xa8, 0x4d, 0x6c, 0x03, 0xa3, 0x92, 0xf1, 0x48, 0x53, 0xdd, 0xbb, 0x7e,
+	0xb6, 0x1e, 0x77, 0xc1, 0xda, 0x24, 0x05, 0xfc, 0x57, 0xb6, 0x15, 0x69, 0x22, 0x6b, 0x30, 0xb0,
+	0x75, 0xd6, 0x89, 0xeb, 0x50, 0x2d, 0xdb, 0xad, 0x51, 0xb5, 0xc1, 0xb6, 0xd6, 0x59, 0x27, 0xae,
+	0x43, 0xb5, 0x6c, 0xc3, 0x46, 0xd5, 0x06, 0x3b, 0x5b, 0x67, 0x9d, 0xb8, 0x16, 0xd5, 0xe7, 0xfb,
+	0x9f, 0xf4, 0x64, 0xb1, 0xe8, 0xcb, 0xaf, 0x7e, 0xf6, 0x7b, 0x00, 0xaf, 0x99, 0xf6, 0x28, 0x64,
+	0x07, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConnInterface
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion6
+
+// AnswerServiceClient is the client API for AnswerService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type AnswerServiceClient interface {
+	PostAnswer(ctx context.Context, in *PostAnswerRequest, opts ...grpc.CallOption) (*PostAnswerResponse, error)
+	PostReply(ctx context.Context, in *PostReplyRequest, opts ...grpc.CallOption) (*PostReplyResponse, error)
+	ListAnswers(ctx context.Context, in *ListAnswersRequest, opts ...grpc.CallOption) (*ListAnswersResponse, error)
+	UpdateAnswer(ctx context.Context, in *UpdateAnswerRequest, opts ...grpc.CallOption) (*UpdateAnswerResponse, error)
+	DeleteAnswer(ctx context.Context, in *DeleteAnswerRequest, opts ...grpc.CallOption) (*DeleteAnswerResponse, error)
+	AcceptAnswer(ctx context.Context, in *AcceptAnswerRequest, opts ...grpc.CallOption) (*AcceptAnswerResponse, error)
+}
+
+type answerServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewAnswerServiceClient(cc grpc.ClientConnInterface) AnswerServiceClient {
+	return &answerServiceClient{cc}
+}
+
+func (c *answerServiceClient) PostAnswer(ctx context.Context, in *PostAnswerRequest, opts ...grpc.CallOption) (*PostAnswerResponse, error) {
+	out := new(PostAnswerResponse)
+	err := c.cc.Invoke(ctx, "/ranabd36.qaengine.AnswerService/PostAnswer", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *answerServiceClient) PostReply(ctx context.Context, in *PostReplyRequest, opts ...grpc.CallOption) (*PostReplyResponse, error) {
+	out := new(PostReplyResponse)
+	err := c.cc.Invoke(ctx, "/ranabd36.qaengine.AnswerService/PostReply", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *answerServiceClient) ListAnswers(ctx context.Context, in *ListAnswersRequest, opts ...grpc.CallOption) (*ListAnswersResponse, error) {
+	out := new(ListAnswersResponse)
+	err := c.cc.Invoke(ctx, "/ranabd36.qaengine.AnswerService/ListAnswers", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *answerServiceClient) UpdateAnswer(ctx context.Context, in *UpdateAnswerRequest, opts ...grpc.CallOption) (*UpdateAnswerResponse, error) {
+	out := new(UpdateAnswerResponse)
+	err := c.cc.Invoke(ctx, "/ranabd36.qaengine.AnswerService/UpdateAnswer", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *answerServiceClient) DeleteAnswer(ctx context.Context, in *DeleteAnswerRequest, opts ...grpc.CallOption) (*DeleteAnswerResponse, error) {
+	out := new(DeleteAnswerResponse)
+	err := c.cc.Invoke(ctx, "/ranabd36.qaengine.AnswerService/DeleteAnswer", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *answerServiceClient) AcceptAnswer(ctx context.Context, in *AcceptAnswerRequest, opts ...grpc.CallOption) (*AcceptAnswerResponse, error) {
+	out := new(AcceptAnswerResponse)
+	err := c.cc.Invoke(ctx, "/ranabd36.qaengine.AnswerService/AcceptAnswer", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// AnswerServiceServer is the server API for AnswerService service.
+type AnswerServiceServer interface {
+	PostAnswer(context.Context, *PostAnswerRequest) (*PostAnswerResponse, error)
+	PostReply(context.Context, *PostReplyRequest) (*PostReplyResponse, error)
+	ListAnswers(context.Context, *ListAnswersRequest) (*ListAnswersResponse, error)
+	UpdateAnswer(context.Context, *UpdateAnswerRequest) (*UpdateAnswerResponse, error)
+	DeleteAnswer(context.Context, *DeleteAnswerRequest) (*DeleteAnswerResponse, error)
+	AcceptAnswer(context.Context, *AcceptAnswerRequest) (*AcceptAnswerResponse, error)
+}
+
+// UnimplementedAnswerServiceServer can be embedded to have forward compatible implementations.
+type UnimplementedAnswerServiceServer struct {
+}
+
+func (*UnimplementedAnswerServiceServer) PostAnswer(ctx context.Context, req *PostAnswerRequest) (*PostAnswerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PostAnswer not implemented")
+}
+func (*UnimplementedAnswerServiceServer) PostReply(ctx context.Context, req *PostReplyRequest) (*PostReplyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PostReply not implemented")
+}
+func (*UnimplementedAnswerServiceServer) ListAnswers(ctx context.Context, req *ListAnswersRequest) (*ListAnswersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAnswers not implemented")
+}
+func (*UnimplementedAnswerServiceServer) UpdateAnswer(ctx context.Context, req *UpdateAnswerRequest) (*UpdateAnswerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateAnswer not implemented")
+}
+func (*UnimplementedAnswerServiceServer) DeleteAnswer(ctx context.Context, req *DeleteAnswerRequest) (*DeleteAnswerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteAnswer not implemented")
+}
+func (*UnimplementedAnswerServiceServer) AcceptAnswer(ctx context.Context, req *AcceptAnswerRequest) (*AcceptAnswerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AcceptAnswer not implemented")
+}
+
+func RegisterAnswerServiceServer(s *grpc.Server, srv AnswerServiceServer) {
+	s.RegisterService(&_AnswerService_serviceDesc, srv)
+}
+
+func _AnswerService_PostAnswer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PostAnswerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AnswerServiceServer).PostAnswer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ranabd36.qaengine.AnswerService/PostAnswer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AnswerServiceServer).PostAnswer(ctx, req.(*PostAnswerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AnswerService_PostReply_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PostReplyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AnswerServiceServer).PostReply(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ranabd36.qaengine.AnswerService/PostReply",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AnswerServiceServer).PostReply(ctx, req.(*PostReplyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AnswerService_ListAnswers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAnswersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AnswerServiceServer).ListAnswers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ranabd36.qaengine.AnswerService/ListAnswers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AnswerServiceServer).ListAnswers(ctx, req.(*ListAnswersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AnswerService_UpdateAnswer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateAnswerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AnswerServiceServer).UpdateAnswer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ranabd36.qaengine.AnswerService/UpdateAnswer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AnswerServiceServer).UpdateAnswer(ctx, req.(*UpdateAnswerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AnswerService_DeleteAnswer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteAnswerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AnswerServiceServer).DeleteAnswer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ranabd36.qaengine.AnswerService/DeleteAnswer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AnswerServiceServer).DeleteAnswer(ctx, req.(*DeleteAnswerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AnswerService_AcceptAnswer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AcceptAnswerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AnswerServiceServer).AcceptAnswer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ranabd36.qaengine.AnswerService/AcceptAnswer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AnswerServiceServer).AcceptAnswer(ctx, req.(*AcceptAnswerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _AnswerService_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "ranabd36.qaengine.AnswerService",
+	HandlerType: (*AnswerServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "PostAnswer",
+			Handler:    _AnswerService_PostAnswer_Handler,
+		},
+		{
+			MethodName: "PostReply",
+			Handler:    _AnswerService_PostReply_Handler,
+		},
+		{
+			MethodName: "ListAnswers",
+			Handler:    _AnswerService_ListAnswers_Handler,
+		},
+		{
+			MethodName: "UpdateAnswer",
+			Handler:    _AnswerService_UpdateAnswer_Handler,
+		},
+		{
+			MethodName: "DeleteAnswer",
+			Handler:    _AnswerService_DeleteAnswer_Handler,
+		},
+		{
+			MethodName: "AcceptAnswer",
+			Handler:    _AnswerService_AcceptAnswer_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "answer_service_message.proto",
+}
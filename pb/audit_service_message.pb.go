@@ -0,0 +1,432 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: audit_service_message.proto
+
+package pb
+
+import (
+	context "context"
+	fmt "fmt"
+	proto "github.com/golang/protobuf/proto"
+	timestamp "github.com/golang/protobuf/ptypes/timestamp"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	math "math"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
+
+type AuditEvent struct {
+	Id                   int64                `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	OccurredAt           *timestamp.Timestamp `protobuf:"bytes,2,opt,name=occurred_at,json=occurredAt,proto3" json:"occurred_at,omitempty"`
+	ActorId              int32                `protobuf:"varint,3,opt,name=actor_id,json=actorId,proto3" json:"actor_id,omitempty"`
+	ActorUsername        string               `protobuf:"bytes,4,opt,name=actor_username,json=actorUsername,proto3" json:"actor_username,omitempty"`
+	Action               string               `protobuf:"bytes,5,opt,name=action,proto3" json:"action,omitempty"`
+	TargetType           string               `protobuf:"bytes,6,opt,name=target_type,json=targetType,proto3" json:"target_type,omitempty"`
+	TargetId             string               `protobuf:"bytes,7,opt,name=target_id,json=targetId,proto3" json:"target_id,omitempty"`
+	Outcome              string               `protobuf:"bytes,8,opt,name=outcome,proto3" json:"outcome,omitempty"`
+	PeerAddress          string               `protobuf:"bytes,9,opt,name=peer_address,json=peerAddress,proto3" json:"peer_address,omitempty"`
+	RequestId            string               `protobuf:"bytes,10,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	Before               string               `protobuf:"bytes,11,opt,name=before,proto3" json:"before,omitempty"`
+	After                string               `protobuf:"bytes,12,opt,name=after,proto3" json:"after,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *AuditEvent) Reset()         { *m = AuditEvent{} }
+func (m *AuditEvent) String() string { return proto.CompactTextString(m) }
+func (*AuditEvent) ProtoMessage()    {}
+func (*AuditEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f9ffdcc9527b65f1, []int{0}
+}
+
+func (m *AuditEvent) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AuditEvent.Unmarshal(m, b)
+}
+func (m *AuditEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AuditEvent.Marshal(b, m, deterministic)
+}
+func (m *AuditEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AuditEvent.Merge(m, src)
+}
+func (m *AuditEvent) XXX_Size() int {
+	return xxx_messageInfo_AuditEvent.Size(m)
+}
+func (m *AuditEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_AuditEvent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AuditEvent proto.InternalMessageInfo
+
+func (m *AuditEvent) GetId() int64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *AuditEvent) GetOccurredAt() *timestamp.Timestamp {
+	if m != nil {
+		return m.OccurredAt
+	}
+	return nil
+}
+
+func (m *AuditEvent) GetActorId() int32 {
+	if m != nil {
+		return m.ActorId
+	}
+	return 0
+}
+
+func (m *AuditEvent) GetActorUsername() string {
+	if m != nil {
+		return m.ActorUsername
+	}
+	return ""
+}
+
+func (m *AuditEvent) GetAction() string {
+	if m != nil {
+		return m.Action
+	}
+	return ""
+}
+
+func (m *AuditEvent) GetTargetType() string {
+	if m != nil {
+		return m.TargetType
+	}
+	return ""
+}
+
+func (m *AuditEvent) GetTargetId() string {
+	if m != nil {
+		return m.TargetId
+	}
+	return ""
+}
+
+func (m *AuditEvent) GetOutcome() string {
+	if m != nil {
+		return m.Outcome
+	}
+	return ""
+}
+
+func (m *AuditEvent) GetPeerAddress() string {
+	if m != nil {
+		return m.PeerAddress
+	}
+	return ""
+}
+
+func (m *AuditEvent) GetRequestId() string {
+	if m != nil {
+		return m.RequestId
+	}
+	return ""
+}
+
+func (m *AuditEvent) GetBefore() string {
+	if m != nil {
+		return m.Before
+	}
+	return ""
+}
+
+func (m *AuditEvent) GetAfter() string {
+	if m != nil {
+		return m.After
+	}
+	return ""
+}
+
+type ListAuditEventsRequest struct {
+	ActorId              int32                `protobuf:"varint,1,opt,name=actor_id,json=actorId,proto3" json:"actor_id,omitempty"`
+	Action               string               `protobuf:"bytes,2,opt,name=action,proto3" json:"action,omitempty"`
+	TargetType           string               `protobuf:"bytes,3,opt,name=target_type,json=targetType,proto3" json:"target_type,omitempty"`
+	TargetId             string               `protobuf:"bytes,4,opt,name=target_id,json=targetId,proto3" json:"target_id,omitempty"`
+	Outcome              string               `protobuf:"bytes,5,opt,name=outcome,proto3" json:"outcome,omitempty"`
+	OccurredAfter        *timestamp.Timestamp `protobuf:"bytes,6,opt,name=occurred_after,json=occurredAfter,proto3" json:"occurred_after,omitempty"`
+	OccurredBefore       *timestamp.Timestamp `protobuf:"bytes,7,opt,name=occurred_before,json=occurredBefore,proto3" json:"occurred_before,omitempty"`
+	PageSize             int32                `protobuf:"varint,8,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken            string               `protobuf:"bytes,9,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *ListAuditEventsRequest) Reset()         { *m = ListAuditEventsRequest{} }
+func (m *ListAuditEventsRequest) String() string { return proto.CompactTextString(m) }
+func (*ListAuditEventsRequest) ProtoMessage()    {}
+func (*ListAuditEventsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f9ffdcc9527b65f1, []int{1}
+}
+
+func (m *ListAuditEventsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListAuditEventsRequest.Unmarshal(m, b)
+}
+func (m *ListAuditEventsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListAuditEventsRequest.Marshal(b, m, deterministic)
+}
+func (m *ListAuditEventsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListAuditEventsRequest.Merge(m, src)
+}
+func (m *ListAuditEventsRequest) XXX_Size() int {
+	return xxx_messageInfo_ListAuditEventsRequest.Size(m)
+}
+func (m *ListAuditEventsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListAuditEventsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListAuditEventsRequest proto.InternalMessageInfo
+
+func (m *ListAuditEventsRequest) GetActorId() int32 {
+	if m != nil {
+		return m.ActorId
+	}
+	return 0
+}
+
+func (m *ListAuditEventsRequest) GetAction() string {
+	if m != nil {
+		return m.Action
+	}
+	return ""
+}
+
+func (m *ListAuditEventsRequest) GetTargetType() string {
+	if m != nil {
+		return m.TargetType
+	}
+	return ""
+}
+
+func (m *ListAuditEventsRequest) GetTargetId() string {
+	if m != nil {
+		return m.TargetId
+	}
+	return ""
+}
+
+func (m *ListAuditEventsRequest) GetOutcome() string {
+	if m != nil {
+		return m.Outcome
+	}
+	return ""
+}
+
+func (m *ListAuditEventsRequest) GetOccurredAfter() *timestamp.Timestamp {
+	if m != nil {
+		return m.OccurredAfter
+	}
+	return nil
+}
+
+func (m *ListAuditEventsRequest) GetOccurredBefore() *timestamp.Timestamp {
+	if m != nil {
+		return m.OccurredBefore
+	}
+	return nil
+}
+
+func (m *ListAuditEventsRequest) GetPageSize() int32 {
+	if m != nil {
+		return m.PageSize
+	}
+	return 0
+}
+
+func (m *ListAuditEventsRequest) GetPageToken() string {
+	if m != nil {
+		return m.PageToken
+	}
+	return ""
+}
+
+type ListAuditEventsResponse struct {
+	AuditEvents          []*AuditEvent `protobuf:"bytes,1,rep,name=audit_events,json=auditEvents,proto3" json:"audit_events,omitempty"`
+	NextPageToken        string        `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *ListAuditEventsResponse) Reset()         { *m = ListAuditEventsResponse{} }
+func (m *ListAuditEventsResponse) String() string { return proto.CompactTextString(m) }
+func (*ListAuditEventsResponse) ProtoMessage()    {}
+func (*ListAuditEventsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f9ffdcc9527b65f1, []int{2}
+}
+
+func (m *ListAuditEventsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListAuditEventsResponse.Unmarshal(m, b)
+}
+func (m *ListAuditEventsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListAuditEventsResponse.Marshal(b, m, deterministic)
+}
+func (m *ListAuditEventsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListAuditEventsResponse.Merge(m, src)
+}
+func (m *ListAuditEventsResponse) XXX_Size() int {
+	return xxx_messageInfo_ListAuditEventsResponse.Size(m)
+}
+func (m *ListAuditEventsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListAuditEventsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListAuditEventsResponse proto.InternalMessageInfo
+
+func (m *ListAuditEventsResponse) GetAuditEvents() []*AuditEvent {
+	if m != nil {
+		return m.AuditEvents
+	}
+	return nil
+}
+
+func (m *ListAuditEventsResponse) GetNextPageToken() string {
+	if m != nil {
+		return m.NextPageToken
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterType((*AuditEvent)(nil), "ranabd36.qaengine.AuditEvent")
+	proto.RegisterType((*ListAuditEventsRequest)(nil), "ranabd36.qaengine.ListAuditEventsRequest")
+	proto.RegisterType((*ListAuditEventsResponse)(nil), "ranabd36.qaengine.ListAuditEventsResponse")
+}
+
+func init() {
+	proto.RegisterFile("audit_service_message.proto", fileDescriptor_f9ffdcc9527b65f1)
+}
+
+var fileDescriptor_f9ffdcc9527b65f1 = []byte{
+	// 550 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x53, 0x4d, 0x6f, 0x13, 0x31,
+	0x10, 0xd5, 0x6e, 0x9a, 0xaf, 0xd9, 0xb4, 0xa5, 0x16, 0x2a, 0x26, 0x55, 0xd5, 0x10, 0x09, 0x14,
+	0x38, 0x6c, 0xa5, 0x54, 0xe2, 0xc2, 0x85, 0x14, 0x71, 0x88, 0xc4, 0x01, 0x6d, 0xc3, 0x85, 0xcb,
+	0xca, 0x59, 0x4f, 0x16, 0x8b, 0x66, 0xbd, 0xb5, 0xbd, 0x15, 0xc9, 0x95, 0x5b, 0xff, 0x0f, 0xbf,
+	0x89, 0x1f, 0xc1, 0x05, 0xad, 0xbd, 0x49, 0x08, 0x2d, 0x2d, 0xc7, 0x79, 0xef, 0xf9, 0x79, 0xfc,
+	0x66, 0x0c, 0x47, 0xac, 0xe0, 0xc2, 0xc4, 0x1a, 0xd5, 0xb5, 0x48, 0x30, 0x9e, 0xa3, 0xd6, 0x2c,
+	0xc5, 0x30, 0x57, 0xd2, 0x48, 0x72, 0xa0, 0x58, 0xc6, 0xa6, 0xfc, 0xec, 0x75, 0x78, 0xc5, 0x30,
+	0x4b, 0x45, 0x86, 0xdd, 0x03, 0x56, 0x98, 0x2f, 0x71, 0x2e, 0x2f, 0x45, 0xb2, 0x70, 0xaa, 0xee,
+	0x49, 0x2a, 0x65, 0x7a, 0x89, 0xa7, 0xb6, 0x9a, 0x16, 0xb3, 0x53, 0x23, 0xe6, 0xa8, 0x0d, 0x9b,
+	0xe7, 0x4e, 0xd0, 0xff, 0xe5, 0x03, 0x8c, 0xca, 0x6b, 0xde, 0x5f, 0x63, 0x66, 0xc8, 0x1e, 0xf8,
+	0x82, 0x53, 0xaf, 0xe7, 0x0d, 0x6a, 0x91, 0x2f, 0x38, 0x79, 0x03, 0x81, 0x4c, 0x92, 0x42, 0x29,
+	0xe4, 0x31, 0x33, 0xd4, 0xef, 0x79, 0x83, 0x60, 0xd8, 0x0d, 0x9d, 0x6b, 0xb8, 0x72, 0x0d, 0x27,
+	0x2b, 0xd7, 0x08, 0x56, 0xf2, 0x91, 0x21, 0x4f, 0xa1, 0xc5, 0x12, 0x23, 0x55, 0x2c, 0x38, 0xad,
+	0xf5, 0xbc, 0x41, 0x3d, 0x6a, 0xda, 0x7a, 0xcc, 0xc9, 0x73, 0xd8, 0x73, 0x54, 0xa1, 0x51, 0x65,
+	0x6c, 0x8e, 0x74, 0xa7, 0xe7, 0x0d, 0xda, 0xd1, 0xae, 0x45, 0x3f, 0x55, 0x20, 0x39, 0x84, 0x06,
+	0x4b, 0x8c, 0x90, 0x19, 0xad, 0x5b, 0xba, 0xaa, 0xc8, 0x09, 0x04, 0x86, 0xa9, 0x14, 0x4d, 0x6c,
+	0x16, 0x39, 0xd2, 0x86, 0x25, 0xc1, 0x41, 0x93, 0x45, 0x8e, 0xe4, 0x08, 0xda, 0x95, 0x40, 0x70,
+	0xda, 0xb4, 0x74, 0xcb, 0x01, 0x63, 0x4e, 0x28, 0x34, 0x65, 0x61, 0x12, 0x39, 0x47, 0xda, 0xb2,
+	0xd4, 0xaa, 0x24, 0xcf, 0xa0, 0x93, 0x23, 0xaa, 0x98, 0x71, 0xae, 0x50, 0x6b, 0xda, 0xb6, 0x74,
+	0x50, 0x62, 0x23, 0x07, 0x91, 0x63, 0x00, 0x85, 0x57, 0x05, 0x6a, 0x6b, 0x0d, 0x56, 0xd0, 0xae,
+	0x90, 0x31, 0x2f, 0x3b, 0x9e, 0xe2, 0x4c, 0x2a, 0xa4, 0x81, 0xeb, 0xd8, 0x55, 0xe4, 0x31, 0xd4,
+	0xd9, 0xcc, 0xa0, 0xa2, 0x1d, 0x0b, 0xbb, 0xa2, 0xff, 0xd3, 0x87, 0xc3, 0x0f, 0x42, 0x9b, 0xcd,
+	0x04, 0x74, 0xe4, 0xac, 0xb6, 0xc2, 0xf3, 0xb6, 0xc3, 0xdb, 0xa4, 0xe2, 0xdf, 0x97, 0x4a, 0xed,
+	0xfe, 0x54, 0x76, 0xfe, 0x9d, 0x4a, 0x7d, 0x3b, 0x95, 0x11, 0xec, 0x6d, 0x96, 0xc0, 0x3e, 0xa2,
+	0xf1, 0xe0, 0x1e, 0xec, 0xae, 0xf7, 0xa0, 0x3c, 0x40, 0xde, 0xc1, 0xfe, 0xda, 0xa2, 0xca, 0xa7,
+	0xf9, 0xa0, 0xc7, 0xfa, 0xd6, 0x73, 0x97, 0xe1, 0x11, 0xb4, 0x73, 0x96, 0x62, 0xac, 0xc5, 0xd2,
+	0x4d, 0xae, 0x1e, 0xb5, 0x4a, 0xe0, 0x42, 0x2c, 0xb1, 0x9c, 0x8b, 0x25, 0x8d, 0xfc, 0x8a, 0x59,
+	0x35, 0x38, 0x2b, 0x9f, 0x94, 0x40, 0xff, 0xbb, 0x07, 0x4f, 0x6e, 0x25, 0xad, 0x73, 0x99, 0x69,
+	0x24, 0x6f, 0xa1, 0xe3, 0x7e, 0x1a, 0x5a, 0x9c, 0x7a, 0xbd, 0xda, 0x20, 0x18, 0x1e, 0x87, 0xb7,
+	0x7e, 0x58, 0xb8, 0x39, 0x1d, 0x05, 0x6c, 0xe3, 0x44, 0x5e, 0xc0, 0x7e, 0x86, 0xdf, 0x4c, 0xfc,
+	0x47, 0x07, 0x6e, 0x34, 0xbb, 0x25, 0xfc, 0x71, 0xd5, 0xc5, 0xf0, 0xc6, 0x83, 0x8e, 0xf5, 0xb8,
+	0x70, 0x7f, 0x9a, 0x2c, 0x61, 0xff, 0xaf, 0xae, 0xc8, 0xcb, 0x3b, 0xee, 0xbd, 0x7b, 0x47, 0xba,
+	0xaf, 0xfe, 0x47, 0xea, 0x1e, 0xd9, 0x7f, 0x74, 0xf3, 0x83, 0x76, 0x08, 0xd8, 0xae, 0x43, 0x85,
+	0x8c, 0x9f, 0xef, 0x7c, 0xf6, 0xf3, 0xe9, 0xb4, 0x61, 0x83, 0x3f, 0xfb, 0x3d, 0x00, 0x97, 0x0b,
+	0x3a, 0xf6, 0x6d, 0x04, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConnInterface
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion6
+
+// AuditServiceClient is the client API for AuditService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type AuditServiceClient interface {
+	ListAuditEvents(ctx context.Context, in *ListAuditEventsRequest, opts ...grpc.CallOption) (*ListAuditEventsResponse, error)
+}
+
+type auditServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewAuditServiceClient(cc grpc.ClientConnInterface) AuditServiceClient {
+	return &auditServiceClient{cc}
+}
+
+func (c *auditServiceClient) ListAuditEvents(ctx context.Context, in *ListAuditEventsRequest, opts ...grpc.CallOption) (*ListAuditEventsResponse, error) {
+	out := new(ListAuditEventsResponse)
+	err := c.cc.Invoke(ctx, "/ranabd36.qaengine.AuditService/ListAuditEvents", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// AuditServiceServer is the server API for AuditService service.
+type AuditServiceServer interface {
+	ListAuditEvents(context.Context, *ListAuditEventsRequest) (*ListAuditEventsResponse, error)
+}
+
+// UnimplementedAuditServiceServer can be embedded to have forward compatible implementations.
+type UnimplementedAuditServiceServer struct {
+}
+
+func (*UnimplementedAuditServiceServer) ListAuditEvents(ctx context.Context, req *ListAuditEventsRequest) (*ListAuditEventsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAuditEvents not implemented")
+}
+
+func RegisterAuditServiceServer(s *grpc.Server, srv AuditServiceServer) {
+	s.RegisterService(&_AuditService_serviceDesc, srv)
+}
+
+func _AuditService_ListAuditEvents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAuditEventsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuditServiceServer).ListAuditEvents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ranabd36.qaengine.AuditService/ListAuditEvents",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuditServiceServer).ListAuditEvents(ctx, req.(*ListAuditEventsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _AuditService_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "ranabd36.qaengine.AuditService",
+	HandlerType: (*AuditServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ListAuditEvents",
+			Handler:    _AuditService_ListAuditEvents_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "audit_service_message.proto",
+}
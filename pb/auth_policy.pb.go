@@ -0,0 +1,115 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: auth_policy.proto
+
+package pb
+
+import (
+	fmt "fmt"
+	proto "github.com/golang/protobuf/proto"
+	descriptor "github.com/golang/protobuf/protoc-gen-go/descriptor"
+	math "math"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
+
+// AuthPolicy tells the AuthInterceptor who may call an RPC. Every RPC must
+// declare one, the server refuses to start otherwise.
+type AuthPolicy struct {
+	Public               bool     `protobuf:"varint,1,opt,name=public,proto3" json:"public,omitempty"`
+	Permission           string   `protobuf:"bytes,2,opt,name=permission,proto3" json:"permission,omitempty"`
+	Audit                bool     `protobuf:"varint,3,opt,name=audit,proto3" json:"audit,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AuthPolicy) Reset()         { *m = AuthPolicy{} }
+func (m *AuthPolicy) String() string { return proto.CompactTextString(m) }
+func (*AuthPolicy) ProtoMessage()    {}
+func (*AuthPolicy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_32219bf35b7e7a23, []int{0}
+}
+
+func (m *AuthPolicy) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AuthPolicy.Unmarshal(m, b)
+}
+func (m *AuthPolicy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AuthPolicy.Marshal(b, m, deterministic)
+}
+func (m *AuthPolicy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AuthPolicy.Merge(m, src)
+}
+func (m *AuthPolicy) XXX_Size() int {
+	return xxx_messageInfo_AuthPolicy.Size(m)
+}
+func (m *AuthPolicy) XXX_DiscardUnknown() {
+	xxx_messageInfo_AuthPolicy.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AuthPolicy proto.InternalMessageInfo
+
+func (m *AuthPolicy) GetPublic() bool {
+	if m != nil {
+		return m.Public
+	}
+	return false
+}
+
+func (m *AuthPolicy) GetPermission() string {
+	if m != nil {
+		return m.Permission
+	}
+	return ""
+}
+
+func (m *AuthPolicy) GetAudit() bool {
+	if m != nil {
+		return m.Audit
+	}
+	return false
+}
+
+var E_Auth = &proto.ExtensionDesc{
+	ExtendedType:  (*descriptor.MethodOptions)(nil),
+	ExtensionType: (*AuthPolicy)(nil),
+	Field:         50001,
+	Name:          "ranabd36.qaengine.auth",
+	Tag:           "bytes,50001,opt,name=auth",
+	Filename:      "auth_policy.proto",
+}
+
+func init() {
+	proto.RegisterType((*AuthPolicy)(nil), "ranabd36.qaengine.AuthPolicy")
+	proto.RegisterExtension(E_Auth)
+}
+
+func init() {
+	proto.RegisterFile("auth_policy.proto", fileDescriptor_32219bf35b7e7a23)
+}
+
+var fileDescriptor_32219bf35b7e7a23 = []byte{
+	// 211 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x12, 0x4c, 0x2c, 0x2d, 0xc9,
+	0x88, 0x2f, 0xc8, 0xcf, 0xc9, 0x4c, 0xae, 0xd4, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x2c,
+	0x4a, 0xcc, 0x4b, 0x4c, 0x4a, 0x31, 0x36, 0xd3, 0x2b, 0x4c, 0x4c, 0xcd, 0x4b, 0xcf, 0xcc, 0x4b,
+	0x95, 0x52, 0x48, 0xcf, 0xcf, 0x4f, 0xcf, 0x49, 0xd5, 0x07, 0x2b, 0x48, 0x2a, 0x4d, 0xd3, 0x4f,
+	0x49, 0x2d, 0x4e, 0x2e, 0xca, 0x2c, 0x28, 0xc9, 0x2f, 0x82, 0x68, 0x52, 0x8a, 0xe2, 0xe2, 0x72,
+	0x2c, 0x2d, 0xc9, 0x08, 0x00, 0x1b, 0x24, 0x24, 0xc6, 0xc5, 0x56, 0x50, 0x9a, 0x94, 0x93, 0x99,
+	0x2c, 0xc1, 0xa8, 0xc0, 0xa8, 0xc1, 0x11, 0x04, 0xe5, 0x09, 0xc9, 0x71, 0x71, 0x15, 0xa4, 0x16,
+	0xe5, 0x66, 0x16, 0x17, 0x67, 0xe6, 0xe7, 0x49, 0x30, 0x29, 0x30, 0x6a, 0x70, 0x06, 0x21, 0x89,
+	0x08, 0x89, 0x70, 0xb1, 0x26, 0x96, 0xa6, 0x64, 0x96, 0x48, 0x30, 0x83, 0xb5, 0x41, 0x38, 0x56,
+	0xc1, 0x5c, 0x2c, 0x20, 0x57, 0x0a, 0xc9, 0xe9, 0x41, 0x9c, 0xa1, 0x07, 0x73, 0x86, 0x9e, 0x6f,
+	0x6a, 0x49, 0x46, 0x7e, 0x8a, 0x7f, 0x41, 0x49, 0x66, 0x7e, 0x5e, 0xb1, 0xc4, 0xc5, 0x36, 0x90,
+	0x36, 0x6e, 0x23, 0x59, 0x3d, 0x0c, 0x1f, 0xe8, 0x21, 0x1c, 0x17, 0x04, 0x36, 0xcc, 0x89, 0x25,
+	0x8a, 0xa9, 0x20, 0x29, 0x89, 0x0d, 0x6c, 0x94, 0x31, 0x60, 0x00, 0xbd, 0x99, 0x0b, 0xb5, 0x07,
+	0x01, 0x00, 0x00,
+}
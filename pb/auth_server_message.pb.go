@@ -25,11 +25,14 @@ var _ = math.Inf
 const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
 
 type LoginRequest struct {
-	Username             string   `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
-	Password             string   `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	// Types that are valid to be assigned to Identifier:
+	//	*LoginRequest_Username
+	//	*LoginRequest_Email
+	Identifier           isLoginRequest_Identifier `protobuf_oneof:"identifier"`
+	Password             string                    `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                  `json:"-"`
+	XXX_unrecognized     []byte                    `json:"-"`
+	XXX_sizecache        int32                     `json:"-"`
 }
 
 func (m *LoginRequest) Reset()         { *m = LoginRequest{} }
@@ -57,9 +60,39 @@ func (m *LoginRequest) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_LoginRequest proto.InternalMessageInfo
 
-func (m *LoginRequest) GetUsername() string {
+type isLoginRequest_Identifier interface {
+	isLoginRequest_Identifier()
+}
+
+type LoginRequest_Username struct {
+	Username string `protobuf:"bytes,1,opt,name=username,proto3,oneof"`
+}
+
+type LoginRequest_Email struct {
+	Email string `protobuf:"bytes,3,opt,name=email,proto3,oneof"`
+}
+
+func (*LoginRequest_Username) isLoginRequest_Identifier() {}
+
+func (*LoginRequest_Email) isLoginRequest_Identifier() {}
+
+func (m *LoginRequest) GetIdentifier() isLoginRequest_Identifier {
 	if m != nil {
-		return m.Username
+		return m.Identifier
+	}
+	return nil
+}
+
+func (m *LoginRequest) GetUsername() string {
+	if x, ok := m.GetIdentifier().(*LoginRequest_Username); ok {
+		return x.Username
+	}
+	return ""
+}
+
+func (m *LoginRequest) GetEmail() string {
+	if x, ok := m.GetIdentifier().(*LoginRequest_Email); ok {
+		return x.Email
 	}
 	return ""
 }
@@ -71,8 +104,19 @@ func (m *LoginRequest) GetPassword() string {
 	return ""
 }
 
+// XXX_OneofWrappers is for the internal use of the proto package.
+func (*LoginRequest) XXX_OneofWrappers() []interface{} {
+	return []interface{}{
+		(*LoginRequest_Username)(nil),
+		(*LoginRequest_Email)(nil),
+	}
+}
+
 type LoginResponse struct {
 	AccessToken          string   `protobuf:"bytes,1,opt,name=access_token,json=accessToken,proto3" json:"access_token,omitempty"`
+	RefreshToken         string   `protobuf:"bytes,2,opt,name=refresh_token,json=refreshToken,proto3" json:"refresh_token,omitempty"`
+	MfaRequired          bool     `protobuf:"varint,3,opt,name=mfa_required,json=mfaRequired,proto3" json:"mfa_required,omitempty"`
+	MfaToken             string   `protobuf:"bytes,4,opt,name=mfa_token,json=mfaToken,proto3" json:"mfa_token,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -110,106 +154,1710 @@ func (m *LoginResponse) GetAccessToken() string {
 	return ""
 }
 
-func init() {
-	proto.RegisterType((*LoginRequest)(nil), "ranabd36.qaengine.LoginRequest")
-	proto.RegisterType((*LoginResponse)(nil), "ranabd36.qaengine.LoginResponse")
+func (m *LoginResponse) GetRefreshToken() string {
+	if m != nil {
+		return m.RefreshToken
+	}
+	return ""
 }
 
-func init() {
-	proto.RegisterFile("auth_server_message.proto", fileDescriptor_a2ce5bf2b83f8231)
+func (m *LoginResponse) GetMfaRequired() bool {
+	if m != nil {
+		return m.MfaRequired
+	}
+	return false
 }
 
-var fileDescriptor_a2ce5bf2b83f8231 = []byte{
-	// 203 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x09, 0x6e, 0x88, 0x02, 0xff, 0xe2, 0x92, 0x4c, 0x2c, 0x2d, 0xc9,
-	0x88, 0x2f, 0x4e, 0x2d, 0x2a, 0x4b, 0x2d, 0x8a, 0xcf, 0x4d, 0x2d, 0x2e, 0x4e, 0x4c, 0x4f, 0xd5,
-	0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x2c, 0x4a, 0xcc, 0x4b, 0x4c, 0x4a, 0x31, 0x36, 0xd3,
-	0x2b, 0x4c, 0x4c, 0xcd, 0x4b, 0xcf, 0xcc, 0x4b, 0x55, 0x72, 0xe3, 0xe2, 0xf1, 0xc9, 0x07, 0xb2,
-	0x82, 0x52, 0x0b, 0x4b, 0x53, 0x8b, 0x4b, 0x84, 0xa4, 0xb8, 0x38, 0x4a, 0x81, 0x7a, 0xf3, 0x12,
-	0x73, 0x53, 0x25, 0x18, 0x15, 0x18, 0x35, 0x38, 0x83, 0xe0, 0x7c, 0x90, 0x5c, 0x41, 0x62, 0x71,
-	0x71, 0x79, 0x7e, 0x51, 0x8a, 0x04, 0x13, 0x44, 0x0e, 0xc6, 0x57, 0x32, 0xe2, 0xe2, 0x85, 0x9a,
-	0x53, 0x5c, 0x90, 0x9f, 0x57, 0x9c, 0x2a, 0xa4, 0xc8, 0xc5, 0x93, 0x98, 0x9c, 0x0c, 0xb4, 0x3e,
-	0xbe, 0x24, 0x3f, 0x3b, 0x35, 0x0f, 0x6a, 0x18, 0x37, 0x44, 0x2c, 0x04, 0x24, 0x64, 0x14, 0xcd,
-	0xc5, 0xed, 0x08, 0x74, 0x6b, 0x30, 0xd0, 0xa9, 0x99, 0xc9, 0xa9, 0x42, 0x3e, 0x5c, 0xac, 0x60,
-	0x23, 0x84, 0xe4, 0xf5, 0x30, 0xdc, 0xa9, 0x87, 0xec, 0x48, 0x29, 0x05, 0xdc, 0x0a, 0x20, 0xb6,
-	0x2b, 0x31, 0x38, 0xb1, 0x44, 0x31, 0x15, 0x24, 0x25, 0xb1, 0x81, 0x3d, 0x6e, 0x0c, 0x08, 0x00,
-	0x00, 0xff, 0xff, 0x3e, 0x76, 0x6e, 0xbf, 0x15, 0x01, 0x00, 0x00,
+func (m *LoginResponse) GetMfaToken() string {
+	if m != nil {
+		return m.MfaToken
+	}
+	return ""
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConnInterface
+type VerifyMFARequest struct {
+	MfaToken             string   `protobuf:"bytes,1,opt,name=mfa_token,json=mfaToken,proto3" json:"mfa_token,omitempty"`
+	Code                 string   `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
 
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion6
+func (m *VerifyMFARequest) Reset()         { *m = VerifyMFARequest{} }
+func (m *VerifyMFARequest) String() string { return proto.CompactTextString(m) }
+func (*VerifyMFARequest) ProtoMessage()    {}
+func (*VerifyMFARequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a2ce5bf2b83f8231, []int{2}
+}
 
-// AuthServiceClient is the client API for AuthService service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type AuthServiceClient interface {
-	Login(ctx context.Context, in *LoginRequest, opts ...grpc.CallOption) (*LoginResponse, error)
+func (m *VerifyMFARequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_VerifyMFARequest.Unmarshal(m, b)
+}
+func (m *VerifyMFARequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_VerifyMFARequest.Marshal(b, m, deterministic)
+}
+func (m *VerifyMFARequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VerifyMFARequest.Merge(m, src)
+}
+func (m *VerifyMFARequest) XXX_Size() int {
+	return xxx_messageInfo_VerifyMFARequest.Size(m)
+}
+func (m *VerifyMFARequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_VerifyMFARequest.DiscardUnknown(m)
 }
 
-type authServiceClient struct {
-	cc grpc.ClientConnInterface
+var xxx_messageInfo_VerifyMFARequest proto.InternalMessageInfo
+
+func (m *VerifyMFARequest) GetMfaToken() string {
+	if m != nil {
+		return m.MfaToken
+	}
+	return ""
 }
 
-func NewAuthServiceClient(cc grpc.ClientConnInterface) AuthServiceClient {
-	return &authServiceClient{cc}
+func (m *VerifyMFARequest) GetCode() string {
+	if m != nil {
+		return m.Code
+	}
+	return ""
 }
 
-func (c *authServiceClient) Login(ctx context.Context, in *LoginRequest, opts ...grpc.CallOption) (*LoginResponse, error) {
-	out := new(LoginResponse)
-	err := c.cc.Invoke(ctx, "/ranabd36.qaengine.AuthService/Login", in, out, opts...)
-	if err != nil {
-		return nil, err
+type BeginTOTPEnrollmentRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *BeginTOTPEnrollmentRequest) Reset()         { *m = BeginTOTPEnrollmentRequest{} }
+func (m *BeginTOTPEnrollmentRequest) String() string { return proto.CompactTextString(m) }
+func (*BeginTOTPEnrollmentRequest) ProtoMessage()    {}
+func (*BeginTOTPEnrollmentRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a2ce5bf2b83f8231, []int{3}
+}
+
+func (m *BeginTOTPEnrollmentRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BeginTOTPEnrollmentRequest.Unmarshal(m, b)
+}
+func (m *BeginTOTPEnrollmentRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BeginTOTPEnrollmentRequest.Marshal(b, m, deterministic)
+}
+func (m *BeginTOTPEnrollmentRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BeginTOTPEnrollmentRequest.Merge(m, src)
+}
+func (m *BeginTOTPEnrollmentRequest) XXX_Size() int {
+	return xxx_messageInfo_BeginTOTPEnrollmentRequest.Size(m)
+}
+func (m *BeginTOTPEnrollmentRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_BeginTOTPEnrollmentRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BeginTOTPEnrollmentRequest proto.InternalMessageInfo
+
+type BeginTOTPEnrollmentResponse struct {
+	Secret               string   `protobuf:"bytes,1,opt,name=secret,proto3" json:"secret,omitempty"`
+	OtpauthUri           string   `protobuf:"bytes,2,opt,name=otpauth_uri,json=otpauthUri,proto3" json:"otpauth_uri,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *BeginTOTPEnrollmentResponse) Reset()         { *m = BeginTOTPEnrollmentResponse{} }
+func (m *BeginTOTPEnrollmentResponse) String() string { return proto.CompactTextString(m) }
+func (*BeginTOTPEnrollmentResponse) ProtoMessage()    {}
+func (*BeginTOTPEnrollmentResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a2ce5bf2b83f8231, []int{4}
+}
+
+func (m *BeginTOTPEnrollmentResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BeginTOTPEnrollmentResponse.Unmarshal(m, b)
+}
+func (m *BeginTOTPEnrollmentResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BeginTOTPEnrollmentResponse.Marshal(b, m, deterministic)
+}
+func (m *BeginTOTPEnrollmentResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BeginTOTPEnrollmentResponse.Merge(m, src)
+}
+func (m *BeginTOTPEnrollmentResponse) XXX_Size() int {
+	return xxx_messageInfo_BeginTOTPEnrollmentResponse.Size(m)
+}
+func (m *BeginTOTPEnrollmentResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_BeginTOTPEnrollmentResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BeginTOTPEnrollmentResponse proto.InternalMessageInfo
+
+func (m *BeginTOTPEnrollmentResponse) GetSecret() string {
+	if m != nil {
+		return m.Secret
 	}
-	return out, nil
+	return ""
 }
 
-// AuthServiceServer is the server API for AuthService service.
-type AuthServiceServer interface {
-	Login(context.Context, *LoginRequest) (*LoginResponse, error)
+func (m *BeginTOTPEnrollmentResponse) GetOtpauthUri() string {
+	if m != nil {
+		return m.OtpauthUri
+	}
+	return ""
 }
 
-// UnimplementedAuthServiceServer can be embedded to have forward compatible implementations.
-type UnimplementedAuthServiceServer struct {
+type ConfirmTOTPRequest struct {
+	Code                 string   `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (*UnimplementedAuthServiceServer) Login(ctx context.Context, req *LoginRequest) (*LoginResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Login not implemented")
+func (m *ConfirmTOTPRequest) Reset()         { *m = ConfirmTOTPRequest{} }
+func (m *ConfirmTOTPRequest) String() string { return proto.CompactTextString(m) }
+func (*ConfirmTOTPRequest) ProtoMessage()    {}
+func (*ConfirmTOTPRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a2ce5bf2b83f8231, []int{5}
 }
 
-func RegisterAuthServiceServer(s *grpc.Server, srv AuthServiceServer) {
-	s.RegisterService(&_AuthService_serviceDesc, srv)
+func (m *ConfirmTOTPRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ConfirmTOTPRequest.Unmarshal(m, b)
+}
+func (m *ConfirmTOTPRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ConfirmTOTPRequest.Marshal(b, m, deterministic)
+}
+func (m *ConfirmTOTPRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConfirmTOTPRequest.Merge(m, src)
+}
+func (m *ConfirmTOTPRequest) XXX_Size() int {
+	return xxx_messageInfo_ConfirmTOTPRequest.Size(m)
+}
+func (m *ConfirmTOTPRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConfirmTOTPRequest.DiscardUnknown(m)
 }
 
-func _AuthService_Login_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(LoginRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+var xxx_messageInfo_ConfirmTOTPRequest proto.InternalMessageInfo
+
+func (m *ConfirmTOTPRequest) GetCode() string {
+	if m != nil {
+		return m.Code
 	}
-	if interceptor == nil {
-		return srv.(AuthServiceServer).Login(ctx, in)
+	return ""
+}
+
+type ConfirmTOTPResponse struct {
+	IsEnabled            bool     `protobuf:"varint,1,opt,name=is_enabled,json=isEnabled,proto3" json:"is_enabled,omitempty"`
+	RecoveryCodes        []string `protobuf:"bytes,2,rep,name=recovery_codes,json=recoveryCodes,proto3" json:"recovery_codes,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ConfirmTOTPResponse) Reset()         { *m = ConfirmTOTPResponse{} }
+func (m *ConfirmTOTPResponse) String() string { return proto.CompactTextString(m) }
+func (*ConfirmTOTPResponse) ProtoMessage()    {}
+func (*ConfirmTOTPResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a2ce5bf2b83f8231, []int{6}
+}
+
+func (m *ConfirmTOTPResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ConfirmTOTPResponse.Unmarshal(m, b)
+}
+func (m *ConfirmTOTPResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ConfirmTOTPResponse.Marshal(b, m, deterministic)
+}
+func (m *ConfirmTOTPResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConfirmTOTPResponse.Merge(m, src)
+}
+func (m *ConfirmTOTPResponse) XXX_Size() int {
+	return xxx_messageInfo_ConfirmTOTPResponse.Size(m)
+}
+func (m *ConfirmTOTPResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConfirmTOTPResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ConfirmTOTPResponse proto.InternalMessageInfo
+
+func (m *ConfirmTOTPResponse) GetIsEnabled() bool {
+	if m != nil {
+		return m.IsEnabled
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/ranabd36.qaengine.AuthService/Login",
+	return false
+}
+
+func (m *ConfirmTOTPResponse) GetRecoveryCodes() []string {
+	if m != nil {
+		return m.RecoveryCodes
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AuthServiceServer).Login(ctx, req.(*LoginRequest))
+	return nil
+}
+
+type RefreshTokenRequest struct {
+	RefreshToken         string   `protobuf:"bytes,1,opt,name=refresh_token,json=refreshToken,proto3" json:"refresh_token,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RefreshTokenRequest) Reset()         { *m = RefreshTokenRequest{} }
+func (m *RefreshTokenRequest) String() string { return proto.CompactTextString(m) }
+func (*RefreshTokenRequest) ProtoMessage()    {}
+func (*RefreshTokenRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a2ce5bf2b83f8231, []int{7}
+}
+
+func (m *RefreshTokenRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RefreshTokenRequest.Unmarshal(m, b)
+}
+func (m *RefreshTokenRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RefreshTokenRequest.Marshal(b, m, deterministic)
+}
+func (m *RefreshTokenRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RefreshTokenRequest.Merge(m, src)
+}
+func (m *RefreshTokenRequest) XXX_Size() int {
+	return xxx_messageInfo_RefreshTokenRequest.Size(m)
+}
+func (m *RefreshTokenRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RefreshTokenRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RefreshTokenRequest proto.InternalMessageInfo
+
+func (m *RefreshTokenRequest) GetRefreshToken() string {
+	if m != nil {
+		return m.RefreshToken
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-var _AuthService_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "ranabd36.qaengine.AuthService",
-	HandlerType: (*AuthServiceServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Login",
-			Handler:    _AuthService_Login_Handler,
+type RefreshTokenResponse struct {
+	AccessToken          string   `protobuf:"bytes,1,opt,name=access_token,json=accessToken,proto3" json:"access_token,omitempty"`
+	RefreshToken         string   `protobuf:"bytes,2,opt,name=refresh_token,json=refreshToken,proto3" json:"refresh_token,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RefreshTokenResponse) Reset()         { *m = RefreshTokenResponse{} }
+func (m *RefreshTokenResponse) String() string { return proto.CompactTextString(m) }
+func (*RefreshTokenResponse) ProtoMessage()    {}
+func (*RefreshTokenResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a2ce5bf2b83f8231, []int{8}
+}
+
+func (m *RefreshTokenResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RefreshTokenResponse.Unmarshal(m, b)
+}
+func (m *RefreshTokenResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RefreshTokenResponse.Marshal(b, m, deterministic)
+}
+func (m *RefreshTokenResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RefreshTokenResponse.Merge(m, src)
+}
+func (m *RefreshTokenResponse) XXX_Size() int {
+	return xxx_messageInfo_RefreshTokenResponse.Size(m)
+}
+func (m *RefreshTokenResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RefreshTokenResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RefreshTokenResponse proto.InternalMessageInfo
+
+func (m *RefreshTokenResponse) GetAccessToken() string {
+	if m != nil {
+		return m.AccessToken
+	}
+	return ""
+}
+
+func (m *RefreshTokenResponse) GetRefreshToken() string {
+	if m != nil {
+		return m.RefreshToken
+	}
+	return ""
+}
+
+type LogoutRequest struct {
+	RefreshToken         string   `protobuf:"bytes,1,opt,name=refresh_token,json=refreshToken,proto3" json:"refresh_token,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *LogoutRequest) Reset()         { *m = LogoutRequest{} }
+func (m *LogoutRequest) String() string { return proto.CompactTextString(m) }
+func (*LogoutRequest) ProtoMessage()    {}
+func (*LogoutRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a2ce5bf2b83f8231, []int{9}
+}
+
+func (m *LogoutRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_LogoutRequest.Unmarshal(m, b)
+}
+func (m *LogoutRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_LogoutRequest.Marshal(b, m, deterministic)
+}
+func (m *LogoutRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LogoutRequest.Merge(m, src)
+}
+func (m *LogoutRequest) XXX_Size() int {
+	return xxx_messageInfo_LogoutRequest.Size(m)
+}
+func (m *LogoutRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_LogoutRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LogoutRequest proto.InternalMessageInfo
+
+func (m *LogoutRequest) GetRefreshToken() string {
+	if m != nil {
+		return m.RefreshToken
+	}
+	return ""
+}
+
+type LogoutResponse struct {
+	IsLoggedOut          bool     `protobuf:"varint,1,opt,name=is_logged_out,json=isLoggedOut,proto3" json:"is_logged_out,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *LogoutResponse) Reset()         { *m = LogoutResponse{} }
+func (m *LogoutResponse) String() string { return proto.CompactTextString(m) }
+func (*LogoutResponse) ProtoMessage()    {}
+func (*LogoutResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a2ce5bf2b83f8231, []int{10}
+}
+
+func (m *LogoutResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_LogoutResponse.Unmarshal(m, b)
+}
+func (m *LogoutResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_LogoutResponse.Marshal(b, m, deterministic)
+}
+func (m *LogoutResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LogoutResponse.Merge(m, src)
+}
+func (m *LogoutResponse) XXX_Size() int {
+	return xxx_messageInfo_LogoutResponse.Size(m)
+}
+func (m *LogoutResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_LogoutResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LogoutResponse proto.InternalMessageInfo
+
+func (m *LogoutResponse) GetIsLoggedOut() bool {
+	if m != nil {
+		return m.IsLoggedOut
+	}
+	return false
+}
+
+type RevokeUserSessionsRequest struct {
+	UserId               int32    `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RevokeUserSessionsRequest) Reset()         { *m = RevokeUserSessionsRequest{} }
+func (m *RevokeUserSessionsRequest) String() string { return proto.CompactTextString(m) }
+func (*RevokeUserSessionsRequest) ProtoMessage()    {}
+func (*RevokeUserSessionsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a2ce5bf2b83f8231, []int{11}
+}
+
+func (m *RevokeUserSessionsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RevokeUserSessionsRequest.Unmarshal(m, b)
+}
+func (m *RevokeUserSessionsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RevokeUserSessionsRequest.Marshal(b, m, deterministic)
+}
+func (m *RevokeUserSessionsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RevokeUserSessionsRequest.Merge(m, src)
+}
+func (m *RevokeUserSessionsRequest) XXX_Size() int {
+	return xxx_messageInfo_RevokeUserSessionsRequest.Size(m)
+}
+func (m *RevokeUserSessionsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RevokeUserSessionsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RevokeUserSessionsRequest proto.InternalMessageInfo
+
+func (m *RevokeUserSessionsRequest) GetUserId() int32 {
+	if m != nil {
+		return m.UserId
+	}
+	return 0
+}
+
+type RevokeUserSessionsResponse struct {
+	IsRevoked            bool     `protobuf:"varint,1,opt,name=is_revoked,json=isRevoked,proto3" json:"is_revoked,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RevokeUserSessionsResponse) Reset()         { *m = RevokeUserSessionsResponse{} }
+func (m *RevokeUserSessionsResponse) String() string { return proto.CompactTextString(m) }
+func (*RevokeUserSessionsResponse) ProtoMessage()    {}
+func (*RevokeUserSessionsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a2ce5bf2b83f8231, []int{12}
+}
+
+func (m *RevokeUserSessionsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RevokeUserSessionsResponse.Unmarshal(m, b)
+}
+func (m *RevokeUserSessionsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RevokeUserSessionsResponse.Marshal(b, m, deterministic)
+}
+func (m *RevokeUserSessionsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RevokeUserSessionsResponse.Merge(m, src)
+}
+func (m *RevokeUserSessionsResponse) XXX_Size() int {
+	return xxx_messageInfo_RevokeUserSessionsResponse.Size(m)
+}
+func (m *RevokeUserSessionsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RevokeUserSessionsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RevokeUserSessionsResponse proto.InternalMessageInfo
+
+func (m *RevokeUserSessionsResponse) GetIsRevoked() bool {
+	if m != nil {
+		return m.IsRevoked
+	}
+	return false
+}
+
+type RegisterRequest struct {
+	FirstName            string   `protobuf:"bytes,1,opt,name=first_name,json=firstName,proto3" json:"first_name,omitempty"`
+	LastName             string   `protobuf:"bytes,2,opt,name=last_name,json=lastName,proto3" json:"last_name,omitempty"`
+	Username             string   `protobuf:"bytes,3,opt,name=username,proto3" json:"username,omitempty"`
+	Email                string   `protobuf:"bytes,4,opt,name=email,proto3" json:"email,omitempty"`
+	Password             string   `protobuf:"bytes,5,opt,name=password,proto3" json:"password,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RegisterRequest) Reset()         { *m = RegisterRequest{} }
+func (m *RegisterRequest) String() string { return proto.CompactTextString(m) }
+func (*RegisterRequest) ProtoMessage()    {}
+func (*RegisterRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a2ce5bf2b83f8231, []int{13}
+}
+
+func (m *RegisterRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RegisterRequest.Unmarshal(m, b)
+}
+func (m *RegisterRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RegisterRequest.Marshal(b, m, deterministic)
+}
+func (m *RegisterRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RegisterRequest.Merge(m, src)
+}
+func (m *RegisterRequest) XXX_Size() int {
+	return xxx_messageInfo_RegisterRequest.Size(m)
+}
+func (m *RegisterRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RegisterRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RegisterRequest proto.InternalMessageInfo
+
+func (m *RegisterRequest) GetFirstName() string {
+	if m != nil {
+		return m.FirstName
+	}
+	return ""
+}
+
+func (m *RegisterRequest) GetLastName() string {
+	if m != nil {
+		return m.LastName
+	}
+	return ""
+}
+
+func (m *RegisterRequest) GetUsername() string {
+	if m != nil {
+		return m.Username
+	}
+	return ""
+}
+
+func (m *RegisterRequest) GetEmail() string {
+	if m != nil {
+		return m.Email
+	}
+	return ""
+}
+
+func (m *RegisterRequest) GetPassword() string {
+	if m != nil {
+		return m.Password
+	}
+	return ""
+}
+
+type RegisterResponse struct {
+	Id                   int32    `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RegisterResponse) Reset()         { *m = RegisterResponse{} }
+func (m *RegisterResponse) String() string { return proto.CompactTextString(m) }
+func (*RegisterResponse) ProtoMessage()    {}
+func (*RegisterResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a2ce5bf2b83f8231, []int{14}
+}
+
+func (m *RegisterResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RegisterResponse.Unmarshal(m, b)
+}
+func (m *RegisterResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RegisterResponse.Marshal(b, m, deterministic)
+}
+func (m *RegisterResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RegisterResponse.Merge(m, src)
+}
+func (m *RegisterResponse) XXX_Size() int {
+	return xxx_messageInfo_RegisterResponse.Size(m)
+}
+func (m *RegisterResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RegisterResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RegisterResponse proto.InternalMessageInfo
+
+func (m *RegisterResponse) GetId() int32 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+type VerifyEmailRequest struct {
+	Token                string   `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *VerifyEmailRequest) Reset()         { *m = VerifyEmailRequest{} }
+func (m *VerifyEmailRequest) String() string { return proto.CompactTextString(m) }
+func (*VerifyEmailRequest) ProtoMessage()    {}
+func (*VerifyEmailRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a2ce5bf2b83f8231, []int{15}
+}
+
+func (m *VerifyEmailRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_VerifyEmailRequest.Unmarshal(m, b)
+}
+func (m *VerifyEmailRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_VerifyEmailRequest.Marshal(b, m, deterministic)
+}
+func (m *VerifyEmailRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VerifyEmailRequest.Merge(m, src)
+}
+func (m *VerifyEmailRequest) XXX_Size() int {
+	return xxx_messageInfo_VerifyEmailRequest.Size(m)
+}
+func (m *VerifyEmailRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_VerifyEmailRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VerifyEmailRequest proto.InternalMessageInfo
+
+func (m *VerifyEmailRequest) GetToken() string {
+	if m != nil {
+		return m.Token
+	}
+	return ""
+}
+
+type VerifyEmailResponse struct {
+	IsVerified           bool     `protobuf:"varint,1,opt,name=is_verified,json=isVerified,proto3" json:"is_verified,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *VerifyEmailResponse) Reset()         { *m = VerifyEmailResponse{} }
+func (m *VerifyEmailResponse) String() string { return proto.CompactTextString(m) }
+func (*VerifyEmailResponse) ProtoMessage()    {}
+func (*VerifyEmailResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a2ce5bf2b83f8231, []int{16}
+}
+
+func (m *VerifyEmailResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_VerifyEmailResponse.Unmarshal(m, b)
+}
+func (m *VerifyEmailResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_VerifyEmailResponse.Marshal(b, m, deterministic)
+}
+func (m *VerifyEmailResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VerifyEmailResponse.Merge(m, src)
+}
+func (m *VerifyEmailResponse) XXX_Size() int {
+	return xxx_messageInfo_VerifyEmailResponse.Size(m)
+}
+func (m *VerifyEmailResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_VerifyEmailResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VerifyEmailResponse proto.InternalMessageInfo
+
+func (m *VerifyEmailResponse) GetIsVerified() bool {
+	if m != nil {
+		return m.IsVerified
+	}
+	return false
+}
+
+type RequestPasswordResetRequest struct {
+	Email                string   `protobuf:"bytes,1,opt,name=email,proto3" json:"email,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RequestPasswordResetRequest) Reset()         { *m = RequestPasswordResetRequest{} }
+func (m *RequestPasswordResetRequest) String() string { return proto.CompactTextString(m) }
+func (*RequestPasswordResetRequest) ProtoMessage()    {}
+func (*RequestPasswordResetRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a2ce5bf2b83f8231, []int{17}
+}
+
+func (m *RequestPasswordResetRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RequestPasswordResetRequest.Unmarshal(m, b)
+}
+func (m *RequestPasswordResetRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RequestPasswordResetRequest.Marshal(b, m, deterministic)
+}
+func (m *RequestPasswordResetRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RequestPasswordResetRequest.Merge(m, src)
+}
+func (m *RequestPasswordResetRequest) XXX_Size() int {
+	return xxx_messageInfo_RequestPasswordResetRequest.Size(m)
+}
+func (m *RequestPasswordResetRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RequestPasswordResetRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RequestPasswordResetRequest proto.InternalMessageInfo
+
+func (m *RequestPasswordResetRequest) GetEmail() string {
+	if m != nil {
+		return m.Email
+	}
+	return ""
+}
+
+type RequestPasswordResetResponse struct {
+	IsRequested          bool     `protobuf:"varint,1,opt,name=is_requested,json=isRequested,proto3" json:"is_requested,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RequestPasswordResetResponse) Reset()         { *m = RequestPasswordResetResponse{} }
+func (m *RequestPasswordResetResponse) String() string { return proto.CompactTextString(m) }
+func (*RequestPasswordResetResponse) ProtoMessage()    {}
+func (*RequestPasswordResetResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a2ce5bf2b83f8231, []int{18}
+}
+
+func (m *RequestPasswordResetResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RequestPasswordResetResponse.Unmarshal(m, b)
+}
+func (m *RequestPasswordResetResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RequestPasswordResetResponse.Marshal(b, m, deterministic)
+}
+func (m *RequestPasswordResetResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RequestPasswordResetResponse.Merge(m, src)
+}
+func (m *RequestPasswordResetResponse) XXX_Size() int {
+	return xxx_messageInfo_RequestPasswordResetResponse.Size(m)
+}
+func (m *RequestPasswordResetResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RequestPasswordResetResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RequestPasswordResetResponse proto.InternalMessageInfo
+
+func (m *RequestPasswordResetResponse) GetIsRequested() bool {
+	if m != nil {
+		return m.IsRequested
+	}
+	return false
+}
+
+type ResetPasswordRequest struct {
+	Token                string   `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	NewPassword          string   `protobuf:"bytes,2,opt,name=new_password,json=newPassword,proto3" json:"new_password,omitempty"`
+	RetypeNewPassword    string   `protobuf:"bytes,3,opt,name=retype_new_password,json=retypeNewPassword,proto3" json:"retype_new_password,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ResetPasswordRequest) Reset()         { *m = ResetPasswordRequest{} }
+func (m *ResetPasswordRequest) String() string { return proto.CompactTextString(m) }
+func (*ResetPasswordRequest) ProtoMessage()    {}
+func (*ResetPasswordRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a2ce5bf2b83f8231, []int{19}
+}
+
+func (m *ResetPasswordRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ResetPasswordRequest.Unmarshal(m, b)
+}
+func (m *ResetPasswordRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ResetPasswordRequest.Marshal(b, m, deterministic)
+}
+func (m *ResetPasswordRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ResetPasswordRequest.Merge(m, src)
+}
+func (m *ResetPasswordRequest) XXX_Size() int {
+	return xxx_messageInfo_ResetPasswordRequest.Size(m)
+}
+func (m *ResetPasswordRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ResetPasswordRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ResetPasswordRequest proto.InternalMessageInfo
+
+func (m *ResetPasswordRequest) GetToken() string {
+	if m != nil {
+		return m.Token
+	}
+	return ""
+}
+
+func (m *ResetPasswordRequest) GetNewPassword() string {
+	if m != nil {
+		return m.NewPassword
+	}
+	return ""
+}
+
+func (m *ResetPasswordRequest) GetRetypeNewPassword() string {
+	if m != nil {
+		return m.RetypeNewPassword
+	}
+	return ""
+}
+
+type ResetPasswordResponse struct {
+	IsPasswordReset      bool     `protobuf:"varint,1,opt,name=is_password_reset,json=isPasswordReset,proto3" json:"is_password_reset,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ResetPasswordResponse) Reset()         { *m = ResetPasswordResponse{} }
+func (m *ResetPasswordResponse) String() string { return proto.CompactTextString(m) }
+func (*ResetPasswordResponse) ProtoMessage()    {}
+func (*ResetPasswordResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a2ce5bf2b83f8231, []int{20}
+}
+
+func (m *ResetPasswordResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ResetPasswordResponse.Unmarshal(m, b)
+}
+func (m *ResetPasswordResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ResetPasswordResponse.Marshal(b, m, deterministic)
+}
+func (m *ResetPasswordResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ResetPasswordResponse.Merge(m, src)
+}
+func (m *ResetPasswordResponse) XXX_Size() int {
+	return xxx_messageInfo_ResetPasswordResponse.Size(m)
+}
+func (m *ResetPasswordResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ResetPasswordResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ResetPasswordResponse proto.InternalMessageInfo
+
+func (m *ResetPasswordResponse) GetIsPasswordReset() bool {
+	if m != nil {
+		return m.IsPasswordReset
+	}
+	return false
+}
+
+type UnlockUserRequest struct {
+	UserId               int32    `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *UnlockUserRequest) Reset()         { *m = UnlockUserRequest{} }
+func (m *UnlockUserRequest) String() string { return proto.CompactTextString(m) }
+func (*UnlockUserRequest) ProtoMessage()    {}
+func (*UnlockUserRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a2ce5bf2b83f8231, []int{21}
+}
+
+func (m *UnlockUserRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UnlockUserRequest.Unmarshal(m, b)
+}
+func (m *UnlockUserRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UnlockUserRequest.Marshal(b, m, deterministic)
+}
+func (m *UnlockUserRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UnlockUserRequest.Merge(m, src)
+}
+func (m *UnlockUserRequest) XXX_Size() int {
+	return xxx_messageInfo_UnlockUserRequest.Size(m)
+}
+func (m *UnlockUserRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_UnlockUserRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UnlockUserRequest proto.InternalMessageInfo
+
+func (m *UnlockUserRequest) GetUserId() int32 {
+	if m != nil {
+		return m.UserId
+	}
+	return 0
+}
+
+type UnlockUserResponse struct {
+	IsUnlocked           bool     `protobuf:"varint,1,opt,name=is_unlocked,json=isUnlocked,proto3" json:"is_unlocked,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *UnlockUserResponse) Reset()         { *m = UnlockUserResponse{} }
+func (m *UnlockUserResponse) String() string { return proto.CompactTextString(m) }
+func (*UnlockUserResponse) ProtoMessage()    {}
+func (*UnlockUserResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a2ce5bf2b83f8231, []int{22}
+}
+
+func (m *UnlockUserResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UnlockUserResponse.Unmarshal(m, b)
+}
+func (m *UnlockUserResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UnlockUserResponse.Marshal(b, m, deterministic)
+}
+func (m *UnlockUserResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UnlockUserResponse.Merge(m, src)
+}
+func (m *UnlockUserResponse) XXX_Size() int {
+	return xxx_messageInfo_UnlockUserResponse.Size(m)
+}
+func (m *UnlockUserResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_UnlockUserResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UnlockUserResponse proto.InternalMessageInfo
+
+func (m *UnlockUserResponse) GetIsUnlocked() bool {
+	if m != nil {
+		return m.IsUnlocked
+	}
+	return false
+}
+
+type GetPublicKeysRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetPublicKeysRequest) Reset()         { *m = GetPublicKeysRequest{} }
+func (m *GetPublicKeysRequest) String() string { return proto.CompactTextString(m) }
+func (*GetPublicKeysRequest) ProtoMessage()    {}
+func (*GetPublicKeysRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a2ce5bf2b83f8231, []int{23}
+}
+
+func (m *GetPublicKeysRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetPublicKeysRequest.Unmarshal(m, b)
+}
+func (m *GetPublicKeysRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetPublicKeysRequest.Marshal(b, m, deterministic)
+}
+func (m *GetPublicKeysRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetPublicKeysRequest.Merge(m, src)
+}
+func (m *GetPublicKeysRequest) XXX_Size() int {
+	return xxx_messageInfo_GetPublicKeysRequest.Size(m)
+}
+func (m *GetPublicKeysRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetPublicKeysRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetPublicKeysRequest proto.InternalMessageInfo
+
+// JSONWebKey is a public key verifying access tokens as defined by RFC 7517.
+type JSONWebKey struct {
+	Kty                  string   `protobuf:"bytes,1,opt,name=kty,proto3" json:"kty,omitempty"`
+	Kid                  string   `protobuf:"bytes,2,opt,name=kid,proto3" json:"kid,omitempty"`
+	Use                  string   `protobuf:"bytes,3,opt,name=use,proto3" json:"use,omitempty"`
+	Alg                  string   `protobuf:"bytes,4,opt,name=alg,proto3" json:"alg,omitempty"`
+	N                    string   `protobuf:"bytes,5,opt,name=n,proto3" json:"n,omitempty"`
+	E                    string   `protobuf:"bytes,6,opt,name=e,proto3" json:"e,omitempty"`
+	Crv                  string   `protobuf:"bytes,7,opt,name=crv,proto3" json:"crv,omitempty"`
+	X                    string   `protobuf:"bytes,8,opt,name=x,proto3" json:"x,omitempty"`
+	Y                    string   `protobuf:"bytes,9,opt,name=y,proto3" json:"y,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *JSONWebKey) Reset()         { *m = JSONWebKey{} }
+func (m *JSONWebKey) String() string { return proto.CompactTextString(m) }
+func (*JSONWebKey) ProtoMessage()    {}
+func (*JSONWebKey) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a2ce5bf2b83f8231, []int{24}
+}
+
+func (m *JSONWebKey) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_JSONWebKey.Unmarshal(m, b)
+}
+func (m *JSONWebKey) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_JSONWebKey.Marshal(b, m, deterministic)
+}
+func (m *JSONWebKey) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_JSONWebKey.Merge(m, src)
+}
+func (m *JSONWebKey) XXX_Size() int {
+	return xxx_messageInfo_JSONWebKey.Size(m)
+}
+func (m *JSONWebKey) XXX_DiscardUnknown() {
+	xxx_messageInfo_JSONWebKey.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_JSONWebKey proto.InternalMessageInfo
+
+func (m *JSONWebKey) GetKty() string {
+	if m != nil {
+		return m.Kty
+	}
+	return ""
+}
+
+func (m *JSONWebKey) GetKid() string {
+	if m != nil {
+		return m.Kid
+	}
+	return ""
+}
+
+func (m *JSONWebKey) GetUse() string {
+	if m != nil {
+		return m.Use
+	}
+	return ""
+}
+
+func (m *JSONWebKey) GetAlg() string {
+	if m != nil {
+		return m.Alg
+	}
+	return ""
+}
+
+func (m *JSONWebKey) GetN() string {
+	if m != nil {
+		return m.N
+	}
+	return ""
+}
+
+func (m *JSONWebKey) GetE() string {
+	if m != nil {
+		return m.E
+	}
+	return ""
+}
+
+func (m *JSONWebKey) GetCrv() string {
+	if m != nil {
+		return m.Crv
+	}
+	return ""
+}
+
+func (m *JSONWebKey) GetX() string {
+	if m != nil {
+		return m.X
+	}
+	return ""
+}
+
+func (m *JSONWebKey) GetY() string {
+	if m != nil {
+		return m.Y
+	}
+	return ""
+}
+
+type GetPublicKeysResponse struct {
+	Keys                 []*JSONWebKey `protobuf:"bytes,1,rep,name=keys,proto3" json:"keys,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *GetPublicKeysResponse) Reset()         { *m = GetPublicKeysResponse{} }
+func (m *GetPublicKeysResponse) String() string { return proto.CompactTextString(m) }
+func (*GetPublicKeysResponse) ProtoMessage()    {}
+func (*GetPublicKeysResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a2ce5bf2b83f8231, []int{25}
+}
+
+func (m *GetPublicKeysResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetPublicKeysResponse.Unmarshal(m, b)
+}
+func (m *GetPublicKeysResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetPublicKeysResponse.Marshal(b, m, deterministic)
+}
+func (m *GetPublicKeysResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetPublicKeysResponse.Merge(m, src)
+}
+func (m *GetPublicKeysResponse) XXX_Size() int {
+	return xxx_messageInfo_GetPublicKeysResponse.Size(m)
+}
+func (m *GetPublicKeysResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetPublicKeysResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetPublicKeysResponse proto.InternalMessageInfo
+
+func (m *GetPublicKeysResponse) GetKeys() []*JSONWebKey {
+	if m != nil {
+		return m.Keys
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*LoginRequest)(nil), "ranabd36.qaengine.LoginRequest")
+	proto.RegisterType((*LoginResponse)(nil), "ranabd36.qaengine.LoginResponse")
+	proto.RegisterType((*VerifyMFARequest)(nil), "ranabd36.qaengine.VerifyMFARequest")
+	proto.RegisterType((*BeginTOTPEnrollmentRequest)(nil), "ranabd36.qaengine.BeginTOTPEnrollmentRequest")
+	proto.RegisterType((*BeginTOTPEnrollmentResponse)(nil), "ranabd36.qaengine.BeginTOTPEnrollmentResponse")
+	proto.RegisterType((*ConfirmTOTPRequest)(nil), "ranabd36.qaengine.ConfirmTOTPRequest")
+	proto.RegisterType((*ConfirmTOTPResponse)(nil), "ranabd36.qaengine.ConfirmTOTPResponse")
+	proto.RegisterType((*RefreshTokenRequest)(nil), "ranabd36.qaengine.RefreshTokenRequest")
+	proto.RegisterType((*RefreshTokenResponse)(nil), "ranabd36.qaengine.RefreshTokenResponse")
+	proto.RegisterType((*LogoutRequest)(nil), "ranabd36.qaengine.LogoutRequest")
+	proto.RegisterType((*LogoutResponse)(nil), "ranabd36.qaengine.LogoutResponse")
+	proto.RegisterType((*RevokeUserSessionsRequest)(nil), "ranabd36.qaengine.RevokeUserSessionsRequest")
+	proto.RegisterType((*RevokeUserSessionsResponse)(nil), "ranabd36.qaengine.RevokeUserSessionsResponse")
+	proto.RegisterType((*RegisterRequest)(nil), "ranabd36.qaengine.RegisterRequest")
+	proto.RegisterType((*RegisterResponse)(nil), "ranabd36.qaengine.RegisterResponse")
+	proto.RegisterType((*VerifyEmailRequest)(nil), "ranabd36.qaengine.VerifyEmailRequest")
+	proto.RegisterType((*VerifyEmailResponse)(nil), "ranabd36.qaengine.VerifyEmailResponse")
+	proto.RegisterType((*RequestPasswordResetRequest)(nil), "ranabd36.qaengine.RequestPasswordResetRequest")
+	proto.RegisterType((*RequestPasswordResetResponse)(nil), "ranabd36.qaengine.RequestPasswordResetResponse")
+	proto.RegisterType((*ResetPasswordRequest)(nil), "ranabd36.qaengine.ResetPasswordRequest")
+	proto.RegisterType((*ResetPasswordResponse)(nil), "ranabd36.qaengine.ResetPasswordResponse")
+	proto.RegisterType((*UnlockUserRequest)(nil), "ranabd36.qaengine.UnlockUserRequest")
+	proto.RegisterType((*UnlockUserResponse)(nil), "ranabd36.qaengine.UnlockUserResponse")
+	proto.RegisterType((*GetPublicKeysRequest)(nil), "ranabd36.qaengine.GetPublicKeysRequest")
+	proto.RegisterType((*JSONWebKey)(nil), "ranabd36.qaengine.JSONWebKey")
+	proto.RegisterType((*GetPublicKeysResponse)(nil), "ranabd36.qaengine.GetPublicKeysResponse")
+}
+
+func init() {
+	proto.RegisterFile("auth_server_message.proto", fileDescriptor_a2ce5bf2b83f8231)
+}
+
+var fileDescriptor_a2ce5bf2b83f8231 = []byte{
+	// 1188 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x57, 0xdd, 0x8f, 0xd3, 0x46,
+	0x10, 0xaf, 0xef, 0x23, 0x24, 0xe3, 0xe4, 0xb8, 0xdb, 0x0b, 0x57, 0xe3, 0x03, 0x71, 0x2c, 0x85,
+	0x46, 0x88, 0xa6, 0x2a, 0x50, 0x1e, 0xda, 0x27, 0x38, 0xd1, 0x0f, 0xa0, 0x80, 0xcc, 0x1d, 0x95,
+	0xfa, 0x65, 0x39, 0xce, 0x24, 0x6c, 0xcf, 0xb1, 0xc3, 0xae, 0x1d, 0xc8, 0x53, 0xd5, 0xa7, 0x4a,
+	0xa7, 0xbe, 0xf7, 0xa1, 0x52, 0xff, 0x8b, 0xeb, 0xdf, 0x57, 0xad, 0x77, 0x9d, 0xd8, 0xd8, 0xb9,
+	0x1c, 0x52, 0xdf, 0xbc, 0x33, 0xbf, 0x99, 0xf9, 0xed, 0xce, 0xec, 0x2f, 0x1b, 0xb8, 0xe8, 0x25,
+	0xf1, 0x2b, 0x57, 0x20, 0x9f, 0x20, 0x77, 0x47, 0x28, 0x84, 0x37, 0xc4, 0xee, 0x98, 0x47, 0x71,
+	0x44, 0xb6, 0xb8, 0x17, 0x7a, 0xbd, 0xfe, 0x9d, 0x7b, 0xdd, 0xd7, 0x1e, 0x86, 0x43, 0x16, 0xa2,
+	0xbd, 0x95, 0xa2, 0xc7, 0x51, 0xc0, 0xfc, 0xa9, 0x42, 0xd1, 0x10, 0x9a, 0x4f, 0xa2, 0x21, 0x0b,
+	0x1d, 0x7c, 0x9d, 0xa0, 0x88, 0xc9, 0x25, 0xa8, 0x27, 0x02, 0x79, 0xe8, 0x8d, 0xd0, 0x32, 0xf6,
+	0x8c, 0x4e, 0xe3, 0x9b, 0x0f, 0x9c, 0x99, 0x85, 0xec, 0xc0, 0x3a, 0x8e, 0x3c, 0x16, 0x58, 0xab,
+	0xda, 0xa5, 0x96, 0xc4, 0x86, 0xfa, 0xd8, 0x13, 0xe2, 0x4d, 0xc4, 0xfb, 0xd6, 0x8a, 0x74, 0x39,
+	0xb3, 0xf5, 0x83, 0x26, 0x00, 0xeb, 0x63, 0x18, 0xb3, 0x01, 0x43, 0x4e, 0xff, 0x32, 0xa0, 0xa5,
+	0x0b, 0x8a, 0x71, 0x14, 0x0a, 0x24, 0x57, 0xa1, 0xe9, 0xf9, 0x3e, 0x0a, 0xe1, 0xc6, 0xd1, 0x11,
+	0x86, 0xaa, 0xaa, 0x63, 0x2a, 0xdb, 0x81, 0x34, 0x91, 0x6b, 0xd0, 0xe2, 0x38, 0xe0, 0x28, 0x5e,
+	0x69, 0x8c, 0xaa, 0xd1, 0xd4, 0x46, 0x05, 0xba, 0x0a, 0xcd, 0xd1, 0xc0, 0x73, 0x39, 0xbe, 0x4e,
+	0x18, 0xc7, 0x7e, 0x4a, 0xb1, 0xee, 0x98, 0xa3, 0x81, 0xe7, 0x68, 0x13, 0xd9, 0x85, 0x86, 0x84,
+	0xa8, 0x1c, 0x6b, 0x8a, 0xe7, 0x68, 0xe0, 0xa5, 0xf1, 0x74, 0x1f, 0x36, 0x5f, 0x22, 0x67, 0x83,
+	0xe9, 0x77, 0x5f, 0xdd, 0xcf, 0x4e, 0xa3, 0x10, 0x60, 0x14, 0x03, 0x08, 0x81, 0x35, 0x3f, 0xea,
+	0xa3, 0x26, 0x93, 0x7e, 0xd3, 0x4b, 0x60, 0x3f, 0xc0, 0x21, 0x0b, 0x0f, 0x9e, 0x1d, 0x3c, 0x7f,
+	0x18, 0xf2, 0x28, 0x08, 0x46, 0x18, 0xc6, 0x3a, 0x1d, 0x7d, 0x09, 0xbb, 0x95, 0x5e, 0x7d, 0x12,
+	0x3b, 0x50, 0x13, 0xe8, 0x73, 0x8c, 0x75, 0x29, 0xbd, 0x22, 0x57, 0xc0, 0x8c, 0xe2, 0x71, 0xda,
+	0xbb, 0x84, 0x33, 0x5d, 0x0f, 0xb4, 0xe9, 0x90, 0x33, 0xda, 0x01, 0xb2, 0x1f, 0x85, 0x03, 0xc6,
+	0x47, 0x32, 0x73, 0x46, 0x3e, 0xe3, 0x67, 0xe4, 0xf8, 0xfd, 0x08, 0xdb, 0x05, 0xa4, 0xae, 0x7c,
+	0x19, 0x80, 0x09, 0x17, 0x43, 0xaf, 0x17, 0x60, 0x3f, 0x0d, 0xa8, 0x3b, 0x0d, 0x26, 0x1e, 0x2a,
+	0x03, 0xb9, 0x0e, 0x1b, 0x1c, 0xfd, 0x68, 0x82, 0x7c, 0xea, 0xca, 0x34, 0xc2, 0x5a, 0xd9, 0x5b,
+	0xed, 0x34, 0x9c, 0x56, 0x66, 0xdd, 0x97, 0x46, 0xfa, 0x05, 0x6c, 0x3b, 0xb9, 0x8e, 0x64, 0x3c,
+	0x4a, 0xdd, 0x33, 0xca, 0xdd, 0xa3, 0xbf, 0x40, 0xbb, 0x18, 0xfb, 0xff, 0x4e, 0x07, 0xbd, 0x9b,
+	0x8e, 0x5d, 0x94, 0xc4, 0xef, 0xc5, 0xea, 0x2e, 0x6c, 0x64, 0x51, 0x9a, 0x0f, 0x85, 0x16, 0x13,
+	0x6e, 0x10, 0x0d, 0x87, 0xd8, 0x77, 0xa3, 0x24, 0xd6, 0x87, 0x65, 0x32, 0xf1, 0x24, 0xb5, 0x3d,
+	0x4b, 0x62, 0x7a, 0x17, 0x2e, 0x3a, 0x38, 0x89, 0x8e, 0xf0, 0x50, 0x20, 0x7f, 0x81, 0x42, 0xb0,
+	0x28, 0x14, 0x59, 0xdd, 0x0f, 0xe1, 0x9c, 0xbc, 0x4e, 0x2e, 0x53, 0xe7, 0xbc, 0xee, 0xd4, 0xe4,
+	0xf2, 0xdb, 0x3e, 0xfd, 0x12, 0xec, 0xaa, 0xa8, 0x42, 0x87, 0x78, 0x0a, 0xc8, 0x75, 0x48, 0x45,
+	0xf4, 0xe9, 0xdf, 0x06, 0x9c, 0x77, 0x70, 0xc8, 0x44, 0x8c, 0x3c, 0xab, 0x74, 0x19, 0x60, 0xc0,
+	0xb8, 0x88, 0xdd, 0xf9, 0x65, 0x76, 0x1a, 0xa9, 0xe5, 0xa9, 0xbc, 0xcb, 0xbb, 0xd0, 0x08, 0xbc,
+	0xcc, 0xab, 0x2f, 0x6d, 0xe0, 0x69, 0xa7, 0x9d, 0x93, 0x81, 0x55, 0xe5, 0xcb, 0xd6, 0xa4, 0x9d,
+	0x89, 0x80, 0xba, 0x41, 0x15, 0x12, 0xb0, 0x5e, 0x94, 0x00, 0x4a, 0x61, 0x73, 0x4e, 0x4e, 0x6f,
+	0x68, 0x03, 0x56, 0x66, 0x47, 0xb0, 0xc2, 0xfa, 0xf4, 0x26, 0x10, 0x75, 0xfd, 0x1e, 0xca, 0x74,
+	0xd9, 0x1e, 0xda, 0xb0, 0x9e, 0xef, 0x8e, 0x5a, 0xd0, 0x7b, 0xb0, 0x5d, 0xc0, 0xea, 0x94, 0x57,
+	0xc0, 0x64, 0xc2, 0x9d, 0x48, 0x0f, 0x9b, 0x1d, 0x12, 0x30, 0xf1, 0x52, 0x5b, 0xe8, 0x1d, 0xd8,
+	0xd5, 0x89, 0x9f, 0x6b, 0x6a, 0x0e, 0x0a, 0x8c, 0x73, 0xc5, 0xd4, 0xc6, 0x8c, 0xdc, 0xc6, 0xe8,
+	0x7d, 0xb8, 0x54, 0x1d, 0x34, 0x9f, 0xd0, 0xb4, 0x33, 0x29, 0x64, 0x56, 0xd6, 0x64, 0x59, 0xc7,
+	0xb1, 0x4f, 0x7f, 0x93, 0xc3, 0x2d, 0x30, 0x97, 0xe0, 0x94, 0xdd, 0xc9, 0x84, 0x21, 0xbe, 0x71,
+	0xdf, 0x11, 0x54, 0x33, 0xc4, 0x37, 0x59, 0x3c, 0xe9, 0xc2, 0x36, 0xc7, 0x78, 0x3a, 0x46, 0xb7,
+	0x80, 0x54, 0x9d, 0xda, 0x52, 0xae, 0xa7, 0x73, 0x3c, 0xdd, 0x87, 0x0b, 0xef, 0x10, 0xd0, 0xe4,
+	0x6f, 0xc2, 0x16, 0x13, 0xb3, 0x04, 0x2e, 0x97, 0x20, 0xbd, 0x83, 0xf3, 0x4c, 0x14, 0x36, 0x4c,
+	0x6f, 0xc1, 0xd6, 0x61, 0x18, 0x44, 0xfe, 0x91, 0x1c, 0xd0, 0xa5, 0xe3, 0xfc, 0x39, 0x90, 0x3c,
+	0xba, 0xd0, 0xa2, 0x24, 0x75, 0xe4, 0x5b, 0x74, 0xa8, 0x2d, 0x74, 0x07, 0xda, 0x5f, 0x63, 0xfc,
+	0x3c, 0xe9, 0x05, 0xcc, 0x7f, 0x8c, 0xd3, 0xec, 0x10, 0xe9, 0x3f, 0x06, 0xc0, 0xa3, 0x17, 0xcf,
+	0x9e, 0x7e, 0x8f, 0xbd, 0xc7, 0x38, 0x25, 0x9b, 0xb0, 0x7a, 0x14, 0x4f, 0xf5, 0xb9, 0xc9, 0xcf,
+	0xd4, 0xc2, 0xb2, 0xc3, 0x92, 0x9f, 0xd2, 0x92, 0x88, 0x6c, 0x7c, 0xe5, 0xa7, 0xb4, 0x78, 0xc1,
+	0x50, 0xcf, 0xad, 0xfc, 0x24, 0x4d, 0x30, 0x42, 0x3d, 0xae, 0x46, 0x28, 0x57, 0x68, 0xd5, 0xd4,
+	0x2a, 0x45, 0xfb, 0x7c, 0x62, 0x9d, 0x53, 0x68, 0x9f, 0x4f, 0xa4, 0xff, 0xad, 0x55, 0x57, 0xfe,
+	0xb7, 0x72, 0x35, 0xb5, 0x1a, 0x6a, 0x35, 0xa5, 0x8f, 0xe0, 0xc2, 0x3b, 0xc4, 0xf5, 0x96, 0x3f,
+	0x83, 0xb5, 0x23, 0x9c, 0x0a, 0xcb, 0xd8, 0x5b, 0xed, 0x98, 0xb7, 0x2f, 0x77, 0x4b, 0x3f, 0xcb,
+	0xdd, 0xf9, 0xbe, 0x9c, 0x14, 0x7a, 0xfb, 0x5f, 0x13, 0xcc, 0xfb, 0x49, 0xfc, 0xea, 0x05, 0xf2,
+	0x09, 0xf3, 0x91, 0x1c, 0xc0, 0x7a, 0xfa, 0x9b, 0x49, 0xae, 0x54, 0x44, 0xe7, 0x7f, 0xbe, 0xed,
+	0xbd, 0xc5, 0x00, 0x45, 0x87, 0xd6, 0x8f, 0x4f, 0xac, 0xb5, 0xba, 0x61, 0x19, 0x64, 0x08, 0xcd,
+	0xbc, 0xe4, 0x92, 0x1b, 0x15, 0xb1, 0x15, 0x7a, 0x6e, 0x7f, 0xbc, 0x14, 0xa7, 0x4b, 0xd5, 0x8e,
+	0x4f, 0xac, 0x95, 0xba, 0x41, 0x10, 0x6a, 0x4a, 0x45, 0xc9, 0x02, 0x7a, 0x73, 0x59, 0xb6, 0xaf,
+	0x9e, 0x82, 0xd0, 0x69, 0x77, 0x8e, 0x4f, 0x2c, 0x42, 0x36, 0x3c, 0xdf, 0x8f, 0x92, 0x30, 0xee,
+	0x8e, 0xbc, 0xd0, 0x1b, 0xa2, 0x65, 0x90, 0x3f, 0x0c, 0x20, 0x65, 0x05, 0x25, 0xb7, 0x2a, 0xe9,
+	0x2e, 0x90, 0x67, 0xfb, 0x93, 0x33, 0xa2, 0x35, 0x97, 0xf6, 0xf1, 0x89, 0xb5, 0x49, 0x9a, 0x72,
+	0xe6, 0xc5, 0x9c, 0xc9, 0xcf, 0x50, 0xcf, 0xf4, 0x8e, 0xd0, 0xca, 0x84, 0x05, 0xa5, 0xb6, 0xaf,
+	0x9d, 0x8a, 0x29, 0x35, 0x6e, 0x00, 0x66, 0x4e, 0xfe, 0xc8, 0xf5, 0x8a, 0xe8, 0xb2, 0x94, 0xda,
+	0x37, 0x96, 0xc1, 0x4a, 0x75, 0x7e, 0x37, 0xa0, 0xad, 0xa3, 0x0b, 0x4a, 0x40, 0xba, 0x95, 0x7c,
+	0x17, 0x0a, 0xab, 0xfd, 0xe9, 0x99, 0xf1, 0x25, 0x0e, 0x01, 0xb4, 0x0a, 0xca, 0x45, 0xaa, 0xa7,
+	0xaf, 0x2c, 0xae, 0x76, 0x67, 0x39, 0xb0, 0x54, 0x2d, 0x02, 0x98, 0x8b, 0x16, 0xf9, 0xa8, 0x22,
+	0x43, 0x49, 0x01, 0xed, 0xeb, 0x4b, 0x50, 0xa7, 0x4e, 0xca, 0x4f, 0xd0, 0x98, 0x3d, 0x3a, 0xc9,
+	0xb5, 0x85, 0x1d, 0x9a, 0x3f, 0x49, 0xdf, 0xeb, 0x86, 0xff, 0x69, 0xc0, 0x76, 0xc5, 0x83, 0x93,
+	0x54, 0x0d, 0xf9, 0xe2, 0x67, 0xab, 0xdd, 0x3d, 0x2b, 0x7c, 0xc9, 0x05, 0x8d, 0xc1, 0xcc, 0x3d,
+	0x3e, 0x2b, 0xe7, 0xb6, 0xfc, 0x8c, 0xb5, 0x6f, 0x2c, 0x83, 0x2d, 0xa9, 0xfa, 0x2b, 0xb4, 0x0a,
+	0xc2, 0x5c, 0x39, 0x41, 0x55, 0xbf, 0x39, 0x76, 0x67, 0x39, 0xb0, 0xa8, 0x74, 0x0f, 0xd6, 0x7e,
+	0x58, 0x19, 0xf7, 0x7a, 0xb5, 0xf4, 0xaf, 0xd5, 0x9d, 0xff, 0x06, 0x00, 0x1b, 0xbc, 0x62, 0x9d,
+	0x9d, 0x0d, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConnInterface
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion6
+
+// AuthServiceClient is the client API for AuthService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type AuthServiceClient interface {
+	Login(ctx context.Context, in *LoginRequest, opts ...grpc.CallOption) (*LoginResponse, error)
+	RefreshToken(ctx context.Context, in *RefreshTokenRequest, opts ...grpc.CallOption) (*RefreshTokenResponse, error)
+	Logout(ctx context.Context, in *LogoutRequest, opts ...grpc.CallOption) (*LogoutResponse, error)
+	RevokeUserSessions(ctx context.Context, in *RevokeUserSessionsRequest, opts ...grpc.CallOption) (*RevokeUserSessionsResponse, error)
+	Register(ctx context.Context, in *RegisterRequest, opts ...grpc.CallOption) (*RegisterResponse, error)
+	VerifyEmail(ctx context.Context, in *VerifyEmailRequest, opts ...grpc.CallOption) (*VerifyEmailResponse, error)
+	RequestPasswordReset(ctx context.Context, in *RequestPasswordResetRequest, opts ...grpc.CallOption) (*RequestPasswordResetResponse, error)
+	ResetPassword(ctx context.Context, in *ResetPasswordRequest, opts ...grpc.CallOption) (*ResetPasswordResponse, error)
+	UnlockUser(ctx context.Context, in *UnlockUserRequest, opts ...grpc.CallOption) (*UnlockUserResponse, error)
+	VerifyMFA(ctx context.Context, in *VerifyMFARequest, opts ...grpc.CallOption) (*LoginResponse, error)
+	BeginTOTPEnrollment(ctx context.Context, in *BeginTOTPEnrollmentRequest, opts ...grpc.CallOption) (*BeginTOTPEnrollmentResponse, error)
+	ConfirmTOTP(ctx context.Context, in *ConfirmTOTPRequest, opts ...grpc.CallOption) (*ConfirmTOTPResponse, error)
+	GetPublicKeys(ctx context.Context, in *GetPublicKeysRequest, opts ...grpc.CallOption) (*GetPublicKeysResponse, error)
+}
+
+type authServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewAuthServiceClient(cc grpc.ClientConnInterface) AuthServiceClient {
+	return &authServiceClient{cc}
+}
+
+func (c *authServiceClient) Login(ctx context.Context, in *LoginRequest, opts ...grpc.CallOption) (*LoginResponse, error) {
+	out := new(LoginResponse)
+	err := c.cc.Invoke(ctx, "/ranabd36.qaengine.AuthService/Login", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) RefreshToken(ctx context.Context, in *RefreshTokenRequest, opts ...grpc.CallOption) (*RefreshTokenResponse, error) {
+	out := new(RefreshTokenResponse)
+	err := c.cc.Invoke(ctx, "/ranabd36.qaengine.AuthService/RefreshToken", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) Logout(ctx context.Context, in *LogoutRequest, opts ...grpc.CallOption) (*LogoutResponse, error) {
+	out := new(LogoutResponse)
+	err := c.cc.Invoke(ctx, "/ranabd36.qaengine.AuthService/Logout", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) RevokeUserSessions(ctx context.Context, in *RevokeUserSessionsRequest, opts ...grpc.CallOption) (*RevokeUserSessionsResponse, error) {
+	out := new(RevokeUserSessionsResponse)
+	err := c.cc.Invoke(ctx, "/ranabd36.qaengine.AuthService/RevokeUserSessions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) Register(ctx context.Context, in *RegisterRequest, opts ...grpc.CallOption) (*RegisterResponse, error) {
+	out := new(RegisterResponse)
+	err := c.cc.Invoke(ctx, "/ranabd36.qaengine.AuthService/Register", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) VerifyEmail(ctx context.Context, in *VerifyEmailRequest, opts ...grpc.CallOption) (*VerifyEmailResponse, error) {
+	out := new(VerifyEmailResponse)
+	err := c.cc.Invoke(ctx, "/ranabd36.qaengine.AuthService/VerifyEmail", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) RequestPasswordReset(ctx context.Context, in *RequestPasswordResetRequest, opts ...grpc.CallOption) (*RequestPasswordResetResponse, error) {
+	out := new(RequestPasswordResetResponse)
+	err := c.cc.Invoke(ctx, "/ranabd36.qaengine.AuthService/RequestPasswordReset", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) ResetPassword(ctx context.Context, in *ResetPasswordRequest, opts ...grpc.CallOption) (*ResetPasswordResponse, error) {
+	out := new(ResetPasswordResponse)
+	err := c.cc.Invoke(ctx, "/ranabd36.qaengine.AuthService/ResetPassword", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) UnlockUser(ctx context.Context, in *UnlockUserRequest, opts ...grpc.CallOption) (*UnlockUserResponse, error) {
+	out := new(UnlockUserResponse)
+	err := c.cc.Invoke(ctx, "/ranabd36.qaengine.AuthService/UnlockUser", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) VerifyMFA(ctx context.Context, in *VerifyMFARequest, opts ...grpc.CallOption) (*LoginResponse, error) {
+	out := new(LoginResponse)
+	err := c.cc.Invoke(ctx, "/ranabd36.qaengine.AuthService/VerifyMFA", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) BeginTOTPEnrollment(ctx context.Context, in *BeginTOTPEnrollmentRequest, opts ...grpc.CallOption) (*BeginTOTPEnrollmentResponse, error) {
+	out := new(BeginTOTPEnrollmentResponse)
+	err := c.cc.Invoke(ctx, "/ranabd36.qaengine.AuthService/BeginTOTPEnrollment", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) ConfirmTOTP(ctx context.Context, in *ConfirmTOTPRequest, opts ...grpc.CallOption) (*ConfirmTOTPResponse, error) {
+	out := new(ConfirmTOTPResponse)
+	err := c.cc.Invoke(ctx, "/ranabd36.qaengine.AuthService/ConfirmTOTP", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) GetPublicKeys(ctx context.Context, in *GetPublicKeysRequest, opts ...grpc.CallOption) (*GetPublicKeysResponse, error) {
+	out := new(GetPublicKeysResponse)
+	err := c.cc.Invoke(ctx, "/ranabd36.qaengine.AuthService/GetPublicKeys", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// AuthServiceServer is the server API for AuthService service.
+type AuthServiceServer interface {
+	Login(context.Context, *LoginRequest) (*LoginResponse, error)
+	RefreshToken(context.Context, *RefreshTokenRequest) (*RefreshTokenResponse, error)
+	Logout(context.Context, *LogoutRequest) (*LogoutResponse, error)
+	RevokeUserSessions(context.Context, *RevokeUserSessionsRequest) (*RevokeUserSessionsResponse, error)
+	Register(context.Context, *RegisterRequest) (*RegisterResponse, error)
+	VerifyEmail(context.Context, *VerifyEmailRequest) (*VerifyEmailResponse, error)
+	RequestPasswordReset(context.Context, *RequestPasswordResetRequest) (*RequestPasswordResetResponse, error)
+	ResetPassword(context.Context, *ResetPasswordRequest) (*ResetPasswordResponse, error)
+	UnlockUser(context.Context, *UnlockUserRequest) (*UnlockUserResponse, error)
+	VerifyMFA(context.Context, *VerifyMFARequest) (*LoginResponse, error)
+	BeginTOTPEnrollment(context.Context, *BeginTOTPEnrollmentRequest) (*BeginTOTPEnrollmentResponse, error)
+	ConfirmTOTP(context.Context, *ConfirmTOTPRequest) (*ConfirmTOTPResponse, error)
+	GetPublicKeys(context.Context, *GetPublicKeysRequest) (*GetPublicKeysResponse, error)
+}
+
+// UnimplementedAuthServiceServer can be embedded to have forward compatible implementations.
+type UnimplementedAuthServiceServer struct {
+}
+
+func (*UnimplementedAuthServiceServer) Login(ctx context.Context, req *LoginRequest) (*LoginResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Login not implemented")
+}
+func (*UnimplementedAuthServiceServer) RefreshToken(ctx context.Context, req *RefreshTokenRequest) (*RefreshTokenResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RefreshToken not implemented")
+}
+func (*UnimplementedAuthServiceServer) Logout(ctx context.Context, req *LogoutRequest) (*LogoutResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Logout not implemented")
+}
+func (*UnimplementedAuthServiceServer) RevokeUserSessions(ctx context.Context, req *RevokeUserSessionsRequest) (*RevokeUserSessionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeUserSessions not implemented")
+}
+func (*UnimplementedAuthServiceServer) Register(ctx context.Context, req *RegisterRequest) (*RegisterResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Register not implemented")
+}
+func (*UnimplementedAuthServiceServer) VerifyEmail(ctx context.Context, req *VerifyEmailRequest) (*VerifyEmailResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VerifyEmail not implemented")
+}
+func (*UnimplementedAuthServiceServer) RequestPasswordReset(ctx context.Context, req *RequestPasswordResetRequest) (*RequestPasswordResetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RequestPasswordReset not implemented")
+}
+func (*UnimplementedAuthServiceServer) ResetPassword(ctx context.Context, req *ResetPasswordRequest) (*ResetPasswordResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResetPassword not implemented")
+}
+func (*UnimplementedAuthServiceServer) UnlockUser(ctx context.Context, req *UnlockUserRequest) (*UnlockUserResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnlockUser not implemented")
+}
+func (*UnimplementedAuthServiceServer) VerifyMFA(ctx context.Context, req *VerifyMFARequest) (*LoginResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VerifyMFA not implemented")
+}
+func (*UnimplementedAuthServiceServer) BeginTOTPEnrollment(ctx context.Context, req *BeginTOTPEnrollmentRequest) (*BeginTOTPEnrollmentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BeginTOTPEnrollment not implemented")
+}
+func (*UnimplementedAuthServiceServer) ConfirmTOTP(ctx context.Context, req *ConfirmTOTPRequest) (*ConfirmTOTPResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConfirmTOTP not implemented")
+}
+func (*UnimplementedAuthServiceServer) GetPublicKeys(ctx context.Context, req *GetPublicKeysRequest) (*GetPublicKeysResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPublicKeys not implemented")
+}
+
+func RegisterAuthServiceServer(s *grpc.Server, srv AuthServiceServer) {
+	s.RegisterService(&_AuthService_serviceDesc, srv)
+}
+
+func _AuthService_Login_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LoginRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).Login(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ranabd36.qaengine.AuthService/Login",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).Login(ctx, req.(*LoginRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_RefreshToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RefreshTokenRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).RefreshToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ranabd36.qaengine.AuthService/RefreshToken",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).RefreshToken(ctx, req.(*RefreshTokenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_Logout_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LogoutRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).Logout(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ranabd36.qaengine.AuthService/Logout",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).Logout(ctx, req.(*LogoutRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_RevokeUserSessions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeUserSessionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).RevokeUserSessions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ranabd36.qaengine.AuthService/RevokeUserSessions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).RevokeUserSessions(ctx, req.(*RevokeUserSessionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_Register_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RegisterRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).Register(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ranabd36.qaengine.AuthService/Register",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).Register(ctx, req.(*RegisterRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_VerifyEmail_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VerifyEmailRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).VerifyEmail(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ranabd36.qaengine.AuthService/VerifyEmail",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).VerifyEmail(ctx, req.(*VerifyEmailRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_RequestPasswordReset_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RequestPasswordResetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).RequestPasswordReset(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ranabd36.qaengine.AuthService/RequestPasswordReset",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).RequestPasswordReset(ctx, req.(*RequestPasswordResetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_ResetPassword_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResetPasswordRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).ResetPassword(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ranabd36.qaengine.AuthService/ResetPassword",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).ResetPassword(ctx, req.(*ResetPasswordRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_UnlockUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnlockUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).UnlockUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ranabd36.qaengine.AuthService/UnlockUser",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).UnlockUser(ctx, req.(*UnlockUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_VerifyMFA_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VerifyMFARequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).VerifyMFA(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ranabd36.qaengine.AuthService/VerifyMFA",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).VerifyMFA(ctx, req.(*VerifyMFARequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_BeginTOTPEnrollment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BeginTOTPEnrollmentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).BeginTOTPEnrollment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ranabd36.qaengine.AuthService/BeginTOTPEnrollment",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).BeginTOTPEnrollment(ctx, req.(*BeginTOTPEnrollmentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_ConfirmTOTP_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ConfirmTOTPRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).ConfirmTOTP(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ranabd36.qaengine.AuthService/ConfirmTOTP",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).ConfirmTOTP(ctx, req.(*ConfirmTOTPRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_GetPublicKeys_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPublicKeysRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).GetPublicKeys(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ranabd36.qaengine.AuthService/GetPublicKeys",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).GetPublicKeys(ctx, req.(*GetPublicKeysRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _AuthService_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "ranabd36.qaengine.AuthService",
+	HandlerType: (*AuthServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Login",
+			Handler:    _AuthService_Login_Handler,
+		},
+		{
+			MethodName: "RefreshToken",
+			Handler:    _AuthService_RefreshToken_Handler,
+		},
+		{
+			MethodName: "Logout",
+			Handler:    _AuthService_Logout_Handler,
+		},
+		{
+			MethodName: "RevokeUserSessions",
+			Handler:    _AuthService_RevokeUserSessions_Handler,
+		},
+		{
+			MethodName: "Register",
+			Handler:    _AuthService_Register_Handler,
+		},
+		{
+			MethodName: "VerifyEmail",
+			Handler:    _AuthService_VerifyEmail_Handler,
+		},
+		{
+			MethodName: "RequestPasswordReset",
+			Handler:    _AuthService_RequestPasswordReset_Handler,
+		},
+		{
+			MethodName: "ResetPassword",
+			Handler:    _AuthService_ResetPassword_Handler,
+		},
+		{
+			MethodName: "UnlockUser",
+			Handler:    _AuthService_UnlockUser_Handler,
+		},
+		{
+			MethodName: "VerifyMFA",
+			Handler:    _AuthService_VerifyMFA_Handler,
+		},
+		{
+			MethodName: "BeginTOTPEnrollment",
+			Handler:    _AuthService_BeginTOTPEnrollment_Handler,
+		},
+		{
+			MethodName: "ConfirmTOTP",
+			Handler:    _AuthService_ConfirmTOTP_Handler,
+		},
+		{
+			MethodName: "GetPublicKeys",
+			Handler:    _AuthService_GetPublicKeys_Handler,
 		},
 	},
 	Streams:  []grpc.StreamDesc{},
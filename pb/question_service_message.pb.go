@@ -0,0 +1,1587 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: question_service_message.proto
+
+package pb
+
+import (
+	context "context"
+	fmt "fmt"
+	proto "github.com/golang/protobuf/proto"
+	timestamp "github.com/golang/protobuf/ptypes/timestamp"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	math "math"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
+
+type ListQuestionsRequest_TagMatch int32
+
+const (
+	ListQuestionsRequest_ANY ListQuestionsRequest_TagMatch = 0
+	ListQuestionsRequest_ALL ListQuestionsRequest_TagMatch = 1
+)
+
+var ListQuestionsRequest_TagMatch_name = map[int32]string{
+	0: "ANY",
+	1: "ALL",
+}
+
+var ListQuestionsRequest_TagMatch_value = map[string]int32{
+	"ANY": 0,
+	"ALL": 1,
+}
+
+func (x ListQuestionsRequest_TagMatch) String() string {
+	return proto.EnumName(ListQuestionsRequest_TagMatch_name, int32(x))
+}
+
+func (ListQuestionsRequest_TagMatch) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_a86a13c7ea1fa681, []int{14, 0}
+}
+
+type ListQuestionsRequest_Feed int32
+
+const (
+	ListQuestionsRequest_NEWEST     ListQuestionsRequest_Feed = 0
+	ListQuestionsRequest_UNANSWERED ListQuestionsRequest_Feed = 1
+	ListQuestionsRequest_TRENDING   ListQuestionsRequest_Feed = 2
+	ListQuestionsRequest_BY_USER    ListQuestionsRequest_Feed = 3
+)
+
+var ListQuestionsRequest_Feed_name = map[int32]string{
+	0: "NEWEST",
+	1: "UNANSWERED",
+	2: "TRENDING",
+	3: "BY_USER",
+}
+
+var ListQuestionsRequest_Feed_value = map[string]int32{
+	"NEWEST":     0,
+	"UNANSWERED": 1,
+	"TRENDING":   2,
+	"BY_USER":    3,
+}
+
+func (x ListQuestionsRequest_Feed) String() string {
+	return proto.EnumName(ListQuestionsRequest_Feed_name, int32(x))
+}
+
+func (ListQuestionsRequest_Feed) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_a86a13c7ea1fa681, []int{14, 1}
+}
+
+type QuestionEvent_Type int32
+
+const (
+	QuestionEvent_ANSWER_POSTED        QuestionEvent_Type = 0
+	QuestionEvent_ANSWER_UPDATED       QuestionEvent_Type = 1
+	QuestionEvent_ANSWER_DELETED       QuestionEvent_Type = 2
+	QuestionEvent_ANSWER_ACCEPTED      QuestionEvent_Type = 3
+	QuestionEvent_QUESTION_UPDATED     QuestionEvent_Type = 4
+	QuestionEvent_QUESTION_DELETED     QuestionEvent_Type = 5
+	QuestionEvent_SCORE_CHANGED        QuestionEvent_Type = 6
+	QuestionEvent_QUESTION_PUBLISHED   QuestionEvent_Type = 7
+	QuestionEvent_QUESTION_UNPUBLISHED QuestionEvent_Type = 8
+)
+
+var QuestionEvent_Type_name = map[int32]string{
+	0: "ANSWER_POSTED",
+	1: "ANSWER_UPDATED",
+	2: "ANSWER_DELETED",
+	3: "ANSWER_ACCEPTED",
+	4: "QUESTION_UPDATED",
+	5: "QUESTION_DELETED",
+	6: "SCORE_CHANGED",
+	7: "QUESTION_PUBLISHED",
+	8: "QUESTION_UNPUBLISHED",
+}
+
+var QuestionEvent_Type_value = map[string]int32{
+	"ANSWER_POSTED":        0,
+	"ANSWER_UPDATED":       1,
+	"ANSWER_DELETED":       2,
+	"ANSWER_ACCEPTED":      3,
+	"QUESTION_UPDATED":     4,
+	"QUESTION_DELETED":     5,
+	"SCORE_CHANGED":        6,
+	"QUESTION_PUBLISHED":   7,
+	"QUESTION_UNPUBLISHED": 8,
+}
+
+func (x QuestionEvent_Type) String() string {
+	return proto.EnumName(QuestionEvent_Type_name, int32(x))
+}
+
+func (QuestionEvent_Type) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_a86a13c7ea1fa681, []int{17, 0}
+}
+
+type Question struct {
+	Id                   int32                `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	UserId               int32                `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Title                string               `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`
+	Description          string               `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	PublishedAt          *timestamp.Timestamp `protobuf:"bytes,5,opt,name=published_at,json=publishedAt,proto3" json:"published_at,omitempty"`
+	CreatedAt            *timestamp.Timestamp `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt            *timestamp.Timestamp `protobuf:"bytes,7,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	Tags                 []string             `protobuf:"bytes,8,rep,name=tags,proto3" json:"tags,omitempty"`
+	Score                int32                `protobuf:"varint,9,opt,name=score,proto3" json:"score,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *Question) Reset()         { *m = Question{} }
+func (m *Question) String() string { return proto.CompactTextString(m) }
+func (*Question) ProtoMessage()    {}
+func (*Question) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a86a13c7ea1fa681, []int{0}
+}
+
+func (m *Question) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Question.Unmarshal(m, b)
+}
+func (m *Question) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Question.Marshal(b, m, deterministic)
+}
+func (m *Question) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Question.Merge(m, src)
+}
+func (m *Question) XXX_Size() int {
+	return xxx_messageInfo_Question.Size(m)
+}
+func (m *Question) XXX_DiscardUnknown() {
+	xxx_messageInfo_Question.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Question proto.InternalMessageInfo
+
+func (m *Question) GetId() int32 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *Question) GetUserId() int32 {
+	if m != nil {
+		return m.UserId
+	}
+	return 0
+}
+
+func (m *Question) GetTitle() string {
+	if m != nil {
+		return m.Title
+	}
+	return ""
+}
+
+func (m *Question) GetDescription() string {
+	if m != nil {
+		return m.Description
+	}
+	return ""
+}
+
+func (m *Question) GetPublishedAt() *timestamp.Timestamp {
+	if m != nil {
+		return m.PublishedAt
+	}
+	return nil
+}
+
+func (m *Question) GetCreatedAt() *timestamp.Timestamp {
+	if m != nil {
+		return m.CreatedAt
+	}
+	return nil
+}
+
+func (m *Question) GetUpdatedAt() *timestamp.Timestamp {
+	if m != nil {
+		return m.UpdatedAt
+	}
+	return nil
+}
+
+func (m *Question) GetTags() []string {
+	if m != nil {
+		return m.Tags
+	}
+	return nil
+}
+
+func (m *Question) GetScore() int32 {
+	if m != nil {
+		return m.Score
+	}
+	return 0
+}
+
+type Tag struct {
+	Name                 string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	UsageCount           int32    `protobuf:"varint,2,opt,name=usage_count,json=usageCount,proto3" json:"usage_count,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Tag) Reset()         { *m = Tag{} }
+func (m *Tag) String() string { return proto.CompactTextString(m) }
+func (*Tag) ProtoMessage()    {}
+func (*Tag) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a86a13c7ea1fa681, []int{1}
+}
+
+func (m *Tag) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Tag.Unmarshal(m, b)
+}
+func (m *Tag) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Tag.Marshal(b, m, deterministic)
+}
+func (m *Tag) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Tag.Merge(m, src)
+}
+func (m *Tag) XXX_Size() int {
+	return xxx_messageInfo_Tag.Size(m)
+}
+func (m *Tag) XXX_DiscardUnknown() {
+	xxx_messageInfo_Tag.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Tag proto.InternalMessageInfo
+
+func (m *Tag) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *Tag) GetUsageCount() int32 {
+	if m != nil {
+		return m.UsageCount
+	}
+	return 0
+}
+
+type CreateQuestionRequest struct {
+	Question             *Question `protobuf:"bytes,1,opt,name=question,proto3" json:"question,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
+	XXX_unrecognized     []byte    `json:"-"`
+	XXX_sizecache        int32     `json:"-"`
+}
+
+func (m *CreateQuestionRequest) Reset()         { *m = CreateQuestionRequest{} }
+func (m *CreateQuestionRequest) String() string { return proto.CompactTextString(m) }
+func (*CreateQuestionRequest) ProtoMessage()    {}
+func (*CreateQuestionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a86a13c7ea1fa681, []int{2}
+}
+
+func (m *CreateQuestionRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CreateQuestionRequest.Unmarshal(m, b)
+}
+func (m *CreateQuestionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CreateQuestionRequest.Marshal(b, m, deterministic)
+}
+func (m *CreateQuestionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateQuestionRequest.Merge(m, src)
+}
+func (m *CreateQuestionRequest) XXX_Size() int {
+	return xxx_messageInfo_CreateQuestionRequest.Size(m)
+}
+func (m *CreateQuestionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateQuestionRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CreateQuestionRequest proto.InternalMessageInfo
+
+func (m *CreateQuestionRequest) GetQuestion() *Question {
+	if m != nil {
+		return m.Question
+	}
+	return nil
+}
+
+type CreateQuestionResponse struct {
+	Id                   int32    `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CreateQuestionResponse) Reset()         { *m = CreateQuestionResponse{} }
+func (m *CreateQuestionResponse) String() string { return proto.CompactTextString(m) }
+func (*CreateQuestionResponse) ProtoMessage()    {}
+func (*CreateQuestionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a86a13c7ea1fa681, []int{3}
+}
+
+func (m *CreateQuestionResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CreateQuestionResponse.Unmarshal(m, b)
+}
+func (m *CreateQuestionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CreateQuestionResponse.Marshal(b, m, deterministic)
+}
+func (m *CreateQuestionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateQuestionResponse.Merge(m, src)
+}
+func (m *CreateQuestionResponse) XXX_Size() int {
+	return xxx_messageInfo_CreateQuestionResponse.Size(m)
+}
+func (m *CreateQuestionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateQuestionResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CreateQuestionResponse proto.InternalMessageInfo
+
+func (m *CreateQuestionResponse) GetId() int32 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+type GetQuestionRequest struct {
+	Id                   int32    `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetQuestionRequest) Reset()         { *m = GetQuestionRequest{} }
+func (m *GetQuestionRequest) String() string { return proto.CompactTextString(m) }
+func (*GetQuestionRequest) ProtoMessage()    {}
+func (*GetQuestionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a86a13c7ea1fa681, []int{4}
+}
+
+func (m *GetQuestionRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetQuestionRequest.Unmarshal(m, b)
+}
+func (m *GetQuestionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetQuestionRequest.Marshal(b, m, deterministic)
+}
+func (m *GetQuestionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetQuestionRequest.Merge(m, src)
+}
+func (m *GetQuestionRequest) XXX_Size() int {
+	return xxx_messageInfo_GetQuestionRequest.Size(m)
+}
+func (m *GetQuestionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetQuestionRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetQuestionRequest proto.InternalMessageInfo
+
+func (m *GetQuestionRequest) GetId() int32 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+type GetQuestionResponse struct {
+	Question             *Question `protobuf:"bytes,1,opt,name=question,proto3" json:"question,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
+	XXX_unrecognized     []byte    `json:"-"`
+	XXX_sizecache        int32     `json:"-"`
+}
+
+func (m *GetQuestionResponse) Reset()         { *m = GetQuestionResponse{} }
+func (m *GetQuestionResponse) String() string { return proto.CompactTextString(m) }
+func (*GetQuestionResponse) ProtoMessage()    {}
+func (*GetQuestionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a86a13c7ea1fa681, []int{5}
+}
+
+func (m *GetQuestionResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetQuestionResponse.Unmarshal(m, b)
+}
+func (m *GetQuestionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetQuestionResponse.Marshal(b, m, deterministic)
+}
+func (m *GetQuestionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetQuestionResponse.Merge(m, src)
+}
+func (m *GetQuestionResponse) XXX_Size() int {
+	return xxx_messageInfo_GetQuestionResponse.Size(m)
+}
+func (m *GetQuestionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetQuestionResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetQuestionResponse proto.InternalMessageInfo
+
+func (m *GetQuestionResponse) GetQuestion() *Question {
+	if m != nil {
+		return m.Question
+	}
+	return nil
+}
+
+type UpdateQuestionRequest struct {
+	Question             *Question `protobuf:"bytes,1,opt,name=question,proto3" json:"question,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
+	XXX_unrecognized     []byte    `json:"-"`
+	XXX_sizecache        int32     `json:"-"`
+}
+
+func (m *UpdateQuestionRequest) Reset()         { *m = UpdateQuestionRequest{} }
+func (m *UpdateQuestionRequest) String() string { return proto.CompactTextString(m) }
+func (*UpdateQuestionRequest) ProtoMessage()    {}
+func (*UpdateQuestionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a86a13c7ea1fa681, []int{6}
+}
+
+func (m *UpdateQuestionRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UpdateQuestionRequest.Unmarshal(m, b)
+}
+func (m *UpdateQuestionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UpdateQuestionRequest.Marshal(b, m, deterministic)
+}
+func (m *UpdateQuestionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateQuestionRequest.Merge(m, src)
+}
+func (m *UpdateQuestionRequest) XXX_Size() int {
+	return xxx_messageInfo_UpdateQuestionRequest.Size(m)
+}
+func (m *UpdateQuestionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateQuestionRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpdateQuestionRequest proto.InternalMessageInfo
+
+func (m *UpdateQuestionRequest) GetQuestion() *Question {
+	if m != nil {
+		return m.Question
+	}
+	return nil
+}
+
+type UpdateQuestionResponse struct {
+	IsUpdated            bool     `protobuf:"varint,1,opt,name=is_updated,json=isUpdated,proto3" json:"is_updated,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *UpdateQuestionResponse) Reset()         { *m = UpdateQuestionResponse{} }
+func (m *UpdateQuestionResponse) String() string { return proto.CompactTextString(m) }
+func (*UpdateQuestionResponse) ProtoMessage()    {}
+func (*UpdateQuestionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a86a13c7ea1fa681, []int{7}
+}
+
+func (m *UpdateQuestionResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UpdateQuestionResponse.Unmarshal(m, b)
+}
+func (m *UpdateQuestionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UpdateQuestionResponse.Marshal(b, m, deterministic)
+}
+func (m *UpdateQuestionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateQuestionResponse.Merge(m, src)
+}
+func (m *UpdateQuestionResponse) XXX_Size() int {
+	return xxx_messageInfo_UpdateQuestionResponse.Size(m)
+}
+func (m *UpdateQuestionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateQuestionResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpdateQuestionResponse proto.InternalMessageInfo
+
+func (m *UpdateQuestionResponse) GetIsUpdated() bool {
+	if m != nil {
+		return m.IsUpdated
+	}
+	return false
+}
+
+type DeleteQuestionRequest struct {
+	Id                   int32    `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DeleteQuestionRequest) Reset()         { *m = DeleteQuestionRequest{} }
+func (m *DeleteQuestionRequest) String() string { return proto.CompactTextString(m) }
+func (*DeleteQuestionRequest) ProtoMessage()    {}
+func (*DeleteQuestionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a86a13c7ea1fa681, []int{8}
+}
+
+func (m *DeleteQuestionRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DeleteQuestionRequest.Unmarshal(m, b)
+}
+func (m *DeleteQuestionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DeleteQuestionRequest.Marshal(b, m, deterministic)
+}
+func (m *DeleteQuestionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteQuestionRequest.Merge(m, src)
+}
+func (m *DeleteQuestionRequest) XXX_Size() int {
+	return xxx_messageInfo_DeleteQuestionRequest.Size(m)
+}
+func (m *DeleteQuestionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteQuestionRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeleteQuestionRequest proto.InternalMessageInfo
+
+func (m *DeleteQuestionRequest) GetId() int32 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+type DeleteQuestionResponse struct {
+	IsDeleted            bool     `protobuf:"varint,1,opt,name=is_deleted,json=isDeleted,proto3" json:"is_deleted,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DeleteQuestionResponse) Reset()         { *m = DeleteQuestionResponse{} }
+func (m *DeleteQuestionResponse) String() string { return proto.CompactTextString(m) }
+func (*DeleteQuestionResponse) ProtoMessage()    {}
+func (*DeleteQuestionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a86a13c7ea1fa681, []int{9}
+}
+
+func (m *DeleteQuestionResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DeleteQuestionResponse.Unmarshal(m, b)
+}
+func (m *DeleteQuestionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DeleteQuestionResponse.Marshal(b, m, deterministic)
+}
+func (m *DeleteQuestionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteQuestionResponse.Merge(m, src)
+}
+func (m *DeleteQuestionResponse) XXX_Size() int {
+	return xxx_messageInfo_DeleteQuestionResponse.Size(m)
+}
+func (m *DeleteQuestionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteQuestionResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeleteQuestionResponse proto.InternalMessageInfo
+
+func (m *DeleteQuestionResponse) GetIsDeleted() bool {
+	if m != nil {
+		return m.IsDeleted
+	}
+	return false
+}
+
+type PublishQuestionRequest struct {
+	Id                   int32    `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PublishQuestionRequest) Reset()         { *m = PublishQuestionRequest{} }
+func (m *PublishQuestionRequest) String() string { return proto.CompactTextString(m) }
+func (*PublishQuestionRequest) ProtoMessage()    {}
+func (*PublishQuestionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a86a13c7ea1fa681, []int{10}
+}
+
+func (m *PublishQuestionRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PublishQuestionRequest.Unmarshal(m, b)
+}
+func (m *PublishQuestionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PublishQuestionRequest.Marshal(b, m, deterministic)
+}
+func (m *PublishQuestionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PublishQuestionRequest.Merge(m, src)
+}
+func (m *PublishQuestionRequest) XXX_Size() int {
+	return xxx_messageInfo_PublishQuestionRequest.Size(m)
+}
+func (m *PublishQuestionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_PublishQuestionRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PublishQuestionRequest proto.InternalMessageInfo
+
+func (m *PublishQuestionRequest) GetId() int32 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+type PublishQuestionResponse struct {
+	IsPublished          bool     `protobuf:"varint,1,opt,name=is_published,json=isPublished,proto3" json:"is_published,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PublishQuestionResponse) Reset()         { *m = PublishQuestionResponse{} }
+func (m *PublishQuestionResponse) String() string { return proto.CompactTextString(m) }
+func (*PublishQuestionResponse) ProtoMessage()    {}
+func (*PublishQuestionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a86a13c7ea1fa681, []int{11}
+}
+
+func (m *PublishQuestionResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PublishQuestionResponse.Unmarshal(m, b)
+}
+func (m *PublishQuestionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PublishQuestionResponse.Marshal(b, m, deterministic)
+}
+func (m *PublishQuestionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PublishQuestionResponse.Merge(m, src)
+}
+func (m *PublishQuestionResponse) XXX_Size() int {
+	return xxx_messageInfo_PublishQuestionResponse.Size(m)
+}
+func (m *PublishQuestionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_PublishQuestionResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PublishQuestionResponse proto.InternalMessageInfo
+
+func (m *PublishQuestionResponse) GetIsPublished() bool {
+	if m != nil {
+		return m.IsPublished
+	}
+	return false
+}
+
+type UnpublishQuestionRequest struct {
+	Id                   int32    `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *UnpublishQuestionRequest) Reset()         { *m = UnpublishQuestionRequest{} }
+func (m *UnpublishQuestionRequest) String() string { return proto.CompactTextString(m) }
+func (*UnpublishQuestionRequest) ProtoMessage()    {}
+func (*UnpublishQuestionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a86a13c7ea1fa681, []int{12}
+}
+
+func (m *UnpublishQuestionRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UnpublishQuestionRequest.Unmarshal(m, b)
+}
+func (m *UnpublishQuestionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UnpublishQuestionRequest.Marshal(b, m, deterministic)
+}
+func (m *UnpublishQuestionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UnpublishQuestionRequest.Merge(m, src)
+}
+func (m *UnpublishQuestionRequest) XXX_Size() int {
+	return xxx_messageInfo_UnpublishQuestionRequest.Size(m)
+}
+func (m *UnpublishQuestionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_UnpublishQuestionRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UnpublishQuestionRequest proto.InternalMessageInfo
+
+func (m *UnpublishQuestionRequest) GetId() int32 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+type UnpublishQuestionResponse struct {
+	IsUnpublished        bool     `protobuf:"varint,1,opt,name=is_unpublished,json=isUnpublished,proto3" json:"is_unpublished,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *UnpublishQuestionResponse) Reset()         { *m = UnpublishQuestionResponse{} }
+func (m *UnpublishQuestionResponse) String() string { return proto.CompactTextString(m) }
+func (*UnpublishQuestionResponse) ProtoMessage()    {}
+func (*UnpublishQuestionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a86a13c7ea1fa681, []int{13}
+}
+
+func (m *UnpublishQuestionResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UnpublishQuestionResponse.Unmarshal(m, b)
+}
+func (m *UnpublishQuestionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UnpublishQuestionResponse.Marshal(b, m, deterministic)
+}
+func (m *UnpublishQuestionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UnpublishQuestionResponse.Merge(m, src)
+}
+func (m *UnpublishQuestionResponse) XXX_Size() int {
+	return xxx_messageInfo_UnpublishQuestionResponse.Size(m)
+}
+func (m *UnpublishQuestionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_UnpublishQuestionResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UnpublishQuestionResponse proto.InternalMessageInfo
+
+func (m *UnpublishQuestionResponse) GetIsUnpublished() bool {
+	if m != nil {
+		return m.IsUnpublished
+	}
+	return false
+}
+
+type ListQuestionsRequest struct {
+	Tags                 []string                      `protobuf:"bytes,1,rep,name=tags,proto3" json:"tags,omitempty"`
+	TagMatch             ListQuestionsRequest_TagMatch `protobuf:"varint,2,opt,name=tag_match,json=tagMatch,proto3,enum=ranabd36.qaengine.ListQuestionsRequest_TagMatch" json:"tag_match,omitempty"`
+	PageSize             int32                         `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	Feed                 ListQuestionsRequest_Feed     `protobuf:"varint,4,opt,name=feed,proto3,enum=ranabd36.qaengine.ListQuestionsRequest_Feed" json:"feed,omitempty"`
+	UserId               int32                         `protobuf:"varint,5,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	PageToken            string                        `protobuf:"bytes,6,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                      `json:"-"`
+	XXX_unrecognized     []byte                        `json:"-"`
+	XXX_sizecache        int32                         `json:"-"`
+}
+
+func (m *ListQuestionsRequest) Reset()         { *m = ListQuestionsRequest{} }
+func (m *ListQuestionsRequest) String() string { return proto.CompactTextString(m) }
+func (*ListQuestionsRequest) ProtoMessage()    {}
+func (*ListQuestionsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a86a13c7ea1fa681, []int{14}
+}
+
+func (m *ListQuestionsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListQuestionsRequest.Unmarshal(m, b)
+}
+func (m *ListQuestionsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListQuestionsRequest.Marshal(b, m, deterministic)
+}
+func (m *ListQuestionsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListQuestionsRequest.Merge(m, src)
+}
+func (m *ListQuestionsRequest) XXX_Size() int {
+	return xxx_messageInfo_ListQuestionsRequest.Size(m)
+}
+func (m *ListQuestionsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListQuestionsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListQuestionsRequest proto.InternalMessageInfo
+
+func (m *ListQuestionsRequest) GetTags() []string {
+	if m != nil {
+		return m.Tags
+	}
+	return nil
+}
+
+func (m *ListQuestionsRequest) GetTagMatch() ListQuestionsRequest_TagMatch {
+	if m != nil {
+		return m.TagMatch
+	}
+	return ListQuestionsRequest_ANY
+}
+
+func (m *ListQuestionsRequest) GetPageSize() int32 {
+	if m != nil {
+		return m.PageSize
+	}
+	return 0
+}
+
+func (m *ListQuestionsRequest) GetFeed() ListQuestionsRequest_Feed {
+	if m != nil {
+		return m.Feed
+	}
+	return ListQuestionsRequest_NEWEST
+}
+
+func (m *ListQuestionsRequest) GetUserId() int32 {
+	if m != nil {
+		return m.UserId
+	}
+	return 0
+}
+
+func (m *ListQuestionsRequest) GetPageToken() string {
+	if m != nil {
+		return m.PageToken
+	}
+	return ""
+}
+
+type ListQuestionsResponse struct {
+	Questions            []*Question `protobuf:"bytes,1,rep,name=questions,proto3" json:"questions,omitempty"`
+	NextPageToken        string      `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
+	XXX_unrecognized     []byte      `json:"-"`
+	XXX_sizecache        int32       `json:"-"`
+}
+
+func (m *ListQuestionsResponse) Reset()         { *m = ListQuestionsResponse{} }
+func (m *ListQuestionsResponse) String() string { return proto.CompactTextString(m) }
+func (*ListQuestionsResponse) ProtoMessage()    {}
+func (*ListQuestionsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a86a13c7ea1fa681, []int{15}
+}
+
+func (m *ListQuestionsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListQuestionsResponse.Unmarshal(m, b)
+}
+func (m *ListQuestionsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListQuestionsResponse.Marshal(b, m, deterministic)
+}
+func (m *ListQuestionsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListQuestionsResponse.Merge(m, src)
+}
+func (m *ListQuestionsResponse) XXX_Size() int {
+	return xxx_messageInfo_ListQuestionsResponse.Size(m)
+}
+func (m *ListQuestionsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListQuestionsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListQuestionsResponse proto.InternalMessageInfo
+
+func (m *ListQuestionsResponse) GetQuestions() []*Question {
+	if m != nil {
+		return m.Questions
+	}
+	return nil
+}
+
+func (m *ListQuestionsResponse) GetNextPageToken() string {
+	if m != nil {
+		return m.NextPageToken
+	}
+	return ""
+}
+
+type WatchQuestionRequest struct {
+	QuestionId           int32    `protobuf:"varint,1,opt,name=question_id,json=questionId,proto3" json:"question_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *WatchQuestionRequest) Reset()         { *m = WatchQuestionRequest{} }
+func (m *WatchQuestionRequest) String() string { return proto.CompactTextString(m) }
+func (*WatchQuestionRequest) ProtoMessage()    {}
+func (*WatchQuestionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a86a13c7ea1fa681, []int{16}
+}
+
+func (m *WatchQuestionRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_WatchQuestionRequest.Unmarshal(m, b)
+}
+func (m *WatchQuestionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_WatchQuestionRequest.Marshal(b, m, deterministic)
+}
+func (m *WatchQuestionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WatchQuestionRequest.Merge(m, src)
+}
+func (m *WatchQuestionRequest) XXX_Size() int {
+	return xxx_messageInfo_WatchQuestionRequest.Size(m)
+}
+func (m *WatchQuestionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_WatchQuestionRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WatchQuestionRequest proto.InternalMessageInfo
+
+func (m *WatchQuestionRequest) GetQuestionId() int32 {
+	if m != nil {
+		return m.QuestionId
+	}
+	return 0
+}
+
+type QuestionEvent struct {
+	Type                 QuestionEvent_Type `protobuf:"varint,1,opt,name=type,proto3,enum=ranabd36.qaengine.QuestionEvent_Type" json:"type,omitempty"`
+	QuestionId           int32              `protobuf:"varint,2,opt,name=question_id,json=questionId,proto3" json:"question_id,omitempty"`
+	AnswerId             int32              `protobuf:"varint,3,opt,name=answer_id,json=answerId,proto3" json:"answer_id,omitempty"`
+	Answer               *Answer            `protobuf:"bytes,4,opt,name=answer,proto3" json:"answer,omitempty"`
+	Question             *Question          `protobuf:"bytes,5,opt,name=question,proto3" json:"question,omitempty"`
+	Score                int32              `protobuf:"varint,6,opt,name=score,proto3" json:"score,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
+}
+
+func (m *QuestionEvent) Reset()         { *m = QuestionEvent{} }
+func (m *QuestionEvent) String() string { return proto.CompactTextString(m) }
+func (*QuestionEvent) ProtoMessage()    {}
+func (*QuestionEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a86a13c7ea1fa681, []int{17}
+}
+
+func (m *QuestionEvent) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_QuestionEvent.Unmarshal(m, b)
+}
+func (m *QuestionEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_QuestionEvent.Marshal(b, m, deterministic)
+}
+func (m *QuestionEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuestionEvent.Merge(m, src)
+}
+func (m *QuestionEvent) XXX_Size() int {
+	return xxx_messageInfo_QuestionEvent.Size(m)
+}
+func (m *QuestionEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuestionEvent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuestionEvent proto.InternalMessageInfo
+
+func (m *QuestionEvent) GetType() QuestionEvent_Type {
+	if m != nil {
+		return m.Type
+	}
+	return QuestionEvent_ANSWER_POSTED
+}
+
+func (m *QuestionEvent) GetQuestionId() int32 {
+	if m != nil {
+		return m.QuestionId
+	}
+	return 0
+}
+
+func (m *QuestionEvent) GetAnswerId() int32 {
+	if m != nil {
+		return m.AnswerId
+	}
+	return 0
+}
+
+func (m *QuestionEvent) GetAnswer() *Answer {
+	if m != nil {
+		return m.Answer
+	}
+	return nil
+}
+
+func (m *QuestionEvent) GetQuestion() *Question {
+	if m != nil {
+		return m.Question
+	}
+	return nil
+}
+
+func (m *QuestionEvent) GetScore() int32 {
+	if m != nil {
+		return m.Score
+	}
+	return 0
+}
+
+type ListTagsRequest struct {
+	Prefix               string   `protobuf:"bytes,1,opt,name=prefix,proto3" json:"prefix,omitempty"`
+	Limit                int32    `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListTagsRequest) Reset()         { *m = ListTagsRequest{} }
+func (m *ListTagsRequest) String() string { return proto.CompactTextString(m) }
+func (*ListTagsRequest) ProtoMessage()    {}
+func (*ListTagsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a86a13c7ea1fa681, []int{18}
+}
+
+func (m *ListTagsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListTagsRequest.Unmarshal(m, b)
+}
+func (m *ListTagsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListTagsRequest.Marshal(b, m, deterministic)
+}
+func (m *ListTagsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListTagsRequest.Merge(m, src)
+}
+func (m *ListTagsRequest) XXX_Size() int {
+	return xxx_messageInfo_ListTagsRequest.Size(m)
+}
+func (m *ListTagsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListTagsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListTagsRequest proto.InternalMessageInfo
+
+func (m *ListTagsRequest) GetPrefix() string {
+	if m != nil {
+		return m.Prefix
+	}
+	return ""
+}
+
+func (m *ListTagsRequest) GetLimit() int32 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+type ListTagsResponse struct {
+	Tags                 []*Tag   `protobuf:"bytes,1,rep,name=tags,proto3" json:"tags,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListTagsResponse) Reset()         { *m = ListTagsResponse{} }
+func (m *ListTagsResponse) String() string { return proto.CompactTextString(m) }
+func (*ListTagsResponse) ProtoMessage()    {}
+func (*ListTagsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a86a13c7ea1fa681, []int{19}
+}
+
+func (m *ListTagsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListTagsResponse.Unmarshal(m, b)
+}
+func (m *ListTagsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListTagsResponse.Marshal(b, m, deterministic)
+}
+func (m *ListTagsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListTagsResponse.Merge(m, src)
+}
+func (m *ListTagsResponse) XXX_Size() int {
+	return xxx_messageInfo_ListTagsResponse.Size(m)
+}
+func (m *ListTagsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListTagsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListTagsResponse proto.InternalMessageInfo
+
+func (m *ListTagsResponse) GetTags() []*Tag {
+	if m != nil {
+		return m.Tags
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterEnum("ranabd36.qaengine.ListQuestionsRequest_TagMatch", ListQuestionsRequest_TagMatch_name, ListQuestionsRequest_TagMatch_value)
+	proto.RegisterEnum("ranabd36.qaengine.ListQuestionsRequest_Feed", ListQuestionsRequest_Feed_name, ListQuestionsRequest_Feed_value)
+	proto.RegisterEnum("ranabd36.qaengine.QuestionEvent_Type", QuestionEvent_Type_name, QuestionEvent_Type_value)
+	proto.RegisterType((*Question)(nil), "ranabd36.qaengine.Question")
+	proto.RegisterType((*Tag)(nil), "ranabd36.qaengine.Tag")
+	proto.RegisterType((*CreateQuestionRequest)(nil), "ranabd36.qaengine.CreateQuestionRequest")
+	proto.RegisterType((*CreateQuestionResponse)(nil), "ranabd36.qaengine.CreateQuestionResponse")
+	proto.RegisterType((*GetQuestionRequest)(nil), "ranabd36.qaengine.GetQuestionRequest")
+	proto.RegisterType((*GetQuestionResponse)(nil), "ranabd36.qaengine.GetQuestionResponse")
+	proto.RegisterType((*UpdateQuestionRequest)(nil), "ranabd36.qaengine.UpdateQuestionRequest")
+	proto.RegisterType((*UpdateQuestionResponse)(nil), "ranabd36.qaengine.UpdateQuestionResponse")
+	proto.RegisterType((*DeleteQuestionRequest)(nil), "ranabd36.qaengine.DeleteQuestionRequest")
+	proto.RegisterType((*DeleteQuestionResponse)(nil), "ranabd36.qaengine.DeleteQuestionResponse")
+	proto.RegisterType((*PublishQuestionRequest)(nil), "ranabd36.qaengine.PublishQuestionRequest")
+	proto.RegisterType((*PublishQuestionResponse)(nil), "ranabd36.qaengine.PublishQuestionResponse")
+	proto.RegisterType((*UnpublishQuestionRequest)(nil), "ranabd36.qaengine.UnpublishQuestionRequest")
+	proto.RegisterType((*UnpublishQuestionResponse)(nil), "ranabd36.qaengine.UnpublishQuestionResponse")
+	proto.RegisterType((*ListQuestionsRequest)(nil), "ranabd36.qaengine.ListQuestionsRequest")
+	proto.RegisterType((*ListQuestionsResponse)(nil), "ranabd36.qaengine.ListQuestionsResponse")
+	proto.RegisterType((*WatchQuestionRequest)(nil), "ranabd36.qaengine.WatchQuestionRequest")
+	proto.RegisterType((*QuestionEvent)(nil), "ranabd36.qaengine.QuestionEvent")
+	proto.RegisterType((*ListTagsRequest)(nil), "ranabd36.qaengine.ListTagsRequest")
+	proto.RegisterType((*ListTagsResponse)(nil), "ranabd36.qaengine.ListTagsResponse")
+}
+
+func init() {
+	proto.RegisterFile("question_service_message.proto", fileDescriptor_a86a13c7ea1fa681)
+}
+
+var fileDescriptor_a86a13c7ea1fa681 = []byte{
+	// 1186 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x57, 0x6d, 0x6f, 0x1a, 0x47,
+	0x10, 0x36, 0xaf, 0x3e, 0x06, 0xf3, 0xe2, 0x8d, 0x4d, 0xc8, 0x25, 0xa9, 0xe9, 0xb5, 0x49, 0xb0,
+	0x1b, 0x91, 0x94, 0x48, 0xb5, 0x52, 0x35, 0x6d, 0x31, 0x5c, 0x1d, 0x24, 0x07, 0x93, 0xe3, 0x90,
+	0x95, 0xf6, 0xc3, 0xe9, 0xcc, 0xad, 0xf1, 0xb6, 0x70, 0x5c, 0xd8, 0x23, 0x89, 0xad, 0x48, 0xfd,
+	0xd4, 0x2f, 0xf9, 0x3f, 0xf9, 0x0d, 0x91, 0xfa, 0xb9, 0x7f, 0xa3, 0xff, 0xa1, 0xda, 0xbd, 0x17,
+	0xe0, 0x7c, 0x06, 0x5a, 0xf5, 0xdb, 0xed, 0xf0, 0xcc, 0x3c, 0xb3, 0xb3, 0x33, 0xf3, 0xd8, 0xf0,
+	0xd9, 0xeb, 0x09, 0xa6, 0x36, 0x19, 0x99, 0x1a, 0xc5, 0xe3, 0x37, 0xa4, 0x87, 0xb5, 0x21, 0xa6,
+	0x54, 0xef, 0xe3, 0x8a, 0x35, 0x1e, 0xd9, 0x23, 0xb4, 0x39, 0xd6, 0x4d, 0xfd, 0xd4, 0x78, 0xf2,
+	0x4d, 0xe5, 0xb5, 0x8e, 0xcd, 0x3e, 0x31, 0xb1, 0x78, 0x47, 0x37, 0xe9, 0x5b, 0x3c, 0x0e, 0x77,
+	0x10, 0x37, 0xf5, 0x89, 0x7d, 0xae, 0x59, 0xa3, 0x01, 0xe9, 0x5d, 0xb8, 0xa6, 0x9d, 0xfe, 0x68,
+	0xd4, 0x1f, 0xe0, 0x47, 0xfc, 0x74, 0x3a, 0x39, 0x7b, 0x64, 0x93, 0x21, 0xa6, 0xb6, 0x3e, 0xb4,
+	0x1c, 0x80, 0xf4, 0x67, 0x14, 0x84, 0x97, 0x6e, 0x1e, 0x28, 0x0b, 0x51, 0x62, 0x14, 0x23, 0xa5,
+	0x48, 0x39, 0xa1, 0x44, 0x89, 0x81, 0x6e, 0xc2, 0xfa, 0x84, 0xe2, 0xb1, 0x46, 0x8c, 0x62, 0x94,
+	0x1b, 0x93, 0xec, 0xd8, 0x34, 0xd0, 0x16, 0x24, 0x6c, 0x62, 0x0f, 0x70, 0x31, 0x56, 0x8a, 0x94,
+	0x53, 0x8a, 0x73, 0x40, 0x25, 0x48, 0x1b, 0x98, 0xf6, 0xc6, 0xc4, 0x62, 0xd1, 0x8a, 0x71, 0xfe,
+	0xdb, 0xac, 0x09, 0x3d, 0x83, 0x0d, 0x6b, 0x72, 0x3a, 0x20, 0xf4, 0x1c, 0x1b, 0x9a, 0x6e, 0x17,
+	0x13, 0xa5, 0x48, 0x39, 0x5d, 0x15, 0x2b, 0x4e, 0x96, 0x15, 0x2f, 0xcb, 0x8a, 0xea, 0x65, 0xa9,
+	0xa4, 0x7d, 0x7c, 0xcd, 0x46, 0x4f, 0x01, 0x7a, 0x63, 0xac, 0xdb, 0x8e, 0x73, 0x72, 0xa9, 0x73,
+	0xca, 0x45, 0x3b, 0xae, 0x13, 0xcb, 0xf0, 0x5c, 0xd7, 0x97, 0xbb, 0xba, 0xe8, 0x9a, 0x8d, 0x10,
+	0xc4, 0x6d, 0xbd, 0x4f, 0x8b, 0x42, 0x29, 0x56, 0x4e, 0x29, 0xfc, 0x9b, 0x15, 0x80, 0xf6, 0x46,
+	0x63, 0x5c, 0x4c, 0xf1, 0xba, 0x38, 0x07, 0xe9, 0x5b, 0x88, 0xa9, 0x7a, 0x9f, 0x39, 0x98, 0xfa,
+	0x10, 0xf3, 0x42, 0xa6, 0x14, 0xfe, 0x8d, 0x76, 0x20, 0x3d, 0x61, 0x4f, 0xa5, 0xf5, 0x46, 0x13,
+	0xd3, 0x76, 0xcb, 0x09, 0xdc, 0x54, 0x67, 0x16, 0xa9, 0x0d, 0xdb, 0x75, 0x9e, 0xad, 0xf7, 0x1a,
+	0x0a, 0xe6, 0xfd, 0x81, 0xf6, 0x41, 0xf0, 0x1a, 0x85, 0x47, 0x4c, 0x57, 0x6f, 0x57, 0xae, 0x74,
+	0x46, 0xc5, 0xf7, 0xf2, 0xc1, 0x52, 0x19, 0x0a, 0xc1, 0x88, 0xd4, 0x1a, 0x99, 0x14, 0x07, 0xdf,
+	0x59, 0xfa, 0x12, 0xd0, 0x21, 0xb6, 0x83, 0xc4, 0x41, 0x54, 0x0b, 0x6e, 0xcc, 0xa1, 0xdc, 0x60,
+	0xff, 0x39, 0xbf, 0x36, 0x6c, 0x77, 0x79, 0x91, 0xff, 0xb7, 0x1b, 0xef, 0x43, 0x21, 0x18, 0xd1,
+	0x4d, 0xf2, 0x2e, 0x00, 0xa1, 0x9a, 0xfb, 0xa6, 0x3c, 0xa8, 0xa0, 0xa4, 0x08, 0x75, 0xd0, 0x86,
+	0xf4, 0x00, 0xb6, 0x1b, 0x78, 0x80, 0x6d, 0xbc, 0xac, 0x06, 0xfb, 0x50, 0x08, 0x02, 0xe7, 0x18,
+	0x0c, 0xfe, 0xe3, 0x0c, 0x83, 0x83, 0x36, 0xd8, 0x63, 0xb4, 0x9d, 0x4e, 0x5e, 0x46, 0xf1, 0x1d,
+	0xdc, 0xbc, 0x82, 0x74, 0x39, 0x3e, 0x87, 0x0d, 0x42, 0x35, 0x7f, 0x22, 0x5c, 0x96, 0x34, 0xa1,
+	0x6d, 0xcf, 0x24, 0xed, 0x41, 0xb1, 0x6b, 0x5a, 0xab, 0x31, 0x1d, 0xc0, 0xad, 0x10, 0xac, 0xcb,
+	0x75, 0x0f, 0xb2, 0xac, 0x62, 0x66, 0x90, 0x2d, 0x43, 0x68, 0x77, 0x6a, 0x94, 0xfe, 0x8e, 0xc2,
+	0xd6, 0x11, 0xa1, 0x7e, 0x5b, 0x50, 0x8f, 0xcc, 0x9b, 0x9a, 0xc8, 0xcc, 0xd4, 0xbc, 0x80, 0x94,
+	0xad, 0xf7, 0xb5, 0xa1, 0x6e, 0xf7, 0xce, 0xf9, 0x08, 0x64, 0xab, 0x8f, 0x43, 0x5e, 0x36, 0x2c,
+	0x5e, 0x45, 0xd5, 0xfb, 0x2f, 0x98, 0x9f, 0x22, 0xd8, 0xee, 0x17, 0xba, 0x0d, 0x29, 0x8b, 0x8d,
+	0x14, 0x25, 0x97, 0xce, 0x26, 0x4a, 0x28, 0x02, 0x33, 0x74, 0xc8, 0x25, 0x46, 0x3f, 0x42, 0xfc,
+	0x0c, 0x63, 0x83, 0x6f, 0xa1, 0x6c, 0xf5, 0xe1, 0xaa, 0x34, 0x3f, 0x61, 0x6c, 0x28, 0xdc, 0x73,
+	0x76, 0xfb, 0x25, 0xe6, 0xb6, 0xdf, 0x5d, 0x00, 0xce, 0x6b, 0x8f, 0x7e, 0xc3, 0x26, 0x5f, 0x43,
+	0x29, 0x85, 0x67, 0xa2, 0x32, 0x83, 0x74, 0x07, 0x04, 0x2f, 0x59, 0xb4, 0x0e, 0xb1, 0x5a, 0xeb,
+	0x55, 0x7e, 0x8d, 0x7f, 0x1c, 0x1d, 0xe5, 0x23, 0xd2, 0x33, 0x88, 0x33, 0x0e, 0x04, 0x90, 0x6c,
+	0xc9, 0x27, 0x72, 0x47, 0xcd, 0xaf, 0xa1, 0x2c, 0x40, 0xb7, 0x55, 0x6b, 0x75, 0x4e, 0x64, 0x45,
+	0x6e, 0xe4, 0x23, 0x68, 0x03, 0x04, 0x55, 0x91, 0x5b, 0x8d, 0x66, 0xeb, 0x30, 0x1f, 0x45, 0x69,
+	0x58, 0x3f, 0x78, 0xa5, 0x75, 0x3b, 0xb2, 0x92, 0x8f, 0x49, 0x97, 0xb0, 0x1d, 0xc8, 0xdb, 0x7d,
+	0xaf, 0xa7, 0x90, 0xf2, 0xe6, 0xc0, 0x29, 0xfa, 0x92, 0xa9, 0x99, 0xa2, 0xd1, 0x7d, 0xc8, 0x99,
+	0xf8, 0x9d, 0xad, 0xcd, 0x5c, 0x2a, 0xca, 0x2f, 0x95, 0x61, 0xe6, 0xb6, 0x7f, 0xb1, 0x7d, 0xd8,
+	0x3a, 0x61, 0xb7, 0x0a, 0xf6, 0xd5, 0x0e, 0xa4, 0x7d, 0x29, 0xf3, 0x1b, 0x0c, 0x3c, 0x53, 0xd3,
+	0x90, 0xfe, 0x8a, 0x41, 0xc6, 0x73, 0x92, 0xdf, 0x60, 0x93, 0xad, 0xe3, 0xb8, 0x7d, 0x61, 0x39,
+	0x2b, 0x32, 0x5b, 0xbd, 0xb7, 0x20, 0x51, 0x8e, 0xaf, 0xa8, 0x17, 0x16, 0x56, 0xb8, 0x4b, 0x90,
+	0x2d, 0x1a, 0x64, 0x63, 0x6d, 0xe1, 0xca, 0x24, 0x31, 0xbc, 0xb6, 0x70, 0x0c, 0x4d, 0x03, 0x7d,
+	0x0d, 0x49, 0xe7, 0x9b, 0x37, 0x46, 0xba, 0x7a, 0x2b, 0x84, 0xba, 0xc6, 0x01, 0x8a, 0x0b, 0x9c,
+	0x5b, 0x47, 0x89, 0x7f, 0xb1, 0x8e, 0xa6, 0x22, 0x91, 0x9c, 0x15, 0x89, 0x4f, 0x11, 0x88, 0xb3,
+	0xeb, 0xa0, 0x4d, 0xc8, 0x38, 0x6f, 0xae, 0xb5, 0x8f, 0x3b, 0xaa, 0xdc, 0xc8, 0xaf, 0x21, 0x04,
+	0x59, 0xd7, 0xd4, 0x6d, 0x37, 0x6a, 0x2a, 0x6f, 0x86, 0xa9, 0xad, 0x21, 0x1f, 0xc9, 0xcc, 0x16,
+	0x45, 0x37, 0x20, 0xe7, 0xda, 0x6a, 0xf5, 0xba, 0xdc, 0x66, 0xc6, 0x18, 0xda, 0x82, 0xfc, 0xcb,
+	0xae, 0xdc, 0x51, 0x9b, 0xc7, 0x2d, 0xdf, 0x3d, 0x3e, 0x67, 0xf5, 0x02, 0x24, 0x18, 0x77, 0xa7,
+	0x7e, 0xac, 0xc8, 0x5a, 0xfd, 0x79, 0xad, 0x75, 0x28, 0x37, 0xf2, 0x49, 0x54, 0x00, 0xe4, 0x03,
+	0xdb, 0xdd, 0x83, 0xa3, 0x66, 0xe7, 0xb9, 0xdc, 0xc8, 0xaf, 0xa3, 0x22, 0x6c, 0x4d, 0xc3, 0xb6,
+	0xa6, 0xbf, 0x08, 0xd2, 0x0f, 0x90, 0x63, 0xbd, 0xa8, 0xea, 0x7d, 0x7f, 0xea, 0x0b, 0x90, 0xb4,
+	0xc6, 0xf8, 0x8c, 0xbc, 0x73, 0xc5, 0xcf, 0x3d, 0xb1, 0x52, 0x0c, 0xc8, 0x90, 0x78, 0xc2, 0xe7,
+	0x1c, 0xa4, 0xef, 0x21, 0x3f, 0x0d, 0xe0, 0xf6, 0xf1, 0xde, 0xcc, 0xde, 0x48, 0x57, 0x0b, 0x21,
+	0x95, 0x56, 0xf5, 0xbe, 0xb3, 0x4f, 0xaa, 0x9f, 0x04, 0xc8, 0x79, 0x75, 0xef, 0x38, 0x7f, 0x12,
+	0xa1, 0xf7, 0x90, 0x9d, 0x57, 0x3d, 0x54, 0x0e, 0x89, 0x11, 0x2a, 0xb5, 0xe2, 0xee, 0x0a, 0x48,
+	0x27, 0x4d, 0x69, 0xfb, 0xc3, 0xc7, 0xe2, 0x26, 0xca, 0xf9, 0x43, 0x54, 0x79, 0x3b, 0x26, 0x36,
+	0x46, 0x14, 0xd2, 0x33, 0x1a, 0x89, 0xc2, 0x1a, 0xfb, 0xaa, 0xd2, 0x8a, 0xf7, 0x97, 0xc1, 0x16,
+	0x93, 0xbe, 0x87, 0xec, 0xbc, 0xec, 0x85, 0x5e, 0x39, 0x54, 0x6b, 0xc5, 0xdd, 0x15, 0x90, 0x4b,
+	0xd9, 0xe7, 0x25, 0x31, 0x94, 0x3d, 0x54, 0x5e, 0xc5, 0xdd, 0x15, 0x90, 0x8b, 0xd9, 0x7f, 0x87,
+	0x5c, 0x40, 0x2d, 0x51, 0x58, 0xd0, 0x70, 0xed, 0x15, 0xf7, 0x56, 0x81, 0x2e, 0x4e, 0xe0, 0x8f,
+	0x08, 0x6c, 0x5e, 0x51, 0x51, 0xf4, 0x55, 0x58, 0x59, 0xaf, 0xd1, 0x65, 0xf1, 0xe1, 0x6a, 0xe0,
+	0xc5, 0x79, 0xfc, 0x0a, 0x99, 0x39, 0x61, 0x40, 0x0f, 0x56, 0x94, 0x3c, 0xb1, 0xbc, 0x1c, 0xe8,
+	0x52, 0x27, 0x3f, 0x7c, 0x2c, 0x46, 0x85, 0x08, 0xfa, 0x05, 0x04, 0x6f, 0x6e, 0x91, 0x74, 0x8d,
+	0xf7, 0xcc, 0x56, 0x10, 0xbf, 0x58, 0x88, 0x09, 0x04, 0xa7, 0x90, 0x99, 0x53, 0x99, 0xd0, 0x8b,
+	0x84, 0xe9, 0x90, 0x58, 0x5a, 0x26, 0x23, 0xd7, 0xd4, 0xee, 0x71, 0xe4, 0x20, 0xfe, 0x73, 0xd4,
+	0x3a, 0x3d, 0x4d, 0xf2, 0x7f, 0x04, 0x9e, 0xfc, 0x33, 0x00, 0xbd, 0x42, 0xa1, 0x3e, 0x9a, 0x0d,
+	0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConnInterface
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion6
+
+// QuestionServiceClient is the client API for QuestionService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QuestionServiceClient interface {
+	CreateQuestion(ctx context.Context, in *CreateQuestionRequest, opts ...grpc.CallOption) (*CreateQuestionResponse, error)
+	GetQuestion(ctx context.Context, in *GetQuestionRequest, opts ...grpc.CallOption) (*GetQuestionResponse, error)
+	UpdateQuestion(ctx context.Context, in *UpdateQuestionRequest, opts ...grpc.CallOption) (*UpdateQuestionResponse, error)
+	DeleteQuestion(ctx context.Context, in *DeleteQuestionRequest, opts ...grpc.CallOption) (*DeleteQuestionResponse, error)
+	PublishQuestion(ctx context.Context, in *PublishQuestionRequest, opts ...grpc.CallOption) (*PublishQuestionResponse, error)
+	UnpublishQuestion(ctx context.Context, in *UnpublishQuestionRequest, opts ...grpc.CallOption) (*UnpublishQuestionResponse, error)
+	ListQuestions(ctx context.Context, in *ListQuestionsRequest, opts ...grpc.CallOption) (*ListQuestionsResponse, error)
+	ListTags(ctx context.Context, in *ListTagsRequest, opts ...grpc.CallOption) (*ListTagsResponse, error)
+	// WatchQuestion streams the changes of the question and its answers as they
+	// happen. Streams of watchers falling behind end with ABORTED, streams of
+	// watchers whose credentials or account become invalid with UNAUTHENTICATED.
+	WatchQuestion(ctx context.Context, in *WatchQuestionRequest, opts ...grpc.CallOption) (QuestionService_WatchQuestionClient, error)
+}
+
+type questionServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewQuestionServiceClient(cc grpc.ClientConnInterface) QuestionServiceClient {
+	return &questionServiceClient{cc}
+}
+
+func (c *questionServiceClient) CreateQuestion(ctx context.Context, in *CreateQuestionRequest, opts ...grpc.CallOption) (*CreateQuestionResponse, error) {
+	out := new(CreateQuestionResponse)
+	err := c.cc.Invoke(ctx, "/ranabd36.qaengine.QuestionService/CreateQuestion", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *questionServiceClient) GetQuestion(ctx context.Context, in *GetQuestionRequest, opts ...grpc.CallOption) (*GetQuestionResponse, error) {
+	out := new(GetQuestionResponse)
+	err := c.cc.Invoke(ctx, "/ranabd36.qaengine.QuestionService/GetQuestion", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *questionServiceClient) UpdateQuestion(ctx context.Context, in *UpdateQuestionRequest, opts ...grpc.CallOption) (*UpdateQuestionResponse, error) {
+	out := new(UpdateQuestionResponse)
+	err := c.cc.Invoke(ctx, "/ranabd36.qaengine.QuestionService/UpdateQuestion", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *questionServiceClient) DeleteQuestion(ctx context.Context, in *DeleteQuestionRequest, opts ...grpc.CallOption) (*DeleteQuestionResponse, error) {
+	out := new(DeleteQuestionResponse)
+	err := c.cc.Invoke(ctx, "/ranabd36.qaengine.QuestionService/DeleteQuestion", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *questionServiceClient) PublishQuestion(ctx context.Context, in *PublishQuestionRequest, opts ...grpc.CallOption) (*PublishQuestionResponse, error) {
+	out := new(PublishQuestionResponse)
+	err := c.cc.Invoke(ctx, "/ranabd36.qaengine.QuestionService/PublishQuestion", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *questionServiceClient) UnpublishQuestion(ctx context.Context, in *UnpublishQuestionRequest, opts ...grpc.CallOption) (*UnpublishQuestionResponse, error) {
+	out := new(UnpublishQuestionResponse)
+	err := c.cc.Invoke(ctx, "/ranabd36.qaengine.QuestionService/UnpublishQuestion", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *questionServiceClient) ListQuestions(ctx context.Context, in *ListQuestionsRequest, opts ...grpc.CallOption) (*ListQuestionsResponse, error) {
+	out := new(ListQuestionsResponse)
+	err := c.cc.Invoke(ctx, "/ranabd36.qaengine.QuestionService/ListQuestions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *questionServiceClient) ListTags(ctx context.Context, in *ListTagsRequest, opts ...grpc.CallOption) (*ListTagsResponse, error) {
+	out := new(ListTagsResponse)
+	err := c.cc.Invoke(ctx, "/ranabd36.qaengine.QuestionService/ListTags", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *questionServiceClient) WatchQuestion(ctx context.Context, in *WatchQuestionRequest, opts ...grpc.CallOption) (QuestionService_WatchQuestionClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_QuestionService_serviceDesc.Streams[0], "/ranabd36.qaengine.QuestionService/WatchQuestion", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &questionServiceWatchQuestionClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type QuestionService_WatchQuestionClient interface {
+	Recv() (*QuestionEvent, error)
+	grpc.ClientStream
+}
+
+type questionServiceWatchQuestionClient struct {
+	grpc.ClientStream
+}
+
+func (x *questionServiceWatchQuestionClient) Recv() (*QuestionEvent, error) {
+	m := new(QuestionEvent)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// QuestionServiceServer is the server API for QuestionService service.
+type QuestionServiceServer interface {
+	CreateQuestion(context.Context, *CreateQuestionRequest) (*CreateQuestionResponse, error)
+	GetQuestion(context.Context, *GetQuestionRequest) (*GetQuestionResponse, error)
+	UpdateQuestion(context.Context, *UpdateQuestionRequest) (*UpdateQuestionResponse, error)
+	DeleteQuestion(context.Context, *DeleteQuestionRequest) (*DeleteQuestionResponse, error)
+	PublishQuestion(context.Context, *PublishQuestionRequest) (*PublishQuestionResponse, error)
+	UnpublishQuestion(context.Context, *UnpublishQuestionRequest) (*UnpublishQuestionResponse, error)
+	ListQuestions(context.Context, *ListQuestionsRequest) (*ListQuestionsResponse, error)
+	ListTags(context.Context, *ListTagsRequest) (*ListTagsResponse, error)
+	// WatchQuestion streams the changes of the question and its answers as they
+	// happen. Streams of watchers falling behind end with ABORTED, streams of
+	// watchers whose credentials or account become invalid with UNAUTHENTICATED.
+	WatchQuestion(*WatchQuestionRequest, QuestionService_WatchQuestionServer) error
+}
+
+// UnimplementedQuestionServiceServer can be embedded to have forward compatible implementations.
+type UnimplementedQuestionServiceServer struct {
+}
+
+func (*UnimplementedQuestionServiceServer) CreateQuestion(ctx context.Context, req *CreateQuestionRequest) (*CreateQuestionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateQuestion not implemented")
+}
+func (*UnimplementedQuestionServiceServer) GetQuestion(ctx context.Context, req *GetQuestionRequest) (*GetQuestionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetQuestion not implemented")
+}
+func (*UnimplementedQuestionServiceServer) UpdateQuestion(ctx context.Context, req *UpdateQuestionRequest) (*UpdateQuestionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateQuestion not implemented")
+}
+func (*UnimplementedQuestionServiceServer) DeleteQuestion(ctx context.Context, req *DeleteQuestionRequest) (*DeleteQuestionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteQuestion not implemented")
+}
+func (*UnimplementedQuestionServiceServer) PublishQuestion(ctx context.Context, req *PublishQuestionRequest) (*PublishQuestionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PublishQuestion not implemented")
+}
+func (*UnimplementedQuestionServiceServer) UnpublishQuestion(ctx context.Context, req *UnpublishQuestionRequest) (*UnpublishQuestionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnpublishQuestion not implemented")
+}
+func (*UnimplementedQuestionServiceServer) ListQuestions(ctx context.Context, req *ListQuestionsRequest) (*ListQuestionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListQuestions not implemented")
+}
+func (*UnimplementedQuestionServiceServer) ListTags(ctx context.Context, req *ListTagsRequest) (*ListTagsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTags not implemented")
+}
+func (*UnimplementedQuestionServiceServer) WatchQuestion(req *WatchQuestionRequest, srv QuestionService_WatchQuestionServer) error {
+	return status.Errorf(codes.Unimplemented, "method WatchQuestion not implemented")
+}
+
+func RegisterQuestionServiceServer(s *grpc.Server, srv QuestionServiceServer) {
+	s.RegisterService(&_QuestionService_serviceDesc, srv)
+}
+
+func _QuestionService_CreateQuestion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateQuestionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QuestionServiceServer).CreateQuestion(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ranabd36.qaengine.QuestionService/CreateQuestion",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QuestionServiceServer).CreateQuestion(ctx, req.(*CreateQuestionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QuestionService_GetQuestion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetQuestionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QuestionServiceServer).GetQuestion(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ranabd36.qaengine.QuestionService/GetQuestion",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QuestionServiceServer).GetQuestion(ctx, req.(*GetQuestionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QuestionService_UpdateQuestion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateQuestionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QuestionServiceServer).UpdateQuestion(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ranabd36.qaengine.QuestionService/UpdateQuestion",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QuestionServiceServer).UpdateQuestion(ctx, req.(*UpdateQuestionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QuestionService_DeleteQuestion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteQuestionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QuestionServiceServer).DeleteQuestion(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ranabd36.qaengine.QuestionService/DeleteQuestion",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QuestionServiceServer).DeleteQuestion(ctx, req.(*DeleteQuestionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QuestionService_PublishQuestion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PublishQuestionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QuestionServiceServer).PublishQuestion(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ranabd36.qaengine.QuestionService/PublishQuestion",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QuestionServiceServer).PublishQuestion(ctx, req.(*PublishQuestionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QuestionService_UnpublishQuestion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnpublishQuestionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QuestionServiceServer).UnpublishQuestion(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ranabd36.qaengine.QuestionService/UnpublishQuestion",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QuestionServiceServer).UnpublishQuestion(ctx, req.(*UnpublishQuestionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QuestionService_ListQuestions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListQuestionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QuestionServiceServer).ListQuestions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ranabd36.qaengine.QuestionService/ListQuestions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QuestionServiceServer).ListQuestions(ctx, req.(*ListQuestionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QuestionService_ListTags_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTagsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QuestionServiceServer).ListTags(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ranabd36.qaengine.QuestionService/ListTags",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QuestionServiceServer).ListTags(ctx, req.(*ListTagsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QuestionService_WatchQuestion_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchQuestionRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(QuestionServiceServer).WatchQuestion(m, &questionServiceWatchQuestionServer{stream})
+}
+
+type QuestionService_WatchQuestionServer interface {
+	Send(*QuestionEvent) error
+	grpc.ServerStream
+}
+
+type questionServiceWatchQuestionServer struct {
+	grpc.ServerStream
+}
+
+func (x *questionServiceWatchQuestionServer) Send(m *QuestionEvent) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+var _QuestionService_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "ranabd36.qaengine.QuestionService",
+	HandlerType: (*QuestionServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateQuestion",
+			Handler:    _QuestionService_CreateQuestion_Handler,
+		},
+		{
+			MethodName: "GetQuestion",
+			Handler:    _QuestionService_GetQuestion_Handler,
+		},
+		{
+			MethodName: "UpdateQuestion",
+			Handler:    _QuestionService_UpdateQuestion_Handler,
+		},
+		{
+			MethodName: "DeleteQuestion",
+			Handler:    _QuestionService_DeleteQuestion_Handler,
+		},
+		{
+			MethodName: "PublishQuestion",
+			Handler:    _QuestionService_PublishQuestion_Handler,
+		},
+		{
+			MethodName: "UnpublishQuestion",
+			Handler:    _QuestionService_UnpublishQuestion_Handler,
+		},
+		{
+			MethodName: "ListQuestions",
+			Handler:    _QuestionService_ListQuestions_Handler,
+		},
+		{
+			MethodName: "ListTags",
+			Handler:    _QuestionService_ListTags_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "WatchQuestion",
+			Handler:       _QuestionService_WatchQuestion_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "question_service_message.proto",
+}
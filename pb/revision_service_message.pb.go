@@ -0,0 +1,650 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: revision_service_message.proto
+
+package pb
+
+import (
+	context "context"
+	fmt "fmt"
+	proto "github.com/golang/protobuf/proto"
+	timestamp "github.com/golang/protobuf/ptypes/timestamp"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	math "math"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
+
+type Revision_Target int32
+
+const (
+	Revision_QUESTION Revision_Target = 0
+	Revision_ANSWER   Revision_Target = 1
+)
+
+var Revision_Target_name = map[int32]string{
+	0: "QUESTION",
+	1: "ANSWER",
+}
+
+var Revision_Target_value = map[string]int32{
+	"QUESTION": 0,
+	"ANSWER":   1,
+}
+
+func (x Revision_Target) String() string {
+	return proto.EnumName(Revision_Target_name, int32(x))
+}
+
+func (Revision_Target) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_e7dd731894d0daa2, []int{0, 0}
+}
+
+// Revision is a saved version of a question or answer. Every update records
+// one with the full text, so any two revisions can be diffed.
+type Revision struct {
+	Target               Revision_Target      `protobuf:"varint,1,opt,name=target,proto3,enum=ranabd36.qaengine.Revision_Target" json:"target,omitempty"`
+	TargetId             int32                `protobuf:"varint,2,opt,name=target_id,json=targetId,proto3" json:"target_id,omitempty"`
+	Revision             int32                `protobuf:"varint,3,opt,name=revision,proto3" json:"revision,omitempty"`
+	UserId               int32                `protobuf:"varint,4,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Title                string               `protobuf:"bytes,5,opt,name=title,proto3" json:"title,omitempty"`
+	Description          string               `protobuf:"bytes,6,opt,name=description,proto3" json:"description,omitempty"`
+	CreatedAt            *timestamp.Timestamp `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *Revision) Reset()         { *m = Revision{} }
+func (m *Revision) String() string { return proto.CompactTextString(m) }
+func (*Revision) ProtoMessage()    {}
+func (*Revision) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e7dd731894d0daa2, []int{0}
+}
+
+func (m *Revision) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Revision.Unmarshal(m, b)
+}
+func (m *Revision) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Revision.Marshal(b, m, deterministic)
+}
+func (m *Revision) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Revision.Merge(m, src)
+}
+func (m *Revision) XXX_Size() int {
+	return xxx_messageInfo_Revision.Size(m)
+}
+func (m *Revision) XXX_DiscardUnknown() {
+	xxx_messageInfo_Revision.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Revision proto.InternalMessageInfo
+
+func (m *Revision) GetTarget() Revision_Target {
+	if m != nil {
+		return m.Target
+	}
+	return Revision_QUESTION
+}
+
+func (m *Revision) GetTargetId() int32 {
+	if m != nil {
+		return m.TargetId
+	}
+	return 0
+}
+
+func (m *Revision) GetRevision() int32 {
+	if m != nil {
+		return m.Revision
+	}
+	return 0
+}
+
+func (m *Revision) GetUserId() int32 {
+	if m != nil {
+		return m.UserId
+	}
+	return 0
+}
+
+func (m *Revision) GetTitle() string {
+	if m != nil {
+		return m.Title
+	}
+	return ""
+}
+
+func (m *Revision) GetDescription() string {
+	if m != nil {
+		return m.Description
+	}
+	return ""
+}
+
+func (m *Revision) GetCreatedAt() *timestamp.Timestamp {
+	if m != nil {
+		return m.CreatedAt
+	}
+	return nil
+}
+
+type ListRevisionsRequest struct {
+	Target               Revision_Target `protobuf:"varint,1,opt,name=target,proto3,enum=ranabd36.qaengine.Revision_Target" json:"target,omitempty"`
+	TargetId             int32           `protobuf:"varint,2,opt,name=target_id,json=targetId,proto3" json:"target_id,omitempty"`
+	PageSize             int32           `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken            string          `protobuf:"bytes,4,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *ListRevisionsRequest) Reset()         { *m = ListRevisionsRequest{} }
+func (m *ListRevisionsRequest) String() string { return proto.CompactTextString(m) }
+func (*ListRevisionsRequest) ProtoMessage()    {}
+func (*ListRevisionsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e7dd731894d0daa2, []int{1}
+}
+
+func (m *ListRevisionsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListRevisionsRequest.Unmarshal(m, b)
+}
+func (m *ListRevisionsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListRevisionsRequest.Marshal(b, m, deterministic)
+}
+func (m *ListRevisionsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListRevisionsRequest.Merge(m, src)
+}
+func (m *ListRevisionsRequest) XXX_Size() int {
+	return xxx_messageInfo_ListRevisionsRequest.Size(m)
+}
+func (m *ListRevisionsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListRevisionsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListRevisionsRequest proto.InternalMessageInfo
+
+func (m *ListRevisionsRequest) GetTarget() Revision_Target {
+	if m != nil {
+		return m.Target
+	}
+	return Revision_QUESTION
+}
+
+func (m *ListRevisionsRequest) GetTargetId() int32 {
+	if m != nil {
+		return m.TargetId
+	}
+	return 0
+}
+
+func (m *ListRevisionsRequest) GetPageSize() int32 {
+	if m != nil {
+		return m.PageSize
+	}
+	return 0
+}
+
+func (m *ListRevisionsRequest) GetPageToken() string {
+	if m != nil {
+		return m.PageToken
+	}
+	return ""
+}
+
+type ListRevisionsResponse struct {
+	Revisions            []*Revision `protobuf:"bytes,1,rep,name=revisions,proto3" json:"revisions,omitempty"`
+	NextPageToken        string      `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
+	XXX_unrecognized     []byte      `json:"-"`
+	XXX_sizecache        int32       `json:"-"`
+}
+
+func (m *ListRevisionsResponse) Reset()         { *m = ListRevisionsResponse{} }
+func (m *ListRevisionsResponse) String() string { return proto.CompactTextString(m) }
+func (*ListRevisionsResponse) ProtoMessage()    {}
+func (*ListRevisionsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e7dd731894d0daa2, []int{2}
+}
+
+func (m *ListRevisionsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListRevisionsResponse.Unmarshal(m, b)
+}
+func (m *ListRevisionsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListRevisionsResponse.Marshal(b, m, deterministic)
+}
+func (m *ListRevisionsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListRevisionsResponse.Merge(m, src)
+}
+func (m *ListRevisionsResponse) XXX_Size() int {
+	return xxx_messageInfo_ListRevisionsResponse.Size(m)
+}
+func (m *ListRevisionsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListRevisionsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListRevisionsResponse proto.InternalMessageInfo
+
+func (m *ListRevisionsResponse) GetRevisions() []*Revision {
+	if m != nil {
+		return m.Revisions
+	}
+	return nil
+}
+
+func (m *ListRevisionsResponse) GetNextPageToken() string {
+	if m != nil {
+		return m.NextPageToken
+	}
+	return ""
+}
+
+type GetRevisionRequest struct {
+	Target               Revision_Target `protobuf:"varint,1,opt,name=target,proto3,enum=ranabd36.qaengine.Revision_Target" json:"target,omitempty"`
+	TargetId             int32           `protobuf:"varint,2,opt,name=target_id,json=targetId,proto3" json:"target_id,omitempty"`
+	Revision             int32           `protobuf:"varint,3,opt,name=revision,proto3" json:"revision,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *GetRevisionRequest) Reset()         { *m = GetRevisionRequest{} }
+func (m *GetRevisionRequest) String() string { return proto.CompactTextString(m) }
+func (*GetRevisionRequest) ProtoMessage()    {}
+func (*GetRevisionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e7dd731894d0daa2, []int{3}
+}
+
+func (m *GetRevisionRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetRevisionRequest.Unmarshal(m, b)
+}
+func (m *GetRevisionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetRevisionRequest.Marshal(b, m, deterministic)
+}
+func (m *GetRevisionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetRevisionRequest.Merge(m, src)
+}
+func (m *GetRevisionRequest) XXX_Size() int {
+	return xxx_messageInfo_GetRevisionRequest.Size(m)
+}
+func (m *GetRevisionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetRevisionRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetRevisionRequest proto.InternalMessageInfo
+
+func (m *GetRevisionRequest) GetTarget() Revision_Target {
+	if m != nil {
+		return m.Target
+	}
+	return Revision_QUESTION
+}
+
+func (m *GetRevisionRequest) GetTargetId() int32 {
+	if m != nil {
+		return m.TargetId
+	}
+	return 0
+}
+
+func (m *GetRevisionRequest) GetRevision() int32 {
+	if m != nil {
+		return m.Revision
+	}
+	return 0
+}
+
+type GetRevisionResponse struct {
+	Revision             *Revision `protobuf:"bytes,1,opt,name=revision,proto3" json:"revision,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
+	XXX_unrecognized     []byte    `json:"-"`
+	XXX_sizecache        int32     `json:"-"`
+}
+
+func (m *GetRevisionResponse) Reset()         { *m = GetRevisionResponse{} }
+func (m *GetRevisionResponse) String() string { return proto.CompactTextString(m) }
+func (*GetRevisionResponse) ProtoMessage()    {}
+func (*GetRevisionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e7dd731894d0daa2, []int{4}
+}
+
+func (m *GetRevisionResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetRevisionResponse.Unmarshal(m, b)
+}
+func (m *GetRevisionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetRevisionResponse.Marshal(b, m, deterministic)
+}
+func (m *GetRevisionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetRevisionResponse.Merge(m, src)
+}
+func (m *GetRevisionResponse) XXX_Size() int {
+	return xxx_messageInfo_GetRevisionResponse.Size(m)
+}
+func (m *GetRevisionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetRevisionResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetRevisionResponse proto.InternalMessageInfo
+
+func (m *GetRevisionResponse) GetRevision() *Revision {
+	if m != nil {
+		return m.Revision
+	}
+	return nil
+}
+
+type RollbackToRevisionRequest struct {
+	Target               Revision_Target `protobuf:"varint,1,opt,name=target,proto3,enum=ranabd36.qaengine.Revision_Target" json:"target,omitempty"`
+	TargetId             int32           `protobuf:"varint,2,opt,name=target_id,json=targetId,proto3" json:"target_id,omitempty"`
+	Revision             int32           `protobuf:"varint,3,opt,name=revision,proto3" json:"revision,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *RollbackToRevisionRequest) Reset()         { *m = RollbackToRevisionRequest{} }
+func (m *RollbackToRevisionRequest) String() string { return proto.CompactTextString(m) }
+func (*RollbackToRevisionRequest) ProtoMessage()    {}
+func (*RollbackToRevisionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e7dd731894d0daa2, []int{5}
+}
+
+func (m *RollbackToRevisionRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RollbackToRevisionRequest.Unmarshal(m, b)
+}
+func (m *RollbackToRevisionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RollbackToRevisionRequest.Marshal(b, m, deterministic)
+}
+func (m *RollbackToRevisionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RollbackToRevisionRequest.Merge(m, src)
+}
+func (m *RollbackToRevisionRequest) XXX_Size() int {
+	return xxx_messageInfo_RollbackToRevisionRequest.Size(m)
+}
+func (m *RollbackToRevisionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RollbackToRevisionRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RollbackToRevisionRequest proto.InternalMessageInfo
+
+func (m *RollbackToRevisionRequest) GetTarget() Revision_Target {
+	if m != nil {
+		return m.Target
+	}
+	return Revision_QUESTION
+}
+
+func (m *RollbackToRevisionRequest) GetTargetId() int32 {
+	if m != nil {
+		return m.TargetId
+	}
+	return 0
+}
+
+func (m *RollbackToRevisionRequest) GetRevision() int32 {
+	if m != nil {
+		return m.Revision
+	}
+	return 0
+}
+
+type RollbackToRevisionResponse struct {
+	Revision             *Revision `protobuf:"bytes,1,opt,name=revision,proto3" json:"revision,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
+	XXX_unrecognized     []byte    `json:"-"`
+	XXX_sizecache        int32     `json:"-"`
+}
+
+func (m *RollbackToRevisionResponse) Reset()         { *m = RollbackToRevisionResponse{} }
+func (m *RollbackToRevisionResponse) String() string { return proto.CompactTextString(m) }
+func (*RollbackToRevisionResponse) ProtoMessage()    {}
+func (*RollbackToRevisionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e7dd731894d0daa2, []int{6}
+}
+
+func (m *RollbackToRevisionResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RollbackToRevisionResponse.Unmarshal(m, b)
+}
+func (m *RollbackToRevisionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RollbackToRevisionResponse.Marshal(b, m, deterministic)
+}
+func (m *RollbackToRevisionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RollbackToRevisionResponse.Merge(m, src)
+}
+func (m *RollbackToRevisionResponse) XXX_Size() int {
+	return xxx_messageInfo_RollbackToRevisionResponse.Size(m)
+}
+func (m *RollbackToRevisionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RollbackToRevisionResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RollbackToRevisionResponse proto.InternalMessageInfo
+
+func (m *RollbackToRevisionResponse) GetRevision() *Revision {
+	if m != nil {
+		return m.Revision
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterEnum("ranabd36.qaengine.Revision_Target", Revision_Target_name, Revision_Target_value)
+	proto.RegisterType((*Revision)(nil), "ranabd36.qaengine.Revision")
+	proto.RegisterType((*ListRevisionsRequest)(nil), "ranabd36.qaengine.ListRevisionsRequest")
+	proto.RegisterType((*ListRevisionsResponse)(nil), "ranabd36.qaengine.ListRevisionsResponse")
+	proto.RegisterType((*GetRevisionRequest)(nil), "ranabd36.qaengine.GetRevisionRequest")
+	proto.RegisterType((*GetRevisionResponse)(nil), "ranabd36.qaengine.GetRevisionResponse")
+	proto.RegisterType((*RollbackToRevisionRequest)(nil), "ranabd36.qaengine.RollbackToRevisionRequest")
+	proto.RegisterType((*RollbackToRevisionResponse)(nil), "ranabd36.qaengine.RollbackToRevisionResponse")
+}
+
+func init() {
+	proto.RegisterFile("revision_service_message.proto", fileDescriptor_e7dd731894d0daa2)
+}
+
+var fileDescriptor_e7dd731894d0daa2 = []byte{
+	// 560 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x54, 0xc1, 0x6e, 0xd3, 0x40,
+	0x10, 0x65, 0xdd, 0xc6, 0x8d, 0x27, 0x94, 0xb4, 0xdb, 0xa2, 0x1a, 0x47, 0x80, 0x65, 0x89, 0xe2,
+	0x03, 0xb8, 0x52, 0x2a, 0x81, 0xca, 0xad, 0x48, 0x15, 0x8a, 0x84, 0x02, 0x38, 0xa9, 0x90, 0xb8,
+	0x58, 0x9b, 0x78, 0x30, 0x4b, 0x13, 0xdb, 0xf5, 0x6e, 0x0a, 0xe4, 0x0f, 0xc8, 0x89, 0xff, 0xe0,
+	0xc0, 0xa9, 0xff, 0xc5, 0x27, 0x20, 0xdb, 0x71, 0x9b, 0x36, 0xa6, 0x41, 0x42, 0x95, 0x7a, 0xf3,
+	0xbc, 0x79, 0x33, 0x6f, 0xe6, 0xed, 0x7a, 0xe1, 0x41, 0x82, 0x27, 0x5c, 0xf0, 0x28, 0xf4, 0x04,
+	0x26, 0x27, 0xbc, 0x8f, 0xde, 0x10, 0x85, 0x60, 0x01, 0x3a, 0x71, 0x12, 0xc9, 0x88, 0xae, 0x27,
+	0x2c, 0x64, 0x3d, 0x7f, 0xf7, 0x99, 0x73, 0xcc, 0x30, 0x0c, 0x78, 0x88, 0xc6, 0x3a, 0x1b, 0xc9,
+	0x4f, 0x5e, 0x1c, 0x0d, 0x78, 0xff, 0x5b, 0xce, 0x32, 0x1e, 0x06, 0x51, 0x14, 0x0c, 0x70, 0x27,
+	0x8b, 0x7a, 0xa3, 0x8f, 0x3b, 0x92, 0x0f, 0x51, 0x48, 0x36, 0x8c, 0x73, 0x82, 0xf5, 0x4b, 0x81,
+	0xaa, 0x3b, 0x55, 0xa2, 0x2f, 0x40, 0x95, 0x2c, 0x09, 0x50, 0xea, 0xc4, 0x24, 0xf6, 0x9d, 0xa6,
+	0xe5, 0xcc, 0x89, 0x38, 0x05, 0xd9, 0xe9, 0x66, 0x4c, 0x77, 0x5a, 0x41, 0x1b, 0xa0, 0xe5, 0x5f,
+	0x1e, 0xf7, 0x75, 0xc5, 0x24, 0x76, 0xc5, 0xad, 0xe6, 0x40, 0xcb, 0xa7, 0x06, 0x54, 0x8b, 0x75,
+	0xf4, 0xa5, 0x3c, 0x57, 0xc4, 0x74, 0x0b, 0x56, 0x46, 0x02, 0x93, 0xb4, 0x6c, 0x39, 0x4b, 0xa9,
+	0x69, 0xd8, 0xf2, 0xe9, 0x26, 0x54, 0x24, 0x97, 0x03, 0xd4, 0x2b, 0x26, 0xb1, 0x35, 0x37, 0x0f,
+	0xa8, 0x09, 0x35, 0x1f, 0x45, 0x3f, 0xe1, 0xb1, 0x4c, 0xbb, 0xa9, 0x59, 0x6e, 0x16, 0xa2, 0x7b,
+	0x00, 0xfd, 0x04, 0x99, 0x44, 0xdf, 0x63, 0x52, 0x5f, 0x31, 0x89, 0x5d, 0x6b, 0x1a, 0x4e, 0x6e,
+	0x84, 0x53, 0x18, 0xe1, 0x74, 0x0b, 0x23, 0x5c, 0x6d, 0xca, 0xde, 0x97, 0x96, 0x05, 0x6a, 0xbe,
+	0x16, 0xbd, 0x0d, 0xd5, 0x77, 0x87, 0x07, 0x9d, 0x6e, 0xeb, 0x4d, 0x7b, 0xed, 0x16, 0x05, 0x50,
+	0xf7, 0xdb, 0x9d, 0xf7, 0x07, 0xee, 0x1a, 0xb1, 0x7e, 0x12, 0xd8, 0x7c, 0xcd, 0x85, 0x2c, 0x8c,
+	0x10, 0x2e, 0x1e, 0x8f, 0x50, 0xc8, 0xeb, 0x73, 0xaf, 0x01, 0x5a, 0xcc, 0x02, 0xf4, 0x04, 0x1f,
+	0x63, 0x61, 0x5f, 0x0a, 0x74, 0xf8, 0x18, 0xe9, 0x7d, 0x80, 0x2c, 0x29, 0xa3, 0x23, 0x0c, 0x33,
+	0x07, 0x35, 0x37, 0xa3, 0x77, 0x53, 0xc0, 0x1a, 0xc3, 0xdd, 0x4b, 0xc3, 0x8a, 0x38, 0x0a, 0x05,
+	0xd2, 0x3d, 0xd0, 0x8a, 0x23, 0x10, 0x3a, 0x31, 0x97, 0xec, 0x5a, 0xb3, 0x71, 0xc5, 0xc0, 0xee,
+	0x39, 0x9b, 0x6e, 0x43, 0x3d, 0xc4, 0xaf, 0xd2, 0x9b, 0xd1, 0x55, 0x32, 0xdd, 0xd5, 0x14, 0x7e,
+	0x7b, 0xa6, 0xfd, 0x9d, 0x00, 0x7d, 0x85, 0x67, 0xda, 0xd7, 0xee, 0xd3, 0x15, 0xb7, 0xcc, 0x6a,
+	0xc3, 0xc6, 0x85, 0x51, 0xa6, 0x2e, 0x3c, 0x9f, 0x29, 0x21, 0x26, 0x59, 0x64, 0xc2, 0x79, 0xbf,
+	0x1f, 0x04, 0xee, 0xb9, 0xd1, 0x60, 0xd0, 0x63, 0xfd, 0xa3, 0x6e, 0x74, 0x23, 0x56, 0x3c, 0x04,
+	0xa3, 0x6c, 0xa2, 0xff, 0xdc, 0xb4, 0xf9, 0x5b, 0x81, 0x7a, 0x01, 0x77, 0xf2, 0xa7, 0x88, 0x7e,
+	0x86, 0xd5, 0x0b, 0xb7, 0x8a, 0x3e, 0x2e, 0xe9, 0x55, 0xf6, 0x93, 0x18, 0xf6, 0x62, 0x62, 0x3e,
+	0xb0, 0xa5, 0x4e, 0x4e, 0x75, 0xa5, 0x4a, 0xa8, 0x0f, 0xb5, 0x99, 0x93, 0xa3, 0x8f, 0x4a, 0x1a,
+	0xcc, 0x5f, 0x32, 0x63, 0x7b, 0x11, 0xed, 0x92, 0xca, 0x84, 0x00, 0x9d, 0x77, 0x8f, 0x3e, 0x29,
+	0xf3, 0xe8, 0x6f, 0xc7, 0x6e, 0x3c, 0xfd, 0x47, 0xf6, 0x54, 0x7b, 0x6b, 0x72, 0xaa, 0x6f, 0xd0,
+	0x7a, 0x56, 0x9b, 0xae, 0xee, 0x7c, 0x49, 0xb8, 0x44, 0x9d, 0xbc, 0x5c, 0xfe, 0xa0, 0xc4, 0xbd,
+	0x9e, 0x9a, 0xbd, 0x55, 0xbb, 0x7f, 0x06, 0x00, 0xa8, 0x1d, 0x70, 0x86, 0x0a, 0x06, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConnInterface
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion6
+
+// RevisionServiceClient is the client API for RevisionService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type RevisionServiceClient interface {
+	ListRevisions(ctx context.Context, in *ListRevisionsRequest, opts ...grpc.CallOption) (*ListRevisionsResponse, error)
+	GetRevision(ctx context.Context, in *GetRevisionRequest, opts ...grpc.CallOption) (*GetRevisionResponse, error)
+	// RollbackToRevision restores the text of an earlier revision, only the
+	// owner and moderators can roll back. Rolling back answers requires
+	// answers.write as well.
+	RollbackToRevision(ctx context.Context, in *RollbackToRevisionRequest, opts ...grpc.CallOption) (*RollbackToRevisionResponse, error)
+}
+
+type revisionServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewRevisionServiceClient(cc grpc.ClientConnInterface) RevisionServiceClient {
+	return &revisionServiceClient{cc}
+}
+
+func (c *revisionServiceClient) ListRevisions(ctx context.Context, in *ListRevisionsRequest, opts ...grpc.CallOption) (*ListRevisionsResponse, error) {
+	out := new(ListRevisionsResponse)
+	err := c.cc.Invoke(ctx, "/ranabd36.qaengine.RevisionService/ListRevisions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *revisionServiceClient) GetRevision(ctx context.Context, in *GetRevisionRequest, opts ...grpc.CallOption) (*GetRevisionResponse, error) {
+	out := new(GetRevisionResponse)
+	err := c.cc.Invoke(ctx, "/ranabd36.qaengine.RevisionService/GetRevision", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *revisionServiceClient) RollbackToRevision(ctx context.Context, in *RollbackToRevisionRequest, opts ...grpc.CallOption) (*RollbackToRevisionResponse, error) {
+	out := new(RollbackToRevisionResponse)
+	err := c.cc.Invoke(ctx, "/ranabd36.qaengine.RevisionService/RollbackToRevision", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// RevisionServiceServer is the server API for RevisionService service.
+type RevisionServiceServer interface {
+	ListRevisions(context.Context, *ListRevisionsRequest) (*ListRevisionsResponse, error)
+	GetRevision(context.Context, *GetRevisionRequest) (*GetRevisionResponse, error)
+	// RollbackToRevision restores the text of an earlier revision, only the
+	// owner and moderators can roll back. Rolling back answers requires
+	// answers.write as well.
+	RollbackToRevision(context.Context, *RollbackToRevisionRequest) (*RollbackToRevisionResponse, error)
+}
+
+// UnimplementedRevisionServiceServer can be embedded to have forward compatible implementations.
+type UnimplementedRevisionServiceServer struct {
+}
+
+func (*UnimplementedRevisionServiceServer) ListRevisions(ctx context.Context, req *ListRevisionsRequest) (*ListRevisionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListRevisions not implemented")
+}
+func (*UnimplementedRevisionServiceServer) GetRevision(ctx context.Context, req *GetRevisionRequest) (*GetRevisionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRevision not implemented")
+}
+func (*UnimplementedRevisionServiceServer) RollbackToRevision(ctx context.Context, req *RollbackToRevisionRequest) (*RollbackToRevisionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RollbackToRevision not implemented")
+}
+
+func RegisterRevisionServiceServer(s *grpc.Server, srv RevisionServiceServer) {
+	s.RegisterService(&_RevisionService_serviceDesc, srv)
+}
+
+func _RevisionService_ListRevisions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListRevisionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RevisionServiceServer).ListRevisions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ranabd36.qaengine.RevisionService/ListRevisions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RevisionServiceServer).ListRevisions(ctx, req.(*ListRevisionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RevisionService_GetRevision_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRevisionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RevisionServiceServer).GetRevision(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ranabd36.qaengine.RevisionService/GetRevision",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RevisionServiceServer).GetRevision(ctx, req.(*GetRevisionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RevisionService_RollbackToRevision_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RollbackToRevisionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RevisionServiceServer).RollbackToRevision(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ranabd36.qaengine.RevisionService/RollbackToRevision",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RevisionServiceServer).RollbackToRevision(ctx, req.(*RollbackToRevisionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _RevisionService_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "ranabd36.qaengine.RevisionService",
+	HandlerType: (*RevisionServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ListRevisions",
+			Handler:    _RevisionService_ListRevisions_Handler,
+		},
+		{
+			MethodName: "GetRevision",
+			Handler:    _RevisionService_GetRevision_Handler,
+		},
+		{
+			MethodName: "RollbackToRevision",
+			Handler:    _RevisionService_RollbackToRevision_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "revision_service_message.proto",
+}
@@ -0,0 +1,1054 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: role_service_message.proto
+
+package pb
+
+import (
+	context "context"
+	fmt "fmt"
+	proto "github.com/golang/protobuf/proto"
+	timestamp "github.com/golang/protobuf/ptypes/timestamp"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	math "math"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
+
+type Role struct {
+	Id                   int32                `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name                 string               `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Description          string               `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	Permissions          []string             `protobuf:"bytes,4,rep,name=permissions,proto3" json:"permissions,omitempty"`
+	CreatedAt            *timestamp.Timestamp `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *Role) Reset()         { *m = Role{} }
+func (m *Role) String() string { return proto.CompactTextString(m) }
+func (*Role) ProtoMessage()    {}
+func (*Role) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4acb1e639fd1f5bc, []int{0}
+}
+
+func (m *Role) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Role.Unmarshal(m, b)
+}
+func (m *Role) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Role.Marshal(b, m, deterministic)
+}
+func (m *Role) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Role.Merge(m, src)
+}
+func (m *Role) XXX_Size() int {
+	return xxx_messageInfo_Role.Size(m)
+}
+func (m *Role) XXX_DiscardUnknown() {
+	xxx_messageInfo_Role.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Role proto.InternalMessageInfo
+
+func (m *Role) GetId() int32 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *Role) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *Role) GetDescription() string {
+	if m != nil {
+		return m.Description
+	}
+	return ""
+}
+
+func (m *Role) GetPermissions() []string {
+	if m != nil {
+		return m.Permissions
+	}
+	return nil
+}
+
+func (m *Role) GetCreatedAt() *timestamp.Timestamp {
+	if m != nil {
+		return m.CreatedAt
+	}
+	return nil
+}
+
+type Permission struct {
+	Name                 string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Description          string   `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Permission) Reset()         { *m = Permission{} }
+func (m *Permission) String() string { return proto.CompactTextString(m) }
+func (*Permission) ProtoMessage()    {}
+func (*Permission) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4acb1e639fd1f5bc, []int{1}
+}
+
+func (m *Permission) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Permission.Unmarshal(m, b)
+}
+func (m *Permission) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Permission.Marshal(b, m, deterministic)
+}
+func (m *Permission) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Permission.Merge(m, src)
+}
+func (m *Permission) XXX_Size() int {
+	return xxx_messageInfo_Permission.Size(m)
+}
+func (m *Permission) XXX_DiscardUnknown() {
+	xxx_messageInfo_Permission.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Permission proto.InternalMessageInfo
+
+func (m *Permission) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *Permission) GetDescription() string {
+	if m != nil {
+		return m.Description
+	}
+	return ""
+}
+
+type ListRolesRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListRolesRequest) Reset()         { *m = ListRolesRequest{} }
+func (m *ListRolesRequest) String() string { return proto.CompactTextString(m) }
+func (*ListRolesRequest) ProtoMessage()    {}
+func (*ListRolesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4acb1e639fd1f5bc, []int{2}
+}
+
+func (m *ListRolesRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListRolesRequest.Unmarshal(m, b)
+}
+func (m *ListRolesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListRolesRequest.Marshal(b, m, deterministic)
+}
+func (m *ListRolesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListRolesRequest.Merge(m, src)
+}
+func (m *ListRolesRequest) XXX_Size() int {
+	return xxx_messageInfo_ListRolesRequest.Size(m)
+}
+func (m *ListRolesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListRolesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListRolesRequest proto.InternalMessageInfo
+
+type ListRolesResponse struct {
+	Roles                []*Role  `protobuf:"bytes,1,rep,name=roles,proto3" json:"roles,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListRolesResponse) Reset()         { *m = ListRolesResponse{} }
+func (m *ListRolesResponse) String() string { return proto.CompactTextString(m) }
+func (*ListRolesResponse) ProtoMessage()    {}
+func (*ListRolesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4acb1e639fd1f5bc, []int{3}
+}
+
+func (m *ListRolesResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListRolesResponse.Unmarshal(m, b)
+}
+func (m *ListRolesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListRolesResponse.Marshal(b, m, deterministic)
+}
+func (m *ListRolesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListRolesResponse.Merge(m, src)
+}
+func (m *ListRolesResponse) XXX_Size() int {
+	return xxx_messageInfo_ListRolesResponse.Size(m)
+}
+func (m *ListRolesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListRolesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListRolesResponse proto.InternalMessageInfo
+
+func (m *ListRolesResponse) GetRoles() []*Role {
+	if m != nil {
+		return m.Roles
+	}
+	return nil
+}
+
+type ListPermissionsRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListPermissionsRequest) Reset()         { *m = ListPermissionsRequest{} }
+func (m *ListPermissionsRequest) String() string { return proto.CompactTextString(m) }
+func (*ListPermissionsRequest) ProtoMessage()    {}
+func (*ListPermissionsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4acb1e639fd1f5bc, []int{4}
+}
+
+func (m *ListPermissionsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListPermissionsRequest.Unmarshal(m, b)
+}
+func (m *ListPermissionsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListPermissionsRequest.Marshal(b, m, deterministic)
+}
+func (m *ListPermissionsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListPermissionsRequest.Merge(m, src)
+}
+func (m *ListPermissionsRequest) XXX_Size() int {
+	return xxx_messageInfo_ListPermissionsRequest.Size(m)
+}
+func (m *ListPermissionsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListPermissionsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListPermissionsRequest proto.InternalMessageInfo
+
+type ListPermissionsResponse struct {
+	Permissions          []*Permission `protobuf:"bytes,1,rep,name=permissions,proto3" json:"permissions,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *ListPermissionsResponse) Reset()         { *m = ListPermissionsResponse{} }
+func (m *ListPermissionsResponse) String() string { return proto.CompactTextString(m) }
+func (*ListPermissionsResponse) ProtoMessage()    {}
+func (*ListPermissionsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4acb1e639fd1f5bc, []int{5}
+}
+
+func (m *ListPermissionsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListPermissionsResponse.Unmarshal(m, b)
+}
+func (m *ListPermissionsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListPermissionsResponse.Marshal(b, m, deterministic)
+}
+func (m *ListPermissionsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListPermissionsResponse.Merge(m, src)
+}
+func (m *ListPermissionsResponse) XXX_Size() int {
+	return xxx_messageInfo_ListPermissionsResponse.Size(m)
+}
+func (m *ListPermissionsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListPermissionsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListPermissionsResponse proto.InternalMessageInfo
+
+func (m *ListPermissionsResponse) GetPermissions() []*Permission {
+	if m != nil {
+		return m.Permissions
+	}
+	return nil
+}
+
+type CreateRoleRequest struct {
+	Role                 *Role    `protobuf:"bytes,1,opt,name=role,proto3" json:"role,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CreateRoleRequest) Reset()         { *m = CreateRoleRequest{} }
+func (m *CreateRoleRequest) String() string { return proto.CompactTextString(m) }
+func (*CreateRoleRequest) ProtoMessage()    {}
+func (*CreateRoleRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4acb1e639fd1f5bc, []int{6}
+}
+
+func (m *CreateRoleRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CreateRoleRequest.Unmarshal(m, b)
+}
+func (m *CreateRoleRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CreateRoleRequest.Marshal(b, m, deterministic)
+}
+func (m *CreateRoleRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateRoleRequest.Merge(m, src)
+}
+func (m *CreateRoleRequest) XXX_Size() int {
+	return xxx_messageInfo_CreateRoleRequest.Size(m)
+}
+func (m *CreateRoleRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateRoleRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CreateRoleRequest proto.InternalMessageInfo
+
+func (m *CreateRoleRequest) GetRole() *Role {
+	if m != nil {
+		return m.Role
+	}
+	return nil
+}
+
+type CreateRoleResponse struct {
+	Id                   int32    `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CreateRoleResponse) Reset()         { *m = CreateRoleResponse{} }
+func (m *CreateRoleResponse) String() string { return proto.CompactTextString(m) }
+func (*CreateRoleResponse) ProtoMessage()    {}
+func (*CreateRoleResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4acb1e639fd1f5bc, []int{7}
+}
+
+func (m *CreateRoleResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CreateRoleResponse.Unmarshal(m, b)
+}
+func (m *CreateRoleResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CreateRoleResponse.Marshal(b, m, deterministic)
+}
+func (m *CreateRoleResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateRoleResponse.Merge(m, src)
+}
+func (m *CreateRoleResponse) XXX_Size() int {
+	return xxx_messageInfo_CreateRoleResponse.Size(m)
+}
+func (m *CreateRoleResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateRoleResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CreateRoleResponse proto.InternalMessageInfo
+
+func (m *CreateRoleResponse) GetId() int32 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+type UpdateRoleRequest struct {
+	Role                 *Role    `protobuf:"bytes,1,opt,name=role,proto3" json:"role,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *UpdateRoleRequest) Reset()         { *m = UpdateRoleRequest{} }
+func (m *UpdateRoleRequest) String() string { return proto.CompactTextString(m) }
+func (*UpdateRoleRequest) ProtoMessage()    {}
+func (*UpdateRoleRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4acb1e639fd1f5bc, []int{8}
+}
+
+func (m *UpdateRoleRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UpdateRoleRequest.Unmarshal(m, b)
+}
+func (m *UpdateRoleRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UpdateRoleRequest.Marshal(b, m, deterministic)
+}
+func (m *UpdateRoleRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateRoleRequest.Merge(m, src)
+}
+func (m *UpdateRoleRequest) XXX_Size() int {
+	return xxx_messageInfo_UpdateRoleRequest.Size(m)
+}
+func (m *UpdateRoleRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateRoleRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpdateRoleRequest proto.InternalMessageInfo
+
+func (m *UpdateRoleRequest) GetRole() *Role {
+	if m != nil {
+		return m.Role
+	}
+	return nil
+}
+
+type UpdateRoleResponse struct {
+	IsUpdated            bool     `protobuf:"varint,1,opt,name=is_updated,json=isUpdated,proto3" json:"is_updated,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *UpdateRoleResponse) Reset()         { *m = UpdateRoleResponse{} }
+func (m *UpdateRoleResponse) String() string { return proto.CompactTextString(m) }
+func (*UpdateRoleResponse) ProtoMessage()    {}
+func (*UpdateRoleResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4acb1e639fd1f5bc, []int{9}
+}
+
+func (m *UpdateRoleResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UpdateRoleResponse.Unmarshal(m, b)
+}
+func (m *UpdateRoleResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UpdateRoleResponse.Marshal(b, m, deterministic)
+}
+func (m *UpdateRoleResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateRoleResponse.Merge(m, src)
+}
+func (m *UpdateRoleResponse) XXX_Size() int {
+	return xxx_messageInfo_UpdateRoleResponse.Size(m)
+}
+func (m *UpdateRoleResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateRoleResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpdateRoleResponse proto.InternalMessageInfo
+
+func (m *UpdateRoleResponse) GetIsUpdated() bool {
+	if m != nil {
+		return m.IsUpdated
+	}
+	return false
+}
+
+type DeleteRoleRequest struct {
+	Id                   int32    `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DeleteRoleRequest) Reset()         { *m = DeleteRoleRequest{} }
+func (m *DeleteRoleRequest) String() string { return proto.CompactTextString(m) }
+func (*DeleteRoleRequest) ProtoMessage()    {}
+func (*DeleteRoleRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4acb1e639fd1f5bc, []int{10}
+}
+
+func (m *DeleteRoleRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DeleteRoleRequest.Unmarshal(m, b)
+}
+func (m *DeleteRoleRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DeleteRoleRequest.Marshal(b, m, deterministic)
+}
+func (m *DeleteRoleRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteRoleRequest.Merge(m, src)
+}
+func (m *DeleteRoleRequest) XXX_Size() int {
+	return xxx_messageInfo_DeleteRoleRequest.Size(m)
+}
+func (m *DeleteRoleRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteRoleRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeleteRoleRequest proto.InternalMessageInfo
+
+func (m *DeleteRoleRequest) GetId() int32 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+type DeleteRoleResponse struct {
+	IsDeleted            bool     `protobuf:"varint,1,opt,name=is_deleted,json=isDeleted,proto3" json:"is_deleted,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DeleteRoleResponse) Reset()         { *m = DeleteRoleResponse{} }
+func (m *DeleteRoleResponse) String() string { return proto.CompactTextString(m) }
+func (*DeleteRoleResponse) ProtoMessage()    {}
+func (*DeleteRoleResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4acb1e639fd1f5bc, []int{11}
+}
+
+func (m *DeleteRoleResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DeleteRoleResponse.Unmarshal(m, b)
+}
+func (m *DeleteRoleResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DeleteRoleResponse.Marshal(b, m, deterministic)
+}
+func (m *DeleteRoleResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteRoleResponse.Merge(m, src)
+}
+func (m *DeleteRoleResponse) XXX_Size() int {
+	return xxx_messageInfo_DeleteRoleResponse.Size(m)
+}
+func (m *DeleteRoleResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteRoleResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeleteRoleResponse proto.InternalMessageInfo
+
+func (m *DeleteRoleResponse) GetIsDeleted() bool {
+	if m != nil {
+		return m.IsDeleted
+	}
+	return false
+}
+
+type AssignRoleRequest struct {
+	UserId               int32    `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	RoleId               int32    `protobuf:"varint,2,opt,name=role_id,json=roleId,proto3" json:"role_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AssignRoleRequest) Reset()         { *m = AssignRoleRequest{} }
+func (m *AssignRoleRequest) String() string { return proto.CompactTextString(m) }
+func (*AssignRoleRequest) ProtoMessage()    {}
+func (*AssignRoleRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4acb1e639fd1f5bc, []int{12}
+}
+
+func (m *AssignRoleRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AssignRoleRequest.Unmarshal(m, b)
+}
+func (m *AssignRoleRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AssignRoleRequest.Marshal(b, m, deterministic)
+}
+func (m *AssignRoleRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AssignRoleRequest.Merge(m, src)
+}
+func (m *AssignRoleRequest) XXX_Size() int {
+	return xxx_messageInfo_AssignRoleRequest.Size(m)
+}
+func (m *AssignRoleRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_AssignRoleRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AssignRoleRequest proto.InternalMessageInfo
+
+func (m *AssignRoleRequest) GetUserId() int32 {
+	if m != nil {
+		return m.UserId
+	}
+	return 0
+}
+
+func (m *AssignRoleRequest) GetRoleId() int32 {
+	if m != nil {
+		return m.RoleId
+	}
+	return 0
+}
+
+type AssignRoleResponse struct {
+	IsAssigned           bool     `protobuf:"varint,1,opt,name=is_assigned,json=isAssigned,proto3" json:"is_assigned,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AssignRoleResponse) Reset()         { *m = AssignRoleResponse{} }
+func (m *AssignRoleResponse) String() string { return proto.CompactTextString(m) }
+func (*AssignRoleResponse) ProtoMessage()    {}
+func (*AssignRoleResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4acb1e639fd1f5bc, []int{13}
+}
+
+func (m *AssignRoleResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AssignRoleResponse.Unmarshal(m, b)
+}
+func (m *AssignRoleResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AssignRoleResponse.Marshal(b, m, deterministic)
+}
+func (m *AssignRoleResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AssignRoleResponse.Merge(m, src)
+}
+func (m *AssignRoleResponse) XXX_Size() int {
+	return xxx_messageInfo_AssignRoleResponse.Size(m)
+}
+func (m *AssignRoleResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_AssignRoleResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AssignRoleResponse proto.InternalMessageInfo
+
+func (m *AssignRoleResponse) GetIsAssigned() bool {
+	if m != nil {
+		return m.IsAssigned
+	}
+	return false
+}
+
+type UnassignRoleRequest struct {
+	UserId               int32    `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	RoleId               int32    `protobuf:"varint,2,opt,name=role_id,json=roleId,proto3" json:"role_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *UnassignRoleRequest) Reset()         { *m = UnassignRoleRequest{} }
+func (m *UnassignRoleRequest) String() string { return proto.CompactTextString(m) }
+func (*UnassignRoleRequest) ProtoMessage()    {}
+func (*UnassignRoleRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4acb1e639fd1f5bc, []int{14}
+}
+
+func (m *UnassignRoleRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UnassignRoleRequest.Unmarshal(m, b)
+}
+func (m *UnassignRoleRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UnassignRoleRequest.Marshal(b, m, deterministic)
+}
+func (m *UnassignRoleRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UnassignRoleRequest.Merge(m, src)
+}
+func (m *UnassignRoleRequest) XXX_Size() int {
+	return xxx_messageInfo_UnassignRoleRequest.Size(m)
+}
+func (m *UnassignRoleRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_UnassignRoleRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UnassignRoleRequest proto.InternalMessageInfo
+
+func (m *UnassignRoleRequest) GetUserId() int32 {
+	if m != nil {
+		return m.UserId
+	}
+	return 0
+}
+
+func (m *UnassignRoleRequest) GetRoleId() int32 {
+	if m != nil {
+		return m.RoleId
+	}
+	return 0
+}
+
+type UnassignRoleResponse struct {
+	IsUnassigned         bool     `protobuf:"varint,1,opt,name=is_unassigned,json=isUnassigned,proto3" json:"is_unassigned,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *UnassignRoleResponse) Reset()         { *m = UnassignRoleResponse{} }
+func (m *UnassignRoleResponse) String() string { return proto.CompactTextString(m) }
+func (*UnassignRoleResponse) ProtoMessage()    {}
+func (*UnassignRoleResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4acb1e639fd1f5bc, []int{15}
+}
+
+func (m *UnassignRoleResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UnassignRoleResponse.Unmarshal(m, b)
+}
+func (m *UnassignRoleResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UnassignRoleResponse.Marshal(b, m, deterministic)
+}
+func (m *UnassignRoleResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UnassignRoleResponse.Merge(m, src)
+}
+func (m *UnassignRoleResponse) XXX_Size() int {
+	return xxx_messageInfo_UnassignRoleResponse.Size(m)
+}
+func (m *UnassignRoleResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_UnassignRoleResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UnassignRoleResponse proto.InternalMessageInfo
+
+func (m *UnassignRoleResponse) GetIsUnassigned() bool {
+	if m != nil {
+		return m.IsUnassigned
+	}
+	return false
+}
+
+func init() {
+	proto.RegisterType((*Role)(nil), "ranabd36.qaengine.Role")
+	proto.RegisterType((*Permission)(nil), "ranabd36.qaengine.Permission")
+	proto.RegisterType((*ListRolesRequest)(nil), "ranabd36.qaengine.ListRolesRequest")
+	proto.RegisterType((*ListRolesResponse)(nil), "ranabd36.qaengine.ListRolesResponse")
+	proto.RegisterType((*ListPermissionsRequest)(nil), "ranabd36.qaengine.ListPermissionsRequest")
+	proto.RegisterType((*ListPermissionsResponse)(nil), "ranabd36.qaengine.ListPermissionsResponse")
+	proto.RegisterType((*CreateRoleRequest)(nil), "ranabd36.qaengine.CreateRoleRequest")
+	proto.RegisterType((*CreateRoleResponse)(nil), "ranabd36.qaengine.CreateRoleResponse")
+	proto.RegisterType((*UpdateRoleRequest)(nil), "ranabd36.qaengine.UpdateRoleRequest")
+	proto.RegisterType((*UpdateRoleResponse)(nil), "ranabd36.qaengine.UpdateRoleResponse")
+	proto.RegisterType((*DeleteRoleRequest)(nil), "ranabd36.qaengine.DeleteRoleRequest")
+	proto.RegisterType((*DeleteRoleResponse)(nil), "ranabd36.qaengine.DeleteRoleResponse")
+	proto.RegisterType((*AssignRoleRequest)(nil), "ranabd36.qaengine.AssignRoleRequest")
+	proto.RegisterType((*AssignRoleResponse)(nil), "ranabd36.qaengine.AssignRoleResponse")
+	proto.RegisterType((*UnassignRoleRequest)(nil), "ranabd36.qaengine.UnassignRoleRequest")
+	proto.RegisterType((*UnassignRoleResponse)(nil), "ranabd36.qaengine.UnassignRoleResponse")
+}
+
+func init() {
+	proto.RegisterFile("role_service_message.proto", fileDescriptor_4acb1e639fd1f5bc)
+}
+
+var fileDescriptor_4acb1e639fd1f5bc = []byte{
+	// 642 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x95, 0xef, 0x6b, 0xd3, 0x40,
+	0x1c, 0xc6, 0x49, 0xd7, 0x56, 0xfb, 0xed, 0x9c, 0xcb, 0x39, 0x5c, 0x08, 0x8c, 0x85, 0x6c, 0xd5,
+	0xaa, 0x98, 0x41, 0x8b, 0x82, 0xf8, 0x42, 0x3b, 0x15, 0x19, 0xf8, 0x42, 0xa2, 0x7d, 0xb3, 0x37,
+	0xe1, 0xda, 0x3b, 0xe3, 0x49, 0xf3, 0x63, 0xb9, 0x44, 0x10, 0xfc, 0x0b, 0xf6, 0x9f, 0xf8, 0x56,
+	0xd8, 0xff, 0x27, 0x77, 0xc9, 0xf2, 0xa3, 0xc9, 0x1a, 0x41, 0xdf, 0x35, 0x77, 0xcf, 0x3d, 0x9f,
+	0x27, 0xdf, 0xde, 0xd3, 0x82, 0x1e, 0x05, 0x2b, 0xea, 0x70, 0x1a, 0x7d, 0x67, 0x4b, 0xea, 0x78,
+	0x94, 0x73, 0xec, 0x52, 0x2b, 0x8c, 0x82, 0x38, 0x40, 0x6a, 0x84, 0x7d, 0xbc, 0x20, 0xd3, 0xe7,
+	0xd6, 0x05, 0xa6, 0xbe, 0xcb, 0x7c, 0xaa, 0xab, 0x38, 0x89, 0xbf, 0x3a, 0x61, 0xb0, 0x62, 0xcb,
+	0x1f, 0xa9, 0x4a, 0x3f, 0x74, 0x83, 0xc0, 0x5d, 0xd1, 0x13, 0xf9, 0xb4, 0x48, 0xbe, 0x9c, 0xc4,
+	0xcc, 0xa3, 0x3c, 0xc6, 0x5e, 0x98, 0x0a, 0xcc, 0x5f, 0x0a, 0x74, 0xed, 0x60, 0x45, 0xd1, 0x0e,
+	0x74, 0x18, 0xd1, 0x14, 0x43, 0x19, 0xf7, 0xec, 0x0e, 0x23, 0x08, 0x41, 0xd7, 0xc7, 0x1e, 0xd5,
+	0x3a, 0x86, 0x32, 0x1e, 0xd8, 0xf2, 0x33, 0x32, 0x60, 0x48, 0x28, 0x5f, 0x46, 0x2c, 0x8c, 0x59,
+	0xe0, 0x6b, 0x5b, 0x72, 0xab, 0xbc, 0x24, 0x14, 0x21, 0x8d, 0x3c, 0xc6, 0x39, 0x0b, 0x7c, 0xae,
+	0x75, 0x8d, 0x2d, 0xa1, 0x28, 0x2d, 0xa1, 0x17, 0x00, 0xcb, 0x88, 0xe2, 0x98, 0x12, 0x07, 0xc7,
+	0x5a, 0xcf, 0x50, 0xc6, 0xc3, 0x89, 0x6e, 0xa5, 0x31, 0xad, 0xeb, 0x98, 0xd6, 0xe7, 0xeb, 0x98,
+	0xf6, 0x20, 0x53, 0xcf, 0x62, 0xf3, 0x14, 0xe0, 0x63, 0xee, 0x94, 0x07, 0x54, 0x6e, 0x0e, 0xd8,
+	0xa9, 0x05, 0x34, 0x11, 0xec, 0x7e, 0x60, 0x3c, 0x16, 0xaf, 0xcc, 0x6d, 0x7a, 0x91, 0x50, 0x2e,
+	0x7c, 0xd5, 0xd2, 0x1a, 0x0f, 0x03, 0x9f, 0x53, 0xf4, 0x14, 0x7a, 0x62, 0xfa, 0x5c, 0x53, 0x8c,
+	0xad, 0xf1, 0x70, 0xb2, 0x6f, 0xd5, 0xe6, 0x6d, 0x89, 0x03, 0x76, 0xaa, 0x32, 0x35, 0xb8, 0x2f,
+	0x3c, 0x8a, 0x7c, 0xb9, 0xfb, 0x39, 0xec, 0xd7, 0x76, 0x32, 0xc6, 0xab, 0xea, 0xb4, 0x52, 0xd2,
+	0x41, 0x03, 0xa9, 0x38, 0x5c, 0x19, 0xa6, 0xf9, 0x1a, 0xd4, 0x37, 0x72, 0x3c, 0x32, 0x4a, 0x0a,
+	0x44, 0x4f, 0xa0, 0x2b, 0x32, 0xc9, 0xc1, 0x6c, 0x08, 0x2e, 0x45, 0xe6, 0x31, 0xa0, 0xb2, 0x43,
+	0x16, 0x6c, 0xed, 0x32, 0x08, 0xce, 0x3c, 0x24, 0xff, 0xc2, 0x99, 0x02, 0x2a, 0x3b, 0x64, 0x9c,
+	0x03, 0x00, 0xc6, 0x9d, 0x44, 0x6e, 0xa4, 0xbc, 0xdb, 0xf6, 0x80, 0xf1, 0x54, 0x49, 0xcc, 0x23,
+	0x50, 0xdf, 0xd2, 0x15, 0xad, 0x62, 0xd7, 0xb3, 0x4d, 0x01, 0x95, 0x45, 0x15, 0x67, 0x22, 0x37,
+	0x4a, 0xce, 0xa9, 0x92, 0x98, 0xef, 0x40, 0x9d, 0x71, 0xce, 0x5c, 0xbf, 0xec, 0xbc, 0x0f, 0xb7,
+	0x12, 0x4e, 0x23, 0x27, 0xb7, 0xef, 0x8b, 0xc7, 0x33, 0x22, 0x36, 0x64, 0x13, 0x19, 0x91, 0x57,
+	0xaa, 0x67, 0xf7, 0xc5, 0xe3, 0x19, 0x31, 0x9f, 0x01, 0x2a, 0xdb, 0x64, 0xec, 0x43, 0x18, 0x32,
+	0xee, 0x60, 0xb9, 0x91, 0xc3, 0x81, 0xf1, 0x59, 0xb6, 0x62, 0xbe, 0x87, 0x7b, 0x73, 0x1f, 0xff,
+	0x07, 0xfe, 0x4b, 0xd8, 0xab, 0x1a, 0x65, 0x09, 0x8e, 0xe0, 0x8e, 0x98, 0xab, 0xbf, 0x96, 0x61,
+	0x9b, 0xf1, 0x79, 0xbe, 0x36, 0xf9, 0xdd, 0x87, 0xa1, 0x38, 0xf5, 0x29, 0xfd, 0x7d, 0x41, 0xdf,
+	0x60, 0x90, 0xd7, 0x00, 0x1d, 0x35, 0x7c, 0x9d, 0xeb, 0xc5, 0xd1, 0x8f, 0x37, 0x8b, 0xd2, 0x30,
+	0x26, 0xba, 0xbc, 0xd2, 0x76, 0xd0, 0xb6, 0xec, 0x89, 0xe5, 0x61, 0x1f, 0xbb, 0x14, 0xfd, 0x84,
+	0xbb, 0x6b, 0xa5, 0x40, 0x8f, 0x6e, 0x30, 0xab, 0x57, 0x4a, 0x7f, 0xfc, 0x37, 0xd2, 0x0d, 0xf4,
+	0x00, 0xa0, 0xb8, 0xf4, 0xa8, 0xe9, 0x2d, 0x6a, 0xad, 0xd2, 0x47, 0x2d, 0xaa, 0x0c, 0xb7, 0x77,
+	0x79, 0xa5, 0xed, 0x56, 0x71, 0x9a, 0x22, 0x80, 0xc5, 0xed, 0x6f, 0x04, 0xd6, 0xea, 0xa5, 0x8f,
+	0x5a, 0x54, 0x6d, 0xc0, 0xa2, 0x14, 0x8d, 0xc0, 0x5a, 0xb1, 0xf4, 0x51, 0x8b, 0xaa, 0x0d, 0x58,
+	0x34, 0xa1, 0x11, 0x58, 0xeb, 0x9b, 0x3e, 0x6a, 0x51, 0x6d, 0x04, 0x26, 0xb0, 0x5d, 0xbe, 0xfa,
+	0xe8, 0x41, 0xd3, 0xb8, 0xea, 0x25, 0xd3, 0x1f, 0xb6, 0xea, 0x36, 0x61, 0x4f, 0xbb, 0xe7, 0x9d,
+	0x70, 0xb1, 0xe8, 0xcb, 0x3f, 0xaa, 0xe9, 0x9f, 0x01, 0x00, 0xb7, 0x3f, 0x23, 0x9f, 0xa1, 0x07,
+	0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConnInterface
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion6
+
+// RoleServiceClient is the client API for RoleService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type RoleServiceClient interface {
+	ListRoles(ctx context.Context, in *ListRolesRequest, opts ...grpc.CallOption) (*ListRolesResponse, error)
+	ListPermissions(ctx context.Context, in *ListPermissionsRequest, opts ...grpc.CallOption) (*ListPermissionsResponse, error)
+	CreateRole(ctx context.Context, in *CreateRoleRequest, opts ...grpc.CallOption) (*CreateRoleResponse, error)
+	UpdateRole(ctx context.Context, in *UpdateRoleRequest, opts ...grpc.CallOption) (*UpdateRoleResponse, error)
+	DeleteRole(ctx context.Context, in *DeleteRoleRequest, opts ...grpc.CallOption) (*DeleteRoleResponse, error)
+	AssignRole(ctx context.Context, in *AssignRoleRequest, opts ...grpc.CallOption) (*AssignRoleResponse, error)
+	UnassignRole(ctx context.Context, in *UnassignRoleRequest, opts ...grpc.CallOption) (*UnassignRoleResponse, error)
+}
+
+type roleServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewRoleServiceClient(cc grpc.ClientConnInterface) RoleServiceClient {
+	return &roleServiceClient{cc}
+}
+
+func (c *roleServiceClient) ListRoles(ctx context.Context, in *ListRolesRequest, opts ...grpc.CallOption) (*ListRolesResponse, error) {
+	out := new(ListRolesResponse)
+	err := c.cc.Invoke(ctx, "/ranabd36.qaengine.RoleService/ListRoles", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *roleServiceClient) ListPermissions(ctx context.Context, in *ListPermissionsRequest, opts ...grpc.CallOption) (*ListPermissionsResponse, error) {
+	out := new(ListPermissionsResponse)
+	err := c.cc.Invoke(ctx, "/ranabd36.qaengine.RoleService/ListPermissions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *roleServiceClient) CreateRole(ctx context.Context, in *CreateRoleRequest, opts ...grpc.CallOption) (*CreateRoleResponse, error) {
+	out := new(CreateRoleResponse)
+	err := c.cc.Invoke(ctx, "/ranabd36.qaengine.RoleService/CreateRole", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *roleServiceClient) UpdateRole(ctx context.Context, in *UpdateRoleRequest, opts ...grpc.CallOption) (*UpdateRoleResponse, error) {
+	out := new(UpdateRoleResponse)
+	err := c.cc.Invoke(ctx, "/ranabd36.qaengine.RoleService/UpdateRole", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *roleServiceClient) DeleteRole(ctx context.Context, in *DeleteRoleRequest, opts ...grpc.CallOption) (*DeleteRoleResponse, error) {
+	out := new(DeleteRoleResponse)
+	err := c.cc.Invoke(ctx, "/ranabd36.qaengine.RoleService/DeleteRole", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *roleServiceClient) AssignRole(ctx context.Context, in *AssignRoleRequest, opts ...grpc.CallOption) (*AssignRoleResponse, error) {
+	out := new(AssignRoleResponse)
+	err := c.cc.Invoke(ctx, "/ranabd36.qaengine.RoleService/AssignRole", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *roleServiceClient) UnassignRole(ctx context.Context, in *UnassignRoleRequest, opts ...grpc.CallOption) (*UnassignRoleResponse, error) {
+	out := new(UnassignRoleResponse)
+	err := c.cc.Invoke(ctx, "/ranabd36.qaengine.RoleService/UnassignRole", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// RoleServiceServer is the server API for RoleService service.
+type RoleServiceServer interface {
+	ListRoles(context.Context, *ListRolesRequest) (*ListRolesResponse, error)
+	ListPermissions(context.Context, *ListPermissionsRequest) (*ListPermissionsResponse, error)
+	CreateRole(context.Context, *CreateRoleRequest) (*CreateRoleResponse, error)
+	UpdateRole(context.Context, *UpdateRoleRequest) (*UpdateRoleResponse, error)
+	DeleteRole(context.Context, *DeleteRoleRequest) (*DeleteRoleResponse, error)
+	AssignRole(context.Context, *AssignRoleRequest) (*AssignRoleResponse, error)
+	UnassignRole(context.Context, *UnassignRoleRequest) (*UnassignRoleResponse, error)
+}
+
+// UnimplementedRoleServiceServer can be embedded to have forward compatible implementations.
+type UnimplementedRoleServiceServer struct {
+}
+
+func (*UnimplementedRoleServiceServer) ListRoles(ctx context.Context, req *ListRolesRequest) (*ListRolesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListRoles not implemented")
+}
+func (*UnimplementedRoleServiceServer) ListPermissions(ctx context.Context, req *ListPermissionsRequest) (*ListPermissionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListPermissions not implemented")
+}
+func (*UnimplementedRoleServiceServer) CreateRole(ctx context.Context, req *CreateRoleRequest) (*CreateRoleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateRole not implemented")
+}
+func (*UnimplementedRoleServiceServer) UpdateRole(ctx context.Context, req *UpdateRoleRequest) (*UpdateRoleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateRole not implemented")
+}
+func (*UnimplementedRoleServiceServer) DeleteRole(ctx context.Context, req *DeleteRoleRequest) (*DeleteRoleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteRole not implemented")
+}
+func (*UnimplementedRoleServiceServer) AssignRole(ctx context.Context, req *AssignRoleRequest) (*AssignRoleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AssignRole not implemented")
+}
+func (*UnimplementedRoleServiceServer) UnassignRole(ctx context.Context, req *UnassignRoleRequest) (*UnassignRoleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnassignRole not implemented")
+}
+
+func RegisterRoleServiceServer(s *grpc.Server, srv RoleServiceServer) {
+	s.RegisterService(&_RoleService_serviceDesc, srv)
+}
+
+func _RoleService_ListRoles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListRolesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RoleServiceServer).ListRoles(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ranabd36.qaengine.RoleService/ListRoles",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RoleServiceServer).ListRoles(ctx, req.(*ListRolesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RoleService_ListPermissions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPermissionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RoleServiceServer).ListPermissions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ranabd36.qaengine.RoleService/ListPermissions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RoleServiceServer).ListPermissions(ctx, req.(*ListPermissionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RoleService_CreateRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateRoleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RoleServiceServer).CreateRole(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ranabd36.qaengine.RoleService/CreateRole",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RoleServiceServer).CreateRole(ctx, req.(*CreateRoleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RoleService_UpdateRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateRoleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RoleServiceServer).UpdateRole(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ranabd36.qaengine.RoleService/UpdateRole",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RoleServiceServer).UpdateRole(ctx, req.(*UpdateRoleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RoleService_DeleteRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteRoleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RoleServiceServer).DeleteRole(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ranabd36.qaengine.RoleService/DeleteRole",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RoleServiceServer).DeleteRole(ctx, req.(*DeleteRoleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RoleService_AssignRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AssignRoleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RoleServiceServer).AssignRole(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ranabd36.qaengine.RoleService/AssignRole",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RoleServiceServer).AssignRole(ctx, req.(*AssignRoleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RoleService_UnassignRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnassignRoleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RoleServiceServer).UnassignRole(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ranabd36.qaengine.RoleService/UnassignRole",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RoleServiceServer).UnassignRole(ctx, req.(*UnassignRoleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _RoleService_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "ranabd36.qaengine.RoleService",
+	HandlerType: (*RoleServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ListRoles",
+			Handler:    _RoleService_ListRoles_Handler,
+		},
+		{
+			MethodName: "ListPermissions",
+			Handler:    _RoleService_ListPermissions_Handler,
+		},
+		{
+			MethodName: "CreateRole",
+			Handler:    _RoleService_CreateRole_Handler,
+		},
+		{
+			MethodName: "UpdateRole",
+			Handler:    _RoleService_UpdateRole_Handler,
+		},
+		{
+			MethodName: "DeleteRole",
+			Handler:    _RoleService_DeleteRole_Handler,
+		},
+		{
+			MethodName: "AssignRole",
+			Handler:    _RoleService_AssignRole_Handler,
+		},
+		{
+			MethodName: "UnassignRole",
+			Handler:    _RoleService_UnassignRole_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "role_service_message.proto",
+}
@@ -0,0 +1,416 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: search_service_message.proto
+
+package pb
+
+import (
+	context "context"
+	fmt "fmt"
+	proto "github.com/golang/protobuf/proto"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	math "math"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
+
+type SearchRequest_Kind int32
+
+const (
+	SearchRequest_ALL       SearchRequest_Kind = 0
+	SearchRequest_QUESTIONS SearchRequest_Kind = 1
+	SearchRequest_ANSWERS   SearchRequest_Kind = 2
+)
+
+var SearchRequest_Kind_name = map[int32]string{
+	0: "ALL",
+	1: "QUESTIONS",
+	2: "ANSWERS",
+}
+
+var SearchRequest_Kind_value = map[string]int32{
+	"ALL":       0,
+	"QUESTIONS": 1,
+	"ANSWERS":   2,
+}
+
+func (x SearchRequest_Kind) String() string {
+	return proto.EnumName(SearchRequest_Kind_name, int32(x))
+}
+
+func (SearchRequest_Kind) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_02d736917937c5d7, []int{0, 0}
+}
+
+type SearchResult_Kind int32
+
+const (
+	SearchResult_QUESTION SearchResult_Kind = 0
+	SearchResult_ANSWER   SearchResult_Kind = 1
+)
+
+var SearchResult_Kind_name = map[int32]string{
+	0: "QUESTION",
+	1: "ANSWER",
+}
+
+var SearchResult_Kind_value = map[string]int32{
+	"QUESTION": 0,
+	"ANSWER":   1,
+}
+
+func (x SearchResult_Kind) String() string {
+	return proto.EnumName(SearchResult_Kind_name, int32(x))
+}
+
+func (SearchResult_Kind) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_02d736917937c5d7, []int{1, 0}
+}
+
+type SearchRequest struct {
+	Query                string             `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
+	Kind                 SearchRequest_Kind `protobuf:"varint,2,opt,name=kind,proto3,enum=ranabd36.qaengine.SearchRequest_Kind" json:"kind,omitempty"`
+	Tags                 []string           `protobuf:"bytes,3,rep,name=tags,proto3" json:"tags,omitempty"`
+	AuthorId             int32              `protobuf:"varint,4,opt,name=author_id,json=authorId,proto3" json:"author_id,omitempty"`
+	PageSize             int32              `protobuf:"varint,5,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken            string             `protobuf:"bytes,6,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
+}
+
+func (m *SearchRequest) Reset()         { *m = SearchRequest{} }
+func (m *SearchRequest) String() string { return proto.CompactTextString(m) }
+func (*SearchRequest) ProtoMessage()    {}
+func (*SearchRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02d736917937c5d7, []int{0}
+}
+
+func (m *SearchRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SearchRequest.Unmarshal(m, b)
+}
+func (m *SearchRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SearchRequest.Marshal(b, m, deterministic)
+}
+func (m *SearchRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SearchRequest.Merge(m, src)
+}
+func (m *SearchRequest) XXX_Size() int {
+	return xxx_messageInfo_SearchRequest.Size(m)
+}
+func (m *SearchRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SearchRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SearchRequest proto.InternalMessageInfo
+
+func (m *SearchRequest) GetQuery() string {
+	if m != nil {
+		return m.Query
+	}
+	return ""
+}
+
+func (m *SearchRequest) GetKind() SearchRequest_Kind {
+	if m != nil {
+		return m.Kind
+	}
+	return SearchRequest_ALL
+}
+
+func (m *SearchRequest) GetTags() []string {
+	if m != nil {
+		return m.Tags
+	}
+	return nil
+}
+
+func (m *SearchRequest) GetAuthorId() int32 {
+	if m != nil {
+		return m.AuthorId
+	}
+	return 0
+}
+
+func (m *SearchRequest) GetPageSize() int32 {
+	if m != nil {
+		return m.PageSize
+	}
+	return 0
+}
+
+func (m *SearchRequest) GetPageToken() string {
+	if m != nil {
+		return m.PageToken
+	}
+	return ""
+}
+
+type SearchResult struct {
+	Kind                 SearchResult_Kind `protobuf:"varint,1,opt,name=kind,proto3,enum=ranabd36.qaengine.SearchResult_Kind" json:"kind,omitempty"`
+	Id                   int32             `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+	QuestionId           int32             `protobuf:"varint,3,opt,name=question_id,json=questionId,proto3" json:"question_id,omitempty"`
+	AuthorId             int32             `protobuf:"varint,4,opt,name=author_id,json=authorId,proto3" json:"author_id,omitempty"`
+	Title                string            `protobuf:"bytes,5,opt,name=title,proto3" json:"title,omitempty"`
+	Snippet              string            `protobuf:"bytes,6,opt,name=snippet,proto3" json:"snippet,omitempty"`
+	Rank                 float64           `protobuf:"fixed64,7,opt,name=rank,proto3" json:"rank,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *SearchResult) Reset()         { *m = SearchResult{} }
+func (m *SearchResult) String() string { return proto.CompactTextString(m) }
+func (*SearchResult) ProtoMessage()    {}
+func (*SearchResult) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02d736917937c5d7, []int{1}
+}
+
+func (m *SearchResult) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SearchResult.Unmarshal(m, b)
+}
+func (m *SearchResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SearchResult.Marshal(b, m, deterministic)
+}
+func (m *SearchResult) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SearchResult.Merge(m, src)
+}
+func (m *SearchResult) XXX_Size() int {
+	return xxx_messageInfo_SearchResult.Size(m)
+}
+func (m *SearchResult) XXX_DiscardUnknown() {
+	xxx_messageInfo_SearchResult.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SearchResult proto.InternalMessageInfo
+
+func (m *SearchResult) GetKind() SearchResult_Kind {
+	if m != nil {
+		return m.Kind
+	}
+	return SearchResult_QUESTION
+}
+
+func (m *SearchResult) GetId() int32 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *SearchResult) GetQuestionId() int32 {
+	if m != nil {
+		return m.QuestionId
+	}
+	return 0
+}
+
+func (m *SearchResult) GetAuthorId() int32 {
+	if m != nil {
+		return m.AuthorId
+	}
+	return 0
+}
+
+func (m *SearchResult) GetTitle() string {
+	if m != nil {
+		return m.Title
+	}
+	return ""
+}
+
+func (m *SearchResult) GetSnippet() string {
+	if m != nil {
+		return m.Snippet
+	}
+	return ""
+}
+
+func (m *SearchResult) GetRank() float64 {
+	if m != nil {
+		return m.Rank
+	}
+	return 0
+}
+
+type SearchResponse struct {
+	Results              []*SearchResult `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+	NextPageToken        string          `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *SearchResponse) Reset()         { *m = SearchResponse{} }
+func (m *SearchResponse) String() string { return proto.CompactTextString(m) }
+func (*SearchResponse) ProtoMessage()    {}
+func (*SearchResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02d736917937c5d7, []int{2}
+}
+
+func (m *SearchResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SearchResponse.Unmarshal(m, b)
+}
+func (m *SearchResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SearchResponse.Marshal(b, m, deterministic)
+}
+func (m *SearchResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SearchResponse.Merge(m, src)
+}
+func (m *SearchResponse) XXX_Size() int {
+	return xxx_messageInfo_SearchResponse.Size(m)
+}
+func (m *SearchResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SearchResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SearchResponse proto.InternalMessageInfo
+
+func (m *SearchResponse) GetResults() []*SearchResult {
+	if m != nil {
+		return m.Results
+	}
+	return nil
+}
+
+func (m *SearchResponse) GetNextPageToken() string {
+	if m != nil {
+		return m.NextPageToken
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterEnum("ranabd36.qaengine.SearchRequest_Kind", SearchRequest_Kind_name, SearchRequest_Kind_value)
+	proto.RegisterEnum("ranabd36.qaengine.SearchResult_Kind", SearchResult_Kind_name, SearchResult_Kind_value)
+	proto.RegisterType((*SearchRequest)(nil), "ranabd36.qaengine.SearchRequest")
+	proto.RegisterType((*SearchResult)(nil), "ranabd36.qaengine.SearchResult")
+	proto.RegisterType((*SearchResponse)(nil), "ranabd36.qaengine.SearchResponse")
+}
+
+func init() {
+	proto.RegisterFile("search_service_message.proto", fileDescriptor_02d736917937c5d7)
+}
+
+var fileDescriptor_02d736917937c5d7 = []byte{
+	// 459 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x92, 0xdf, 0x6e, 0xd3, 0x30,
+	0x14, 0xc6, 0xe7, 0xfc, 0x6d, 0x4e, 0xd7, 0x92, 0x59, 0xbb, 0xb0, 0x06, 0x68, 0x21, 0x02, 0x14,
+	0x09, 0x29, 0x17, 0x9d, 0x84, 0xd8, 0xe5, 0x90, 0x76, 0x31, 0x31, 0x0d, 0x70, 0x36, 0x21, 0x71,
+	0x13, 0xa5, 0x8d, 0xe9, 0xac, 0x16, 0x27, 0x8d, 0x5d, 0xc4, 0xf6, 0x08, 0xbc, 0x0f, 0x2f, 0x87,
+	0xb8, 0x40, 0xb6, 0x1b, 0x34, 0x84, 0xb6, 0xde, 0xd9, 0xdf, 0x39, 0x39, 0xf9, 0x7e, 0x9f, 0x0f,
+	0x3c, 0x91, 0xac, 0xea, 0x66, 0xd7, 0xa5, 0x64, 0xdd, 0x37, 0x3e, 0x63, 0xe5, 0x57, 0x26, 0x65,
+	0x35, 0x67, 0x79, 0xdb, 0x35, 0xaa, 0xc1, 0x7b, 0x5d, 0x25, 0xaa, 0x69, 0x7d, 0xf4, 0x3a, 0x5f,
+	0x55, 0x4c, 0xcc, 0xb9, 0x60, 0x07, 0x7b, 0xd5, 0x5a, 0x5d, 0x97, 0x6d, 0xb3, 0xe4, 0xb3, 0x1b,
+	0xdb, 0x95, 0xfe, 0x42, 0x30, 0x2a, 0xcc, 0x18, 0xca, 0x56, 0x6b, 0x26, 0x15, 0xde, 0x07, 0x7f,
+	0xb5, 0x66, 0xdd, 0x0d, 0x41, 0x09, 0xca, 0x22, 0x6a, 0x2f, 0xf8, 0x18, 0xbc, 0x05, 0x17, 0x35,
+	0x71, 0x12, 0x94, 0x8d, 0x27, 0x2f, 0xf2, 0xff, 0x86, 0xe7, 0xff, 0x4c, 0xc9, 0xdf, 0x71, 0x51,
+	0x53, 0xf3, 0x09, 0xc6, 0xe0, 0xa9, 0x6a, 0x2e, 0x89, 0x9b, 0xb8, 0x59, 0x44, 0xcd, 0x19, 0x3f,
+	0x86, 0x48, 0x7b, 0x69, 0xba, 0x92, 0xd7, 0xc4, 0x4b, 0x50, 0xe6, 0xd3, 0x81, 0x15, 0xce, 0x6a,
+	0x5d, 0x6c, 0xab, 0x39, 0x2b, 0x25, 0xbf, 0x65, 0xc4, 0xb7, 0x45, 0x2d, 0x14, 0xfc, 0x96, 0xe1,
+	0xa7, 0x00, 0xa6, 0xa8, 0x9a, 0x05, 0x13, 0x24, 0x30, 0x1e, 0x4d, 0xfb, 0xa5, 0x16, 0xd2, 0x57,
+	0xe0, 0xe9, 0x5f, 0xe3, 0x10, 0xdc, 0x93, 0xf3, 0xf3, 0x78, 0x07, 0x8f, 0x20, 0xfa, 0x78, 0x75,
+	0x5a, 0x5c, 0x9e, 0xbd, 0xbf, 0x28, 0x62, 0x84, 0x87, 0x10, 0x9e, 0x5c, 0x14, 0x9f, 0x4e, 0x69,
+	0x11, 0x3b, 0xe9, 0x6f, 0x04, 0xbb, 0xbd, 0x6d, 0xb9, 0x5e, 0x2a, 0xfc, 0x66, 0x43, 0x89, 0x0c,
+	0xe5, 0xf3, 0x07, 0x28, 0x75, 0xfb, 0x5d, 0xc8, 0x31, 0x38, 0xdc, 0xa6, 0xe3, 0x53, 0x87, 0xd7,
+	0xf8, 0x10, 0x86, 0x26, 0x08, 0xde, 0x08, 0x8d, 0xe8, 0x9a, 0x02, 0xf4, 0x92, 0x85, 0xbc, 0x3f,
+	0x81, 0x7d, 0xf0, 0x15, 0x57, 0x4b, 0x4b, 0x1f, 0x51, 0x7b, 0xc1, 0x04, 0x42, 0x29, 0x78, 0xdb,
+	0x32, 0xb5, 0xe1, 0xee, 0xaf, 0x3a, 0xe2, 0xae, 0x12, 0x0b, 0x12, 0x26, 0x28, 0x43, 0xd4, 0x9c,
+	0xd3, 0x64, 0x93, 0xc4, 0x2e, 0x0c, 0xfa, 0x00, 0xe2, 0x1d, 0x0c, 0x10, 0x58, 0xfe, 0x18, 0xa5,
+	0x12, 0xc6, 0x7f, 0x71, 0xda, 0x46, 0x48, 0x86, 0x8f, 0x21, 0xec, 0x0c, 0x9a, 0x24, 0x28, 0x71,
+	0xb3, 0xe1, 0xe4, 0x70, 0x4b, 0x04, 0xb4, 0xef, 0xc7, 0x2f, 0xe1, 0x91, 0x60, 0xdf, 0x55, 0x79,
+	0xe7, 0x71, 0x1c, 0x63, 0x72, 0xa4, 0xe5, 0x0f, 0xfd, 0x03, 0x4d, 0xbe, 0xf4, 0xfb, 0x56, 0xd8,
+	0xad, 0xc5, 0x57, 0x10, 0x58, 0x01, 0x27, 0xdb, 0xb6, 0xea, 0xe0, 0xd9, 0x43, 0x76, 0x0c, 0x42,
+	0x1a, 0xfc, 0xf8, 0x49, 0x9c, 0x01, 0x7a, 0xeb, 0x7d, 0x76, 0xda, 0xe9, 0x34, 0x30, 0x5b, 0x7e,
+	0xf4, 0x67, 0x00, 0x6d, 0x87, 0x1b, 0x7c, 0x2b, 0x03, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConnInterface
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion6
+
+// SearchServiceClient is the client API for SearchService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type SearchServiceClient interface {
+	Search(ctx context.Context, in *SearchRequest, opts ...grpc.CallOption) (*SearchResponse, error)
+}
+
+type searchServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewSearchServiceClient(cc grpc.ClientConnInterface) SearchServiceClient {
+	return &searchServiceClient{cc}
+}
+
+func (c *searchServiceClient) Search(ctx context.Context, in *SearchRequest, opts ...grpc.CallOption) (*SearchResponse, error) {
+	out := new(SearchResponse)
+	err := c.cc.Invoke(ctx, "/ranabd36.qaengine.SearchService/Search", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// SearchServiceServer is the server API for SearchService service.
+type SearchServiceServer interface {
+	Search(context.Context, *SearchRequest) (*SearchResponse, error)
+}
+
+// UnimplementedSearchServiceServer can be embedded to have forward compatible implementations.
+type UnimplementedSearchServiceServer struct {
+}
+
+func (*UnimplementedSearchServiceServer) Search(ctx context.Context, req *SearchRequest) (*SearchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Search not implemented")
+}
+
+func RegisterSearchServiceServer(s *grpc.Server, srv SearchServiceServer) {
+	s.RegisterService(&_SearchService_serviceDesc, srv)
+}
+
+func _SearchService_Search_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SearchServiceServer).Search(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ranabd36.qaengine.SearchService/Search",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SearchServiceServer).Search(ctx, req.(*SearchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _SearchService_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "ranabd36.qaengine.SearchService",
+	HandlerType: (*SearchServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Search",
+			Handler:    _SearchService_Search_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "search_service_message.proto",
+}
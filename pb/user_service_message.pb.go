@@ -8,6 +8,7 @@ import (
 	fmt "fmt"
 	proto "github.com/golang/protobuf/proto"
 	timestamp "github.com/golang/protobuf/ptypes/timestamp"
+	wrappers "github.com/golang/protobuf/ptypes/wrappers"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
@@ -25,6 +26,37 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
 
+type ListUsersRequest_SortBy int32
+
+const (
+	ListUsersRequest_CREATED_AT ListUsersRequest_SortBy = 0
+	ListUsersRequest_USERNAME   ListUsersRequest_SortBy = 1
+	ListUsersRequest_EMAIL      ListUsersRequest_SortBy = 2
+	ListUsersRequest_ID         ListUsersRequest_SortBy = 3
+)
+
+var ListUsersRequest_SortBy_name = map[int32]string{
+	0: "CREATED_AT",
+	1: "USERNAME",
+	2: "EMAIL",
+	3: "ID",
+}
+
+var ListUsersRequest_SortBy_value = map[string]int32{
+	"CREATED_AT": 0,
+	"USERNAME":   1,
+	"EMAIL":      2,
+	"ID":         3,
+}
+
+func (x ListUsersRequest_SortBy) String() string {
+	return proto.EnumName(ListUsersRequest_SortBy_name, int32(x))
+}
+
+func (ListUsersRequest_SortBy) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_83213d866ee4d08a, []int{24, 0}
+}
+
 type User struct {
 	Id                   int32                `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
 	FirstName            string               `protobuf:"bytes,2,opt,name=first_name,json=firstName,proto3" json:"first_name,omitempty"`
@@ -36,6 +68,13 @@ type User struct {
 	IsAdmin              bool                 `protobuf:"varint,8,opt,name=is_admin,json=isAdmin,proto3" json:"is_admin,omitempty"`
 	CreatedAt            *timestamp.Timestamp `protobuf:"bytes,9,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
 	UpdatedAt            *timestamp.Timestamp `protobuf:"bytes,10,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	IsEmailVerified      bool                 `protobuf:"varint,11,opt,name=is_email_verified,json=isEmailVerified,proto3" json:"is_email_verified,omitempty"`
+	IsTotpEnabled        bool                 `protobuf:"varint,12,opt,name=is_totp_enabled,json=isTotpEnabled,proto3" json:"is_totp_enabled,omitempty"`
+	Roles                []string             `protobuf:"bytes,13,rep,name=roles,proto3" json:"roles,omitempty"`
+	IsServiceAccount     bool                 `protobuf:"varint,14,opt,name=is_service_account,json=isServiceAccount,proto3" json:"is_service_account,omitempty"`
+	DeletedAt            *timestamp.Timestamp `protobuf:"bytes,15,opt,name=deleted_at,json=deletedAt,proto3" json:"deleted_at,omitempty"`
+	Version              int32                `protobuf:"varint,16,opt,name=version,proto3" json:"version,omitempty"`
+	Reputation           *Reputation          `protobuf:"bytes,17,opt,name=reputation,proto3" json:"reputation,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
 	XXX_unrecognized     []byte               `json:"-"`
 	XXX_sizecache        int32                `json:"-"`
@@ -136,6 +175,118 @@ func (m *User) GetUpdatedAt() *timestamp.Timestamp {
 	return nil
 }
 
+func (m *User) GetIsEmailVerified() bool {
+	if m != nil {
+		return m.IsEmailVerified
+	}
+	return false
+}
+
+func (m *User) GetIsTotpEnabled() bool {
+	if m != nil {
+		return m.IsTotpEnabled
+	}
+	return false
+}
+
+func (m *User) GetRoles() []string {
+	if m != nil {
+		return m.Roles
+	}
+	return nil
+}
+
+func (m *User) GetIsServiceAccount() bool {
+	if m != nil {
+		return m.IsServiceAccount
+	}
+	return false
+}
+
+func (m *User) GetDeletedAt() *timestamp.Timestamp {
+	if m != nil {
+		return m.DeletedAt
+	}
+	return nil
+}
+
+func (m *User) GetVersion() int32 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
+func (m *User) GetReputation() *Reputation {
+	if m != nil {
+		return m.Reputation
+	}
+	return nil
+}
+
+type Reputation struct {
+	Score                int32    `protobuf:"varint,1,opt,name=score,proto3" json:"score,omitempty"`
+	UpvotesReceived      int32    `protobuf:"varint,2,opt,name=upvotes_received,json=upvotesReceived,proto3" json:"upvotes_received,omitempty"`
+	DownvotesReceived    int32    `protobuf:"varint,3,opt,name=downvotes_received,json=downvotesReceived,proto3" json:"downvotes_received,omitempty"`
+	AcceptedAnswers      int32    `protobuf:"varint,4,opt,name=accepted_answers,json=acceptedAnswers,proto3" json:"accepted_answers,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Reputation) Reset()         { *m = Reputation{} }
+func (m *Reputation) String() string { return proto.CompactTextString(m) }
+func (*Reputation) ProtoMessage()    {}
+func (*Reputation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_83213d866ee4d08a, []int{1}
+}
+
+func (m *Reputation) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Reputation.Unmarshal(m, b)
+}
+func (m *Reputation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Reputation.Marshal(b, m, deterministic)
+}
+func (m *Reputation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Reputation.Merge(m, src)
+}
+func (m *Reputation) XXX_Size() int {
+	return xxx_messageInfo_Reputation.Size(m)
+}
+func (m *Reputation) XXX_DiscardUnknown() {
+	xxx_messageInfo_Reputation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Reputation proto.InternalMessageInfo
+
+func (m *Reputation) GetScore() int32 {
+	if m != nil {
+		return m.Score
+	}
+	return 0
+}
+
+func (m *Reputation) GetUpvotesReceived() int32 {
+	if m != nil {
+		return m.UpvotesReceived
+	}
+	return 0
+}
+
+func (m *Reputation) GetDownvotesReceived() int32 {
+	if m != nil {
+		return m.DownvotesReceived
+	}
+	return 0
+}
+
+func (m *Reputation) GetAcceptedAnswers() int32 {
+	if m != nil {
+		return m.AcceptedAnswers
+	}
+	return 0
+}
+
 type CreateUserRequest struct {
 	User                 *User    `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
@@ -147,7 +298,7 @@ func (m *CreateUserRequest) Reset()         { *m = CreateUserRequest{} }
 func (m *CreateUserRequest) String() string { return proto.CompactTextString(m) }
 func (*CreateUserRequest) ProtoMessage()    {}
 func (*CreateUserRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_83213d866ee4d08a, []int{1}
+	return fileDescriptor_83213d866ee4d08a, []int{2}
 }
 
 func (m *CreateUserRequest) XXX_Unmarshal(b []byte) error {
@@ -186,7 +337,7 @@ func (m *CreateUserResponse) Reset()         { *m = CreateUserResponse{} }
 func (m *CreateUserResponse) String() string { return proto.CompactTextString(m) }
 func (*CreateUserResponse) ProtoMessage()    {}
 func (*CreateUserResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_83213d866ee4d08a, []int{2}
+	return fileDescriptor_83213d866ee4d08a, []int{3}
 }
 
 func (m *CreateUserResponse) XXX_Unmarshal(b []byte) error {
@@ -225,7 +376,7 @@ func (m *FindUserRequest) Reset()         { *m = FindUserRequest{} }
 func (m *FindUserRequest) String() string { return proto.CompactTextString(m) }
 func (*FindUserRequest) ProtoMessage()    {}
 func (*FindUserRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_83213d866ee4d08a, []int{3}
+	return fileDescriptor_83213d866ee4d08a, []int{4}
 }
 
 func (m *FindUserRequest) XXX_Unmarshal(b []byte) error {
@@ -264,7 +415,7 @@ func (m *FindUserResponse) Reset()         { *m = FindUserResponse{} }
 func (m *FindUserResponse) String() string { return proto.CompactTextString(m) }
 func (*FindUserResponse) ProtoMessage()    {}
 func (*FindUserResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_83213d866ee4d08a, []int{4}
+	return fileDescriptor_83213d866ee4d08a, []int{5}
 }
 
 func (m *FindUserResponse) XXX_Unmarshal(b []byte) error {
@@ -303,7 +454,7 @@ func (m *UpdateUserRequest) Reset()         { *m = UpdateUserRequest{} }
 func (m *UpdateUserRequest) String() string { return proto.CompactTextString(m) }
 func (*UpdateUserRequest) ProtoMessage()    {}
 func (*UpdateUserRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_83213d866ee4d08a, []int{5}
+	return fileDescriptor_83213d866ee4d08a, []int{6}
 }
 
 func (m *UpdateUserRequest) XXX_Unmarshal(b []byte) error {
@@ -342,7 +493,7 @@ func (m *UpdateUserResponse) Reset()         { *m = UpdateUserResponse{} }
 func (m *UpdateUserResponse) String() string { return proto.CompactTextString(m) }
 func (*UpdateUserResponse) ProtoMessage()    {}
 func (*UpdateUserResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_83213d866ee4d08a, []int{6}
+	return fileDescriptor_83213d866ee4d08a, []int{7}
 }
 
 func (m *UpdateUserResponse) XXX_Unmarshal(b []byte) error {
@@ -381,7 +532,7 @@ func (m *DeleteUserRequest) Reset()         { *m = DeleteUserRequest{} }
 func (m *DeleteUserRequest) String() string { return proto.CompactTextString(m) }
 func (*DeleteUserRequest) ProtoMessage()    {}
 func (*DeleteUserRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_83213d866ee4d08a, []int{7}
+	return fileDescriptor_83213d866ee4d08a, []int{8}
 }
 
 func (m *DeleteUserRequest) XXX_Unmarshal(b []byte) error {
@@ -420,7 +571,7 @@ func (m *DeleteUserResponse) Reset()         { *m = DeleteUserResponse{} }
 func (m *DeleteUserResponse) String() string { return proto.CompactTextString(m) }
 func (*DeleteUserResponse) ProtoMessage()    {}
 func (*DeleteUserResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_83213d866ee4d08a, []int{8}
+	return fileDescriptor_83213d866ee4d08a, []int{9}
 }
 
 func (m *DeleteUserResponse) XXX_Unmarshal(b []byte) error {
@@ -448,6 +599,162 @@ func (m *DeleteUserResponse) GetIsDeleted() bool {
 	return false
 }
 
+type RestoreUserRequest struct {
+	Id                   int32    `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RestoreUserRequest) Reset()         { *m = RestoreUserRequest{} }
+func (m *RestoreUserRequest) String() string { return proto.CompactTextString(m) }
+func (*RestoreUserRequest) ProtoMessage()    {}
+func (*RestoreUserRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_83213d866ee4d08a, []int{10}
+}
+
+func (m *RestoreUserRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RestoreUserRequest.Unmarshal(m, b)
+}
+func (m *RestoreUserRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RestoreUserRequest.Marshal(b, m, deterministic)
+}
+func (m *RestoreUserRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RestoreUserRequest.Merge(m, src)
+}
+func (m *RestoreUserRequest) XXX_Size() int {
+	return xxx_messageInfo_RestoreUserRequest.Size(m)
+}
+func (m *RestoreUserRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RestoreUserRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RestoreUserRequest proto.InternalMessageInfo
+
+func (m *RestoreUserRequest) GetId() int32 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+type RestoreUserResponse struct {
+	IsRestored           bool     `protobuf:"varint,1,opt,name=is_restored,json=isRestored,proto3" json:"is_restored,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RestoreUserResponse) Reset()         { *m = RestoreUserResponse{} }
+func (m *RestoreUserResponse) String() string { return proto.CompactTextString(m) }
+func (*RestoreUserResponse) ProtoMessage()    {}
+func (*RestoreUserResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_83213d866ee4d08a, []int{11}
+}
+
+func (m *RestoreUserResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RestoreUserResponse.Unmarshal(m, b)
+}
+func (m *RestoreUserResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RestoreUserResponse.Marshal(b, m, deterministic)
+}
+func (m *RestoreUserResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RestoreUserResponse.Merge(m, src)
+}
+func (m *RestoreUserResponse) XXX_Size() int {
+	return xxx_messageInfo_RestoreUserResponse.Size(m)
+}
+func (m *RestoreUserResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RestoreUserResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RestoreUserResponse proto.InternalMessageInfo
+
+func (m *RestoreUserResponse) GetIsRestored() bool {
+	if m != nil {
+		return m.IsRestored
+	}
+	return false
+}
+
+type PurgeUserRequest struct {
+	Id                   int32    `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PurgeUserRequest) Reset()         { *m = PurgeUserRequest{} }
+func (m *PurgeUserRequest) String() string { return proto.CompactTextString(m) }
+func (*PurgeUserRequest) ProtoMessage()    {}
+func (*PurgeUserRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_83213d866ee4d08a, []int{12}
+}
+
+func (m *PurgeUserRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PurgeUserRequest.Unmarshal(m, b)
+}
+func (m *PurgeUserRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PurgeUserRequest.Marshal(b, m, deterministic)
+}
+func (m *PurgeUserRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PurgeUserRequest.Merge(m, src)
+}
+func (m *PurgeUserRequest) XXX_Size() int {
+	return xxx_messageInfo_PurgeUserRequest.Size(m)
+}
+func (m *PurgeUserRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_PurgeUserRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PurgeUserRequest proto.InternalMessageInfo
+
+func (m *PurgeUserRequest) GetId() int32 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+type PurgeUserResponse struct {
+	IsPurged             bool     `protobuf:"varint,1,opt,name=is_purged,json=isPurged,proto3" json:"is_purged,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PurgeUserResponse) Reset()         { *m = PurgeUserResponse{} }
+func (m *PurgeUserResponse) String() string { return proto.CompactTextString(m) }
+func (*PurgeUserResponse) ProtoMessage()    {}
+func (*PurgeUserResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_83213d866ee4d08a, []int{13}
+}
+
+func (m *PurgeUserResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PurgeUserResponse.Unmarshal(m, b)
+}
+func (m *PurgeUserResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PurgeUserResponse.Marshal(b, m, deterministic)
+}
+func (m *PurgeUserResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PurgeUserResponse.Merge(m, src)
+}
+func (m *PurgeUserResponse) XXX_Size() int {
+	return xxx_messageInfo_PurgeUserResponse.Size(m)
+}
+func (m *PurgeUserResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_PurgeUserResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PurgeUserResponse proto.InternalMessageInfo
+
+func (m *PurgeUserResponse) GetIsPurged() bool {
+	if m != nil {
+		return m.IsPurged
+	}
+	return false
+}
+
 type ChangePasswordRequest struct {
 	Id                   int32    `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
 	OldPassword          string   `protobuf:"bytes,2,opt,name=old_password,json=oldPassword,proto3" json:"old_password,omitempty"`
@@ -462,7 +769,7 @@ func (m *ChangePasswordRequest) Reset()         { *m = ChangePasswordRequest{} }
 func (m *ChangePasswordRequest) String() string { return proto.CompactTextString(m) }
 func (*ChangePasswordRequest) ProtoMessage()    {}
 func (*ChangePasswordRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_83213d866ee4d08a, []int{9}
+	return fileDescriptor_83213d866ee4d08a, []int{14}
 }
 
 func (m *ChangePasswordRequest) XXX_Unmarshal(b []byte) error {
@@ -522,7 +829,7 @@ func (m *ChangePasswordResponse) Reset()         { *m = ChangePasswordResponse{}
 func (m *ChangePasswordResponse) String() string { return proto.CompactTextString(m) }
 func (*ChangePasswordResponse) ProtoMessage()    {}
 func (*ChangePasswordResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_83213d866ee4d08a, []int{10}
+	return fileDescriptor_83213d866ee4d08a, []int{15}
 }
 
 func (m *ChangePasswordResponse) XXX_Unmarshal(b []byte) error {
@@ -561,7 +868,7 @@ func (m *ToggleAdminRequest) Reset()         { *m = ToggleAdminRequest{} }
 func (m *ToggleAdminRequest) String() string { return proto.CompactTextString(m) }
 func (*ToggleAdminRequest) ProtoMessage()    {}
 func (*ToggleAdminRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_83213d866ee4d08a, []int{11}
+	return fileDescriptor_83213d866ee4d08a, []int{16}
 }
 
 func (m *ToggleAdminRequest) XXX_Unmarshal(b []byte) error {
@@ -600,7 +907,7 @@ func (m *ToggleAdminResponse) Reset()         { *m = ToggleAdminResponse{} }
 func (m *ToggleAdminResponse) String() string { return proto.CompactTextString(m) }
 func (*ToggleAdminResponse) ProtoMessage()    {}
 func (*ToggleAdminResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_83213d866ee4d08a, []int{12}
+	return fileDescriptor_83213d866ee4d08a, []int{17}
 }
 
 func (m *ToggleAdminResponse) XXX_Unmarshal(b []byte) error {
@@ -639,7 +946,7 @@ func (m *ToggleActiveRequest) Reset()         { *m = ToggleActiveRequest{} }
 func (m *ToggleActiveRequest) String() string { return proto.CompactTextString(m) }
 func (*ToggleActiveRequest) ProtoMessage()    {}
 func (*ToggleActiveRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_83213d866ee4d08a, []int{13}
+	return fileDescriptor_83213d866ee4d08a, []int{18}
 }
 
 func (m *ToggleActiveRequest) XXX_Unmarshal(b []byte) error {
@@ -678,7 +985,7 @@ func (m *ToggleActiveResponse) Reset()         { *m = ToggleActiveResponse{} }
 func (m *ToggleActiveResponse) String() string { return proto.CompactTextString(m) }
 func (*ToggleActiveResponse) ProtoMessage()    {}
 func (*ToggleActiveResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_83213d866ee4d08a, []int{14}
+	return fileDescriptor_83213d866ee4d08a, []int{19}
 }
 
 func (m *ToggleActiveResponse) XXX_Unmarshal(b []byte) error {
@@ -706,8 +1013,364 @@ func (m *ToggleActiveResponse) GetIsUpdated() bool {
 	return false
 }
 
+type SetUserFlagsRequest struct {
+	Id                   int32               `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	IsActive             *wrappers.BoolValue `protobuf:"bytes,2,opt,name=is_active,json=isActive,proto3" json:"is_active,omitempty"`
+	IsAdmin              *wrappers.BoolValue `protobuf:"bytes,3,opt,name=is_admin,json=isAdmin,proto3" json:"is_admin,omitempty"`
+	Version              int32               `protobuf:"varint,4,opt,name=version,proto3" json:"version,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
+	XXX_unrecognized     []byte              `json:"-"`
+	XXX_sizecache        int32               `json:"-"`
+}
+
+func (m *SetUserFlagsRequest) Reset()         { *m = SetUserFlagsRequest{} }
+func (m *SetUserFlagsRequest) String() string { return proto.CompactTextString(m) }
+func (*SetUserFlagsRequest) ProtoMessage()    {}
+func (*SetUserFlagsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_83213d866ee4d08a, []int{20}
+}
+
+func (m *SetUserFlagsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SetUserFlagsRequest.Unmarshal(m, b)
+}
+func (m *SetUserFlagsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SetUserFlagsRequest.Marshal(b, m, deterministic)
+}
+func (m *SetUserFlagsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetUserFlagsRequest.Merge(m, src)
+}
+func (m *SetUserFlagsRequest) XXX_Size() int {
+	return xxx_messageInfo_SetUserFlagsRequest.Size(m)
+}
+func (m *SetUserFlagsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetUserFlagsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetUserFlagsRequest proto.InternalMessageInfo
+
+func (m *SetUserFlagsRequest) GetId() int32 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *SetUserFlagsRequest) GetIsActive() *wrappers.BoolValue {
+	if m != nil {
+		return m.IsActive
+	}
+	return nil
+}
+
+func (m *SetUserFlagsRequest) GetIsAdmin() *wrappers.BoolValue {
+	if m != nil {
+		return m.IsAdmin
+	}
+	return nil
+}
+
+func (m *SetUserFlagsRequest) GetVersion() int32 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
+type SetUserFlagsResponse struct {
+	User                 *User    `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SetUserFlagsResponse) Reset()         { *m = SetUserFlagsResponse{} }
+func (m *SetUserFlagsResponse) String() string { return proto.CompactTextString(m) }
+func (*SetUserFlagsResponse) ProtoMessage()    {}
+func (*SetUserFlagsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_83213d866ee4d08a, []int{21}
+}
+
+func (m *SetUserFlagsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SetUserFlagsResponse.Unmarshal(m, b)
+}
+func (m *SetUserFlagsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SetUserFlagsResponse.Marshal(b, m, deterministic)
+}
+func (m *SetUserFlagsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetUserFlagsResponse.Merge(m, src)
+}
+func (m *SetUserFlagsResponse) XXX_Size() int {
+	return xxx_messageInfo_SetUserFlagsResponse.Size(m)
+}
+func (m *SetUserFlagsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetUserFlagsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetUserFlagsResponse proto.InternalMessageInfo
+
+func (m *SetUserFlagsResponse) GetUser() *User {
+	if m != nil {
+		return m.User
+	}
+	return nil
+}
+
+type CreateServiceAccountRequest struct {
+	Username             string   `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
+	Name                 string   `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CreateServiceAccountRequest) Reset()         { *m = CreateServiceAccountRequest{} }
+func (m *CreateServiceAccountRequest) String() string { return proto.CompactTextString(m) }
+func (*CreateServiceAccountRequest) ProtoMessage()    {}
+func (*CreateServiceAccountRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_83213d866ee4d08a, []int{22}
+}
+
+func (m *CreateServiceAccountRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CreateServiceAccountRequest.Unmarshal(m, b)
+}
+func (m *CreateServiceAccountRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CreateServiceAccountRequest.Marshal(b, m, deterministic)
+}
+func (m *CreateServiceAccountRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateServiceAccountRequest.Merge(m, src)
+}
+func (m *CreateServiceAccountRequest) XXX_Size() int {
+	return xxx_messageInfo_CreateServiceAccountRequest.Size(m)
+}
+func (m *CreateServiceAccountRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateServiceAccountRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CreateServiceAccountRequest proto.InternalMessageInfo
+
+func (m *CreateServiceAccountRequest) GetUsername() string {
+	if m != nil {
+		return m.Username
+	}
+	return ""
+}
+
+func (m *CreateServiceAccountRequest) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+type CreateServiceAccountResponse struct {
+	Id                   int32    `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CreateServiceAccountResponse) Reset()         { *m = CreateServiceAccountResponse{} }
+func (m *CreateServiceAccountResponse) String() string { return proto.CompactTextString(m) }
+func (*CreateServiceAccountResponse) ProtoMessage()    {}
+func (*CreateServiceAccountResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_83213d866ee4d08a, []int{23}
+}
+
+func (m *CreateServiceAccountResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CreateServiceAccountResponse.Unmarshal(m, b)
+}
+func (m *CreateServiceAccountResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CreateServiceAccountResponse.Marshal(b, m, deterministic)
+}
+func (m *CreateServiceAccountResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateServiceAccountResponse.Merge(m, src)
+}
+func (m *CreateServiceAccountResponse) XXX_Size() int {
+	return xxx_messageInfo_CreateServiceAccountResponse.Size(m)
+}
+func (m *CreateServiceAccountResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateServiceAccountResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CreateServiceAccountResponse proto.InternalMessageInfo
+
+func (m *CreateServiceAccountResponse) GetId() int32 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+type ListUsersRequest struct {
+	PageSize             int32                   `protobuf:"varint,1,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken            string                  `protobuf:"bytes,2,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	IsActive             *wrappers.BoolValue     `protobuf:"bytes,3,opt,name=is_active,json=isActive,proto3" json:"is_active,omitempty"`
+	IsAdmin              *wrappers.BoolValue     `protobuf:"bytes,4,opt,name=is_admin,json=isAdmin,proto3" json:"is_admin,omitempty"`
+	UsernamePrefix       string                  `protobuf:"bytes,5,opt,name=username_prefix,json=usernamePrefix,proto3" json:"username_prefix,omitempty"`
+	EmailPrefix          string                  `protobuf:"bytes,6,opt,name=email_prefix,json=emailPrefix,proto3" json:"email_prefix,omitempty"`
+	CreatedAfter         *timestamp.Timestamp    `protobuf:"bytes,7,opt,name=created_after,json=createdAfter,proto3" json:"created_after,omitempty"`
+	CreatedBefore        *timestamp.Timestamp    `protobuf:"bytes,8,opt,name=created_before,json=createdBefore,proto3" json:"created_before,omitempty"`
+	SortBy               ListUsersRequest_SortBy `protobuf:"varint,9,opt,name=sort_by,json=sortBy,proto3,enum=ranabd36.qaengine.ListUsersRequest_SortBy" json:"sort_by,omitempty"`
+	Descending           bool                    `protobuf:"varint,10,opt,name=descending,proto3" json:"descending,omitempty"`
+	Deleted              bool                    `protobuf:"varint,11,opt,name=deleted,proto3" json:"deleted,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                `json:"-"`
+	XXX_unrecognized     []byte                  `json:"-"`
+	XXX_sizecache        int32                   `json:"-"`
+}
+
+func (m *ListUsersRequest) Reset()         { *m = ListUsersRequest{} }
+func (m *ListUsersRequest) String() string { return proto.CompactTextString(m) }
+func (*ListUsersRequest) ProtoMessage()    {}
+func (*ListUsersRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_83213d866ee4d08a, []int{24}
+}
+
+func (m *ListUsersRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListUsersRequest.Unmarshal(m, b)
+}
+func (m *ListUsersRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListUsersRequest.Marshal(b, m, deterministic)
+}
+func (m *ListUsersRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListUsersRequest.Merge(m, src)
+}
+func (m *ListUsersRequest) XXX_Size() int {
+	return xxx_messageInfo_ListUsersRequest.Size(m)
+}
+func (m *ListUsersRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListUsersRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListUsersRequest proto.InternalMessageInfo
+
+func (m *ListUsersRequest) GetPageSize() int32 {
+	if m != nil {
+		return m.PageSize
+	}
+	return 0
+}
+
+func (m *ListUsersRequest) GetPageToken() string {
+	if m != nil {
+		return m.PageToken
+	}
+	return ""
+}
+
+func (m *ListUsersRequest) GetIsActive() *wrappers.BoolValue {
+	if m != nil {
+		return m.IsActive
+	}
+	return nil
+}
+
+func (m *ListUsersRequest) GetIsAdmin() *wrappers.BoolValue {
+	if m != nil {
+		return m.IsAdmin
+	}
+	return nil
+}
+
+func (m *ListUsersRequest) GetUsernamePrefix() string {
+	if m != nil {
+		return m.UsernamePrefix
+	}
+	return ""
+}
+
+func (m *ListUsersRequest) GetEmailPrefix() string {
+	if m != nil {
+		return m.EmailPrefix
+	}
+	return ""
+}
+
+func (m *ListUsersRequest) GetCreatedAfter() *timestamp.Timestamp {
+	if m != nil {
+		return m.CreatedAfter
+	}
+	return nil
+}
+
+func (m *ListUsersRequest) GetCreatedBefore() *timestamp.Timestamp {
+	if m != nil {
+		return m.CreatedBefore
+	}
+	return nil
+}
+
+func (m *ListUsersRequest) GetSortBy() ListUsersRequest_SortBy {
+	if m != nil {
+		return m.SortBy
+	}
+	return ListUsersRequest_CREATED_AT
+}
+
+func (m *ListUsersRequest) GetDescending() bool {
+	if m != nil {
+		return m.Descending
+	}
+	return false
+}
+
+func (m *ListUsersRequest) GetDeleted() bool {
+	if m != nil {
+		return m.Deleted
+	}
+	return false
+}
+
+type ListUsersResponse struct {
+	Users                []*User  `protobuf:"bytes,1,rep,name=users,proto3" json:"users,omitempty"`
+	NextPageToken        string   `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListUsersResponse) Reset()         { *m = ListUsersResponse{} }
+func (m *ListUsersResponse) String() string { return proto.CompactTextString(m) }
+func (*ListUsersResponse) ProtoMessage()    {}
+func (*ListUsersResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_83213d866ee4d08a, []int{25}
+}
+
+func (m *ListUsersResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListUsersResponse.Unmarshal(m, b)
+}
+func (m *ListUsersResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListUsersResponse.Marshal(b, m, deterministic)
+}
+func (m *ListUsersResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListUsersResponse.Merge(m, src)
+}
+func (m *ListUsersResponse) XXX_Size() int {
+	return xxx_messageInfo_ListUsersResponse.Size(m)
+}
+func (m *ListUsersResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListUsersResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListUsersResponse proto.InternalMessageInfo
+
+func (m *ListUsersResponse) GetUsers() []*User {
+	if m != nil {
+		return m.Users
+	}
+	return nil
+}
+
+func (m *ListUsersResponse) GetNextPageToken() string {
+	if m != nil {
+		return m.NextPageToken
+	}
+	return ""
+}
+
 func init() {
+	proto.RegisterEnum("ranabd36.qaengine.ListUsersRequest_SortBy", ListUsersRequest_SortBy_name, ListUsersRequest_SortBy_value)
 	proto.RegisterType((*User)(nil), "ranabd36.qaengine.User")
+	proto.RegisterType((*Reputation)(nil), "ranabd36.qaengine.Reputation")
 	proto.RegisterType((*CreateUserRequest)(nil), "ranabd36.qaengine.CreateUserRequest")
 	proto.RegisterType((*CreateUserResponse)(nil), "ranabd36.qaengine.CreateUserResponse")
 	proto.RegisterType((*FindUserRequest)(nil), "ranabd36.qaengine.FindUserRequest")
@@ -716,12 +1379,22 @@ func init() {
 	proto.RegisterType((*UpdateUserResponse)(nil), "ranabd36.qaengine.UpdateUserResponse")
 	proto.RegisterType((*DeleteUserRequest)(nil), "ranabd36.qaengine.DeleteUserRequest")
 	proto.RegisterType((*DeleteUserResponse)(nil), "ranabd36.qaengine.DeleteUserResponse")
+	proto.RegisterType((*RestoreUserRequest)(nil), "ranabd36.qaengine.RestoreUserRequest")
+	proto.RegisterType((*RestoreUserResponse)(nil), "ranabd36.qaengine.RestoreUserResponse")
+	proto.RegisterType((*PurgeUserRequest)(nil), "ranabd36.qaengine.PurgeUserRequest")
+	proto.RegisterType((*PurgeUserResponse)(nil), "ranabd36.qaengine.PurgeUserResponse")
 	proto.RegisterType((*ChangePasswordRequest)(nil), "ranabd36.qaengine.ChangePasswordRequest")
 	proto.RegisterType((*ChangePasswordResponse)(nil), "ranabd36.qaengine.ChangePasswordResponse")
 	proto.RegisterType((*ToggleAdminRequest)(nil), "ranabd36.qaengine.ToggleAdminRequest")
 	proto.RegisterType((*ToggleAdminResponse)(nil), "ranabd36.qaengine.ToggleAdminResponse")
 	proto.RegisterType((*ToggleActiveRequest)(nil), "ranabd36.qaengine.ToggleActiveRequest")
 	proto.RegisterType((*ToggleActiveResponse)(nil), "ranabd36.qaengine.ToggleActiveResponse")
+	proto.RegisterType((*SetUserFlagsRequest)(nil), "ranabd36.qaengine.SetUserFlagsRequest")
+	proto.RegisterType((*SetUserFlagsResponse)(nil), "ranabd36.qaengine.SetUserFlagsResponse")
+	proto.RegisterType((*CreateServiceAccountRequest)(nil), "ranabd36.qaengine.CreateServiceAccountRequest")
+	proto.RegisterType((*CreateServiceAccountResponse)(nil), "ranabd36.qaengine.CreateServiceAccountResponse")
+	proto.RegisterType((*ListUsersRequest)(nil), "ranabd36.qaengine.ListUsersRequest")
+	proto.RegisterType((*ListUsersResponse)(nil), "ranabd36.qaengine.ListUsersResponse")
 }
 
 func init() {
@@ -729,49 +1402,98 @@ func init() {
 }
 
 var fileDescriptor_83213d866ee4d08a = []byte{
-	// 666 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x09, 0x6e, 0x88, 0x02, 0xff, 0x9c, 0x55, 0xe1, 0x4e, 0xd4, 0x4c,
-	0x14, 0xfd, 0xd8, 0x6f, 0x17, 0xba, 0x77, 0x09, 0xba, 0x03, 0x6a, 0xad, 0x31, 0x4a, 0x01, 0xc5,
-	0x98, 0x94, 0x04, 0xd4, 0xc4, 0x5f, 0x8a, 0x18, 0xe3, 0x2f, 0x62, 0x56, 0xf8, 0xa3, 0x89, 0xcd,
-	0xec, 0xce, 0x50, 0x27, 0xd9, 0x6d, 0x4b, 0xdb, 0x85, 0xf8, 0x30, 0x3e, 0x82, 0xaf, 0xe2, 0x33,
-	0x79, 0x67, 0xa6, 0xb3, 0x6d, 0xb7, 0x85, 0x45, 0x7f, 0x10, 0x98, 0xb9, 0xe7, 0x9c, 0xb9, 0x73,
-	0xe7, 0x9c, 0x02, 0xce, 0x34, 0xe5, 0x89, 0x8f, 0x3f, 0x17, 0x62, 0xc4, 0xfd, 0x09, 0x4f, 0x53,
-	0x1a, 0x70, 0x2f, 0x4e, 0xa2, 0x2c, 0x22, 0xfd, 0x84, 0x86, 0x74, 0xc8, 0x0e, 0x5e, 0x79, 0xe7,
-	0x94, 0x87, 0x81, 0x08, 0xb9, 0xf3, 0x28, 0x88, 0xa2, 0x60, 0xcc, 0xf7, 0x14, 0x60, 0x38, 0x3d,
-	0xdb, 0xcb, 0x04, 0x72, 0x32, 0x3a, 0x89, 0x35, 0xc7, 0xfd, 0xdd, 0x82, 0xf6, 0x29, 0xca, 0x91,
-	0x35, 0x68, 0x09, 0x66, 0x2f, 0x3d, 0x5e, 0xda, 0xed, 0x0c, 0xf0, 0x2f, 0xf2, 0x10, 0xe0, 0x4c,
-	0x24, 0x69, 0xe6, 0x87, 0x74, 0xc2, 0xed, 0x16, 0xee, 0x77, 0x07, 0x5d, 0xb5, 0x73, 0x8c, 0x1b,
-	0xe4, 0x01, 0x74, 0xc7, 0xd4, 0x54, 0xff, 0x57, 0x55, 0x4b, 0x6e, 0xa8, 0xa2, 0x03, 0x96, 0x6c,
-	0x53, 0xd5, 0xda, 0xba, 0x66, 0xd6, 0x64, 0x03, 0x3a, 0x7c, 0x42, 0xc5, 0xd8, 0xee, 0xa8, 0x82,
-	0x5e, 0x48, 0x46, 0x4c, 0xd3, 0xf4, 0x32, 0x4a, 0x98, 0xbd, 0xac, 0x19, 0x66, 0x2d, 0x8f, 0x12,
-	0xa9, 0x4f, 0x47, 0x99, 0xb8, 0xe0, 0xf6, 0x0a, 0x16, 0xad, 0x81, 0x25, 0xd2, 0x43, 0xb5, 0x26,
-	0xf7, 0xc1, 0x92, 0x45, 0x36, 0x11, 0xa1, 0x6d, 0xa9, 0xda, 0x0a, 0xd6, 0xe4, 0x92, 0xbc, 0x06,
-	0x18, 0x25, 0x9c, 0x66, 0x9c, 0xf9, 0x34, 0xb3, 0xbb, 0x58, 0xec, 0xed, 0x3b, 0x9e, 0x1e, 0x88,
-	0x67, 0x06, 0xe2, 0x9d, 0x98, 0x81, 0x0c, 0xba, 0x39, 0xfa, 0x30, 0x93, 0xd4, 0x69, 0xcc, 0x0c,
-	0x15, 0x16, 0x53, 0x73, 0xf4, 0x61, 0xe6, 0xbe, 0x85, 0xfe, 0x91, 0xd2, 0x91, 0x53, 0x1d, 0xf0,
-	0xf3, 0x29, 0x42, 0xc8, 0x73, 0x68, 0xcb, 0x01, 0xa8, 0xf1, 0xf6, 0xf6, 0xef, 0x79, 0xb5, 0x87,
-	0xf2, 0x14, 0x5a, 0x81, 0xdc, 0x6d, 0x20, 0x65, 0x85, 0x34, 0x8e, 0xc2, 0x94, 0xcf, 0xbf, 0x8f,
-	0xbb, 0x09, 0xb7, 0x3e, 0x88, 0x90, 0x95, 0x4f, 0x99, 0x87, 0xbc, 0x81, 0xdb, 0x05, 0x24, 0x97,
-	0xf9, 0xab, 0x4e, 0xf0, 0x2e, 0xa7, 0xea, 0x62, 0xff, 0x7c, 0x97, 0x03, 0x20, 0x65, 0x85, 0xbc,
-	0x09, 0xf4, 0x16, 0x3e, 0x5a, 0x3e, 0x33, 0x25, 0x64, 0x0d, 0xf0, 0x8d, 0x35, 0x92, 0xb9, 0x5b,
-	0xd0, 0x7f, 0xcf, 0xc7, 0xbc, 0x7a, 0xec, 0xfc, 0xe5, 0x50, 0xb9, 0x0c, 0xaa, 0x28, 0x33, 0x55,
-	0x28, 0x29, 0x6b, 0x24, 0x73, 0x7f, 0x2e, 0xc1, 0x9d, 0xa3, 0xef, 0x34, 0x0c, 0xf8, 0xa7, 0xdc,
-	0x5d, 0x57, 0xc8, 0x93, 0x4d, 0x58, 0x8d, 0xc6, 0xcc, 0x9f, 0x99, 0x52, 0x07, 0xa0, 0x87, 0x7b,
-	0x86, 0x29, 0x21, 0x21, 0xbf, 0x2c, 0x20, 0x3a, 0x05, 0x3d, 0xdc, 0x9b, 0x41, 0x3c, 0x58, 0x4f,
-	0x78, 0xf6, 0x23, 0xe6, 0x7e, 0x05, 0xa9, 0x33, 0xd1, 0xd7, 0xa5, 0xe3, 0x02, 0xef, 0x7e, 0x84,
-	0xbb, 0xf3, 0xed, 0xe5, 0x17, 0x43, 0x25, 0xbc, 0x98, 0x51, 0xf0, 0x47, 0x0a, 0x65, 0x6e, 0xd8,
-	0x17, 0xa9, 0x21, 0x68, 0x3a, 0x93, 0x26, 0x3a, 0x89, 0x02, 0xb4, 0xab, 0xca, 0xc2, 0x55, 0x43,
-	0x7c, 0x01, 0xeb, 0x15, 0xd4, 0xcd, 0xde, 0x67, 0x67, 0xc6, 0x52, 0x19, 0xbc, 0x4a, 0xfc, 0x25,
-	0x6c, 0x54, 0x61, 0x37, 0x52, 0xdf, 0xff, 0xd5, 0x41, 0xd7, 0xe1, 0x9b, 0x7e, 0xd6, 0xdf, 0x38,
-	0xf9, 0x0b, 0x3f, 0x4f, 0x5f, 0x01, 0x8a, 0x50, 0x90, 0xed, 0x06, 0xd7, 0xd5, 0x52, 0xe7, 0xec,
-	0x2c, 0x40, 0xe9, 0x7e, 0xdc, 0xff, 0xc8, 0x29, 0x58, 0x26, 0x28, 0xc4, 0x6d, 0x20, 0xcd, 0x05,
-	0xcd, 0xd9, 0xba, 0x16, 0x33, 0x93, 0xc5, 0x9e, 0x0b, 0xf3, 0x37, 0xf6, 0x5c, 0x4b, 0x57, 0x63,
-	0xcf, 0xf5, 0x04, 0x69, 0xf1, 0xc2, 0xff, 0x8d, 0xe2, 0xb5, 0x0c, 0x35, 0x8a, 0xd7, 0x43, 0x84,
-	0xe2, 0x01, 0xac, 0x55, 0x7d, 0x48, 0x76, 0x9b, 0x66, 0xd9, 0x94, 0x24, 0xe7, 0xd9, 0x0d, 0x90,
-	0xb3, 0x83, 0xbe, 0x41, 0xaf, 0x64, 0x40, 0xd2, 0xd4, 0x60, 0xdd, 0xc6, 0xce, 0x93, 0x45, 0xb0,
-	0x99, 0x3e, 0x85, 0xd5, 0xb2, 0x07, 0xc9, 0x35, 0xcc, 0xb2, 0x97, 0x9d, 0xa7, 0x0b, 0x71, 0xe6,
-	0x88, 0x77, 0xed, 0x2f, 0xad, 0x78, 0x38, 0x5c, 0x56, 0xff, 0x15, 0x0e, 0xfe, 0x04, 0x00, 0x00,
-	0xff, 0xff, 0x96, 0x8c, 0xff, 0x77, 0xa0, 0x07, 0x00, 0x00,
+	// 1449 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x57, 0xcf, 0x73, 0xd3, 0xd6,
+	0x13, 0xff, 0xca, 0x3f, 0x12, 0x7b, 0xed, 0xf8, 0xc7, 0x4b, 0xbe, 0xa0, 0xaf, 0xf8, 0x02, 0x41,
+	0x24, 0x10, 0x68, 0x31, 0x9d, 0xa4, 0xd0, 0xe1, 0xd0, 0x01, 0x27, 0x98, 0x29, 0x33, 0xc0, 0x64,
+	0x94, 0xc0, 0xa1, 0x17, 0x8d, 0x6c, 0x6d, 0xcc, 0xa3, 0xb2, 0x24, 0xf4, 0xe4, 0x84, 0x30, 0xd3,
+	0x7b, 0x87, 0x99, 0xde, 0x7b, 0xe9, 0xb9, 0xc7, 0x9e, 0xf8, 0x1f, 0xfa, 0x37, 0xf5, 0xd4, 0x79,
+	0x3f, 0x64, 0xcb, 0xb6, 0x6c, 0xa7, 0x9c, 0x9c, 0xb7, 0xfb, 0xd9, 0xdd, 0xb7, 0xfb, 0x56, 0xbb,
+	0x9f, 0x80, 0x31, 0x64, 0x18, 0xd9, 0x0c, 0xa3, 0x53, 0xda, 0x43, 0x7b, 0x80, 0x8c, 0x39, 0x7d,
+	0x6c, 0x85, 0x51, 0x10, 0x07, 0xa4, 0x19, 0x39, 0xbe, 0xd3, 0x75, 0xf7, 0x1e, 0xb6, 0xde, 0x3b,
+	0xe8, 0xf7, 0xa9, 0x8f, 0x46, 0xd3, 0x19, 0xc6, 0x6f, 0xed, 0x30, 0xf0, 0x68, 0xef, 0x5c, 0xa2,
+	0x8c, 0xeb, 0xfd, 0x20, 0xe8, 0x7b, 0x78, 0x5f, 0x9c, 0xba, 0xc3, 0x93, 0xfb, 0x31, 0x1d, 0x20,
+	0x8b, 0x9d, 0x41, 0xa8, 0x00, 0xd7, 0xa6, 0x01, 0x67, 0x91, 0x13, 0x86, 0x18, 0x31, 0xa9, 0x37,
+	0xff, 0x2e, 0x40, 0xe1, 0x35, 0xc3, 0x88, 0xd4, 0x20, 0x47, 0x5d, 0x5d, 0xdb, 0xd4, 0x76, 0x8a,
+	0x56, 0x8e, 0xba, 0xe4, 0x2a, 0xc0, 0x09, 0x8d, 0x58, 0x6c, 0xfb, 0xce, 0x00, 0xf5, 0xdc, 0xa6,
+	0xb6, 0x53, 0xb6, 0xca, 0x42, 0xf2, 0xca, 0x19, 0x20, 0xb9, 0x02, 0x65, 0xcf, 0x49, 0xb4, 0x79,
+	0xa1, 0x2d, 0x79, 0x8e, 0x52, 0x1a, 0x50, 0xe2, 0x99, 0x09, 0x5d, 0x41, 0xea, 0x92, 0x33, 0xd9,
+	0x80, 0x22, 0x0e, 0x1c, 0xea, 0xe9, 0x45, 0xa1, 0x90, 0x07, 0x6e, 0x11, 0x3a, 0x8c, 0x9d, 0x05,
+	0x91, 0xab, 0xaf, 0x48, 0x8b, 0xe4, 0xcc, 0x43, 0x51, 0x66, 0x3b, 0xbd, 0x98, 0x9e, 0xa2, 0xbe,
+	0xba, 0xa9, 0xed, 0x94, 0xac, 0x12, 0x65, 0x6d, 0x71, 0x26, 0xff, 0x83, 0x12, 0x57, 0xba, 0x03,
+	0xea, 0xeb, 0x25, 0xa1, 0x5b, 0xa5, 0xac, 0xcd, 0x8f, 0xe4, 0x11, 0x40, 0x2f, 0x42, 0x27, 0x46,
+	0xd7, 0x76, 0x62, 0xbd, 0xbc, 0xa9, 0xed, 0x54, 0x76, 0x8d, 0x96, 0xac, 0x47, 0x2b, 0xa9, 0x47,
+	0xeb, 0x38, 0x29, 0x98, 0x55, 0x56, 0xe8, 0x76, 0xcc, 0x4d, 0x87, 0xa1, 0x9b, 0x98, 0xc2, 0x72,
+	0x53, 0x85, 0x6e, 0xc7, 0xe4, 0x2e, 0x34, 0x29, 0xb3, 0x45, 0x56, 0xf6, 0x29, 0x46, 0xf4, 0x84,
+	0xa2, 0xab, 0x57, 0xc4, 0xcd, 0xea, 0x94, 0x75, 0xb8, 0xfc, 0x8d, 0x12, 0x93, 0x5b, 0x50, 0xa7,
+	0xcc, 0x8e, 0x83, 0x38, 0xb4, 0xd1, 0x77, 0xba, 0x1e, 0xba, 0x7a, 0x55, 0x20, 0xd7, 0x28, 0x3b,
+	0x0e, 0xe2, 0xb0, 0x23, 0x85, 0xbc, 0x66, 0x51, 0xe0, 0x21, 0xd3, 0xd7, 0x36, 0xf3, 0xbc, 0x66,
+	0xe2, 0x40, 0xbe, 0x06, 0x42, 0xd9, 0xa8, 0x7b, 0x9c, 0x5e, 0x2f, 0x18, 0xfa, 0xb1, 0x5e, 0x13,
+	0x0e, 0x1a, 0x94, 0x1d, 0x49, 0x45, 0x5b, 0xca, 0x79, 0x4a, 0x2e, 0x7a, 0xa8, 0x52, 0xaa, 0x2f,
+	0x4f, 0x49, 0xa1, 0xdb, 0x31, 0xd1, 0x61, 0xf5, 0x14, 0x23, 0x46, 0x03, 0x5f, 0x6f, 0x88, 0xfe,
+	0x48, 0x8e, 0xe4, 0x7b, 0x80, 0x08, 0xc3, 0x61, 0xec, 0xc4, 0x5c, 0xd9, 0x14, 0x4e, 0xaf, 0xb6,
+	0x66, 0x3a, 0xb7, 0x65, 0x8d, 0x40, 0x56, 0xca, 0xc0, 0xfc, 0x43, 0x03, 0x18, 0xab, 0x78, 0x9a,
+	0xac, 0x17, 0x44, 0xa8, 0xba, 0x50, 0x1e, 0xc8, 0x1d, 0x68, 0x0c, 0xc3, 0xd3, 0x20, 0x46, 0x66,
+	0x47, 0xd8, 0x43, 0x7a, 0x8a, 0xae, 0x68, 0xc7, 0xa2, 0x55, 0x57, 0x72, 0x4b, 0x89, 0xc9, 0x3d,
+	0x20, 0x6e, 0x70, 0xe6, 0x4f, 0x81, 0xf3, 0x02, 0xdc, 0x1c, 0x69, 0x46, 0xf0, 0x3b, 0xd0, 0x70,
+	0x7a, 0x3d, 0x0c, 0x45, 0x4d, 0x7c, 0x76, 0x86, 0x11, 0x13, 0xed, 0x5a, 0xb4, 0xea, 0x89, 0xbc,
+	0x2d, 0xc5, 0xe6, 0x13, 0x68, 0x1e, 0x88, 0xee, 0xe0, 0xdf, 0x8a, 0x85, 0xef, 0x87, 0xc8, 0x62,
+	0xf2, 0x15, 0x14, 0x78, 0x5b, 0x8b, 0xeb, 0x56, 0x76, 0x2f, 0x67, 0xe4, 0x2d, 0xd0, 0x02, 0x64,
+	0x6e, 0x01, 0x49, 0x7b, 0x60, 0x61, 0xe0, 0x33, 0x9c, 0xfe, 0xea, 0xcc, 0x1b, 0x50, 0x7f, 0x46,
+	0x7d, 0x37, 0x1d, 0x65, 0x1a, 0xf2, 0x18, 0x1a, 0x63, 0x88, 0x72, 0xf3, 0xaf, 0x6e, 0xf2, 0x04,
+	0x9a, 0xaf, 0x45, 0xbb, 0x7e, 0x71, 0x2e, 0x7b, 0x40, 0xd2, 0x1e, 0xd4, 0x25, 0xae, 0x02, 0x50,
+	0x66, 0xab, 0x2f, 0x41, 0x38, 0x2a, 0x59, 0x65, 0xca, 0x24, 0xd2, 0x35, 0x6f, 0x42, 0xf3, 0xa9,
+	0x68, 0xa9, 0x45, 0xc9, 0xed, 0x01, 0x49, 0x83, 0x26, 0x3c, 0xab, 0x86, 0x1c, 0x7b, 0x96, 0x48,
+	0x97, 0x97, 0xd6, 0x42, 0x16, 0x07, 0xd1, 0x42, 0xd7, 0x0f, 0x61, 0x7d, 0x02, 0xa5, 0x7c, 0x5f,
+	0x87, 0x0a, 0xe5, 0xcd, 0x22, 0x34, 0x89, 0x73, 0xa0, 0x4c, 0x61, 0x5d, 0xd3, 0x84, 0xc6, 0xe1,
+	0x30, 0xea, 0x2f, 0xf4, 0xfd, 0x0d, 0x34, 0x53, 0x18, 0xe5, 0x59, 0xce, 0xad, 0x90, 0xcb, 0x13,
+	0xbf, 0x25, 0xca, 0x04, 0xce, 0x35, 0x7f, 0xd7, 0xe0, 0xbf, 0x07, 0x6f, 0x1d, 0xbf, 0x8f, 0x87,
+	0x6a, 0xce, 0xcd, 0xf1, 0x4d, 0x6e, 0x40, 0x35, 0xf0, 0x5c, 0x7b, 0x34, 0x1e, 0xe5, 0x28, 0xae,
+	0x04, 0x9e, 0x9b, 0x58, 0x72, 0x88, 0x8f, 0x67, 0x63, 0x88, 0x9c, 0xc7, 0x15, 0x1f, 0xcf, 0x46,
+	0x90, 0x16, 0xac, 0x47, 0x18, 0x9f, 0x87, 0x68, 0x4f, 0x20, 0xe5, 0x74, 0x6e, 0x4a, 0xd5, 0xab,
+	0x31, 0xde, 0xfc, 0x01, 0x2e, 0x4d, 0x5f, 0x4f, 0xa5, 0xd5, 0x82, 0x75, 0x9e, 0x96, 0x12, 0xdb,
+	0x3d, 0x81, 0x4a, 0x12, 0x6c, 0x52, 0x96, 0x18, 0x48, 0x73, 0xf1, 0x3a, 0xc7, 0x41, 0xbf, 0xef,
+	0xa1, 0x98, 0xca, 0xf3, 0x2a, 0xf8, 0x2d, 0xac, 0x4f, 0xa0, 0x2e, 0xd6, 0x53, 0xdb, 0x23, 0x2b,
+	0xb1, 0x0d, 0xe6, 0x39, 0x7f, 0x00, 0x1b, 0x93, 0xb0, 0x8b, 0x79, 0xff, 0x53, 0x83, 0xf5, 0x23,
+	0x8c, 0xf9, 0xa3, 0x3e, 0xf3, 0x9c, 0x3e, 0x9b, 0xf7, 0x42, 0xdf, 0xa5, 0x17, 0x54, 0x6e, 0xce,
+	0x64, 0xdd, 0x0f, 0x02, 0xef, 0x8d, 0xe3, 0x0d, 0x31, 0xb5, 0xbc, 0x1e, 0xa4, 0x96, 0x57, 0x7e,
+	0xa9, 0xdd, 0x68, 0xb1, 0xa5, 0xe6, 0x71, 0x61, 0x62, 0x1e, 0x9b, 0x07, 0xb0, 0x31, 0x79, 0xe1,
+	0x2f, 0x99, 0x0f, 0x2f, 0xe1, 0x8a, 0x9c, 0x54, 0x93, 0x1b, 0x24, 0xc9, 0x3e, 0xbd, 0xdc, 0xb5,
+	0xa9, 0xe5, 0x4e, 0xa0, 0x90, 0xa2, 0x0b, 0xe2, 0x6f, 0xb3, 0x05, 0xff, 0xcf, 0x76, 0x37, 0x67,
+	0x04, 0xfe, 0x55, 0x80, 0xc6, 0x0b, 0xca, 0x44, 0x16, 0xa3, 0x92, 0x5f, 0x81, 0x72, 0xe8, 0xf4,
+	0xd1, 0x66, 0xf4, 0x63, 0xb2, 0x1e, 0x4a, 0x5c, 0x70, 0x44, 0x3f, 0x8a, 0x67, 0x14, 0xca, 0x38,
+	0xf8, 0x09, 0xfd, 0x84, 0xaa, 0x70, 0xc9, 0x31, 0x17, 0x4c, 0x3e, 0x4f, 0xfe, 0x0b, 0x9f, 0xa7,
+	0x70, 0xf1, 0xe7, 0xb9, 0x0d, 0xf5, 0xa4, 0x20, 0x76, 0x18, 0xe1, 0x09, 0xfd, 0xa0, 0xb8, 0x4e,
+	0x2d, 0x11, 0x1f, 0x0a, 0x29, 0xff, 0x6c, 0x25, 0x4f, 0x50, 0x28, 0x49, 0x7c, 0x2a, 0x42, 0xa6,
+	0x20, 0x8f, 0x61, 0x6d, 0xc4, 0x61, 0x4e, 0x62, 0x8c, 0xf4, 0xd5, 0x39, 0xf7, 0x18, 0x2f, 0xee,
+	0x6a, 0x42, 0x63, 0x38, 0x9e, 0xb4, 0xa1, 0x96, 0x38, 0xe8, 0xe2, 0x09, 0x5f, 0xae, 0xa5, 0xa5,
+	0x1e, 0x92, 0x90, 0xfb, 0xc2, 0x80, 0x1c, 0xc0, 0x2a, 0x0b, 0xa2, 0xd8, 0xee, 0x9e, 0x0b, 0x12,
+	0x55, 0xdb, 0xbd, 0x9b, 0xd1, 0x3f, 0xd3, 0x2f, 0xd6, 0x3a, 0x0a, 0xa2, 0x78, 0xff, 0xdc, 0x5a,
+	0x61, 0xe2, 0x97, 0x5c, 0xe3, 0xf4, 0x83, 0xf5, 0xd0, 0x77, 0xa9, 0xdf, 0x17, 0x8c, 0xaa, 0x64,
+	0xa5, 0x24, 0xbc, 0xa7, 0x93, 0xf9, 0x2e, 0xc9, 0x52, 0x72, 0x34, 0x1f, 0xc1, 0x8a, 0xf4, 0x45,
+	0x6a, 0x00, 0x07, 0x56, 0xa7, 0x7d, 0xdc, 0x79, 0x6a, 0xb7, 0x8f, 0x1b, 0xff, 0x21, 0x55, 0x28,
+	0xbd, 0x3e, 0xea, 0x58, 0xaf, 0xda, 0x2f, 0x3b, 0x0d, 0x8d, 0x94, 0xa1, 0xd8, 0x79, 0xd9, 0x7e,
+	0xfe, 0xa2, 0x91, 0x23, 0x2b, 0x90, 0x7b, 0xfe, 0xb4, 0x91, 0x37, 0xdf, 0x41, 0x33, 0x75, 0x2f,
+	0xd5, 0x6f, 0xf7, 0xa0, 0xc8, 0xdf, 0x81, 0xe9, 0xda, 0x66, 0x7e, 0xd1, 0xc7, 0x20, 0x51, 0x9c,
+	0xa3, 0xf9, 0xf8, 0x21, 0xb6, 0x67, 0x3a, 0x6c, 0x8d, 0x8b, 0x0f, 0x93, 0x2e, 0xdb, 0xfd, 0xad,
+	0x02, 0x4d, 0x6e, 0xa7, 0xba, 0x9c, 0xff, 0x60, 0x44, 0x02, 0x80, 0xf1, 0xd6, 0x27, 0x5b, 0x19,
+	0xb1, 0x66, 0x68, 0x85, 0xb1, 0xbd, 0x04, 0x25, 0xf3, 0x30, 0x37, 0x3e, 0x7d, 0xd6, 0x1b, 0xa4,
+	0x2a, 0x6e, 0xd9, 0x1a, 0x38, 0xbe, 0xd3, 0x47, 0x5d, 0x23, 0x14, 0x4a, 0x09, 0x3b, 0x20, 0x66,
+	0x86, 0xa3, 0x29, 0x76, 0x61, 0xdc, 0x5c, 0x88, 0x99, 0x08, 0x55, 0x53, 0x14, 0x53, 0x05, 0x23,
+	0xef, 0x01, 0xc6, 0x2c, 0x20, 0x33, 0xb7, 0x19, 0x9a, 0x61, 0x6c, 0x2f, 0x41, 0xa9, 0x80, 0x97,
+	0x3e, 0x7d, 0xd6, 0xc9, 0x74, 0x40, 0x5d, 0xe3, 0xe5, 0x1c, 0xd3, 0x83, 0xcc, 0x90, 0x33, 0x14,
+	0xc3, 0xd8, 0x5e, 0x82, 0x5a, 0x58, 0xce, 0x08, 0x2a, 0x29, 0xd2, 0x40, 0xb6, 0x33, 0xb9, 0xed,
+	0x34, 0xf5, 0x30, 0x6e, 0x2d, 0x83, 0x2d, 0x8c, 0xe9, 0x41, 0x79, 0x44, 0x26, 0x48, 0xd6, 0xfb,
+	0x4c, 0xd3, 0x11, 0x63, 0x6b, 0x31, 0x68, 0x61, 0xb4, 0x9f, 0xa1, 0x36, 0xb9, 0xe8, 0xc9, 0x4e,
+	0x56, 0xff, 0x65, 0x51, 0x15, 0xe3, 0xce, 0x05, 0x90, 0x4b, 0x5e, 0x74, 0x08, 0x95, 0xd4, 0xde,
+	0xcf, 0x2c, 0xf0, 0x2c, 0x7b, 0x30, 0x6e, 0x2d, 0x83, 0xa9, 0xa8, 0x97, 0x7f, 0xc9, 0x69, 0x32,
+	0x6b, 0xf1, 0x2f, 0xd3, 0x38, 0xec, 0x07, 0xa8, 0xa6, 0x19, 0x01, 0x59, 0xe0, 0x30, 0xcd, 0x2c,
+	0x8c, 0xdb, 0x4b, 0x71, 0x33, 0x91, 0xa7, 0xea, 0x3d, 0x84, 0x6a, 0x7a, 0x45, 0x67, 0x46, 0xce,
+	0x20, 0x1d, 0xc6, 0xed, 0xa5, 0xb8, 0x85, 0xcf, 0xfc, 0x0e, 0xca, 0xa3, 0x51, 0x98, 0xd9, 0x54,
+	0xd3, 0x03, 0xdc, 0xd8, 0x5a, 0x0c, 0x52, 0xd1, 0xc8, 0xa7, 0xcf, 0x7a, 0x6d, 0x32, 0x1a, 0xf9,
+	0x55, 0x83, 0x8d, 0xac, 0x95, 0x4f, 0x5a, 0x73, 0x27, 0x5b, 0x26, 0xd5, 0x30, 0xee, 0x5f, 0x18,
+	0xbf, 0x28, 0xf7, 0xfd, 0xc2, 0x8f, 0xb9, 0xb0, 0xdb, 0x5d, 0x11, 0x9b, 0x6e, 0xef, 0x9f, 0x01,
+	0x00, 0xc7, 0x12, 0x22, 0x74, 0x75, 0x11, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -790,9 +1512,20 @@ type UserServiceServerClient interface {
 	FindUser(ctx context.Context, in *FindUserRequest, opts ...grpc.CallOption) (*FindUserResponse, error)
 	UpdateUser(ctx context.Context, in *UpdateUserRequest, opts ...grpc.CallOption) (*UpdateUserResponse, error)
 	DeleteUser(ctx context.Context, in *DeleteUserRequest, opts ...grpc.CallOption) (*DeleteUserResponse, error)
+	RestoreUser(ctx context.Context, in *RestoreUserRequest, opts ...grpc.CallOption) (*RestoreUserResponse, error)
+	PurgeUser(ctx context.Context, in *PurgeUserRequest, opts ...grpc.CallOption) (*PurgeUserResponse, error)
 	ChangePassword(ctx context.Context, in *ChangePasswordRequest, opts ...grpc.CallOption) (*ChangePasswordResponse, error)
+	// Deprecated: retried or concurrent calls flip the flag back, use SetUserFlags.
+	//
+	// Deprecated: Do not use.
 	ToggleAdmin(ctx context.Context, in *ToggleAdminRequest, opts ...grpc.CallOption) (*ToggleAdminResponse, error)
+	// Deprecated: retried or concurrent calls flip the flag back, use SetUserFlags.
+	//
+	// Deprecated: Do not use.
 	ToggleActive(ctx context.Context, in *ToggleActiveRequest, opts ...grpc.CallOption) (*ToggleActiveResponse, error)
+	SetUserFlags(ctx context.Context, in *SetUserFlagsRequest, opts ...grpc.CallOption) (*SetUserFlagsResponse, error)
+	ListUsers(ctx context.Context, in *ListUsersRequest, opts ...grpc.CallOption) (*ListUsersResponse, error)
+	CreateServiceAccount(ctx context.Context, in *CreateServiceAccountRequest, opts ...grpc.CallOption) (*CreateServiceAccountResponse, error)
 }
 
 type userServiceServerClient struct {
@@ -839,6 +1572,24 @@ func (c *userServiceServerClient) DeleteUser(ctx context.Context, in *DeleteUser
 	return out, nil
 }
 
+func (c *userServiceServerClient) RestoreUser(ctx context.Context, in *RestoreUserRequest, opts ...grpc.CallOption) (*RestoreUserResponse, error) {
+	out := new(RestoreUserResponse)
+	err := c.cc.Invoke(ctx, "/ranabd36.qaengine.UserServiceServer/RestoreUser", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceServerClient) PurgeUser(ctx context.Context, in *PurgeUserRequest, opts ...grpc.CallOption) (*PurgeUserResponse, error) {
+	out := new(PurgeUserResponse)
+	err := c.cc.Invoke(ctx, "/ranabd36.qaengine.UserServiceServer/PurgeUser", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *userServiceServerClient) ChangePassword(ctx context.Context, in *ChangePasswordRequest, opts ...grpc.CallOption) (*ChangePasswordResponse, error) {
 	out := new(ChangePasswordResponse)
 	err := c.cc.Invoke(ctx, "/ranabd36.qaengine.UserServiceServer/ChangePassword", in, out, opts...)
@@ -848,6 +1599,7 @@ func (c *userServiceServerClient) ChangePassword(ctx context.Context, in *Change
 	return out, nil
 }
 
+// Deprecated: Do not use.
 func (c *userServiceServerClient) ToggleAdmin(ctx context.Context, in *ToggleAdminRequest, opts ...grpc.CallOption) (*ToggleAdminResponse, error) {
 	out := new(ToggleAdminResponse)
 	err := c.cc.Invoke(ctx, "/ranabd36.qaengine.UserServiceServer/ToggleAdmin", in, out, opts...)
@@ -857,6 +1609,7 @@ func (c *userServiceServerClient) ToggleAdmin(ctx context.Context, in *ToggleAdm
 	return out, nil
 }
 
+// Deprecated: Do not use.
 func (c *userServiceServerClient) ToggleActive(ctx context.Context, in *ToggleActiveRequest, opts ...grpc.CallOption) (*ToggleActiveResponse, error) {
 	out := new(ToggleActiveResponse)
 	err := c.cc.Invoke(ctx, "/ranabd36.qaengine.UserServiceServer/ToggleActive", in, out, opts...)
@@ -866,15 +1619,53 @@ func (c *userServiceServerClient) ToggleActive(ctx context.Context, in *ToggleAc
 	return out, nil
 }
 
+func (c *userServiceServerClient) SetUserFlags(ctx context.Context, in *SetUserFlagsRequest, opts ...grpc.CallOption) (*SetUserFlagsResponse, error) {
+	out := new(SetUserFlagsResponse)
+	err := c.cc.Invoke(ctx, "/ranabd36.qaengine.UserServiceServer/SetUserFlags", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceServerClient) ListUsers(ctx context.Context, in *ListUsersRequest, opts ...grpc.CallOption) (*ListUsersResponse, error) {
+	out := new(ListUsersResponse)
+	err := c.cc.Invoke(ctx, "/ranabd36.qaengine.UserServiceServer/ListUsers", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceServerClient) CreateServiceAccount(ctx context.Context, in *CreateServiceAccountRequest, opts ...grpc.CallOption) (*CreateServiceAccountResponse, error) {
+	out := new(CreateServiceAccountResponse)
+	err := c.cc.Invoke(ctx, "/ranabd36.qaengine.UserServiceServer/CreateServiceAccount", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // UserServiceServerServer is the server API for UserServiceServer service.
 type UserServiceServerServer interface {
 	CreateUser(context.Context, *CreateUserRequest) (*CreateUserResponse, error)
 	FindUser(context.Context, *FindUserRequest) (*FindUserResponse, error)
 	UpdateUser(context.Context, *UpdateUserRequest) (*UpdateUserResponse, error)
 	DeleteUser(context.Context, *DeleteUserRequest) (*DeleteUserResponse, error)
+	RestoreUser(context.Context, *RestoreUserRequest) (*RestoreUserResponse, error)
+	PurgeUser(context.Context, *PurgeUserRequest) (*PurgeUserResponse, error)
 	ChangePassword(context.Context, *ChangePasswordRequest) (*ChangePasswordResponse, error)
+	// Deprecated: retried or concurrent calls flip the flag back, use SetUserFlags.
+	//
+	// Deprecated: Do not use.
 	ToggleAdmin(context.Context, *ToggleAdminRequest) (*ToggleAdminResponse, error)
+	// Deprecated: retried or concurrent calls flip the flag back, use SetUserFlags.
+	//
+	// Deprecated: Do not use.
 	ToggleActive(context.Context, *ToggleActiveRequest) (*ToggleActiveResponse, error)
+	SetUserFlags(context.Context, *SetUserFlagsRequest) (*SetUserFlagsResponse, error)
+	ListUsers(context.Context, *ListUsersRequest) (*ListUsersResponse, error)
+	CreateServiceAccount(context.Context, *CreateServiceAccountRequest) (*CreateServiceAccountResponse, error)
 }
 
 // UnimplementedUserServiceServerServer can be embedded to have forward compatible implementations.
@@ -893,6 +1684,12 @@ func (*UnimplementedUserServiceServerServer) UpdateUser(ctx context.Context, req
 func (*UnimplementedUserServiceServerServer) DeleteUser(ctx context.Context, req *DeleteUserRequest) (*DeleteUserResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteUser not implemented")
 }
+func (*UnimplementedUserServiceServerServer) RestoreUser(ctx context.Context, req *RestoreUserRequest) (*RestoreUserResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreUser not implemented")
+}
+func (*UnimplementedUserServiceServerServer) PurgeUser(ctx context.Context, req *PurgeUserRequest) (*PurgeUserResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PurgeUser not implemented")
+}
 func (*UnimplementedUserServiceServerServer) ChangePassword(ctx context.Context, req *ChangePasswordRequest) (*ChangePasswordResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ChangePassword not implemented")
 }
@@ -902,6 +1699,15 @@ func (*UnimplementedUserServiceServerServer) ToggleAdmin(ctx context.Context, re
 func (*UnimplementedUserServiceServerServer) ToggleActive(ctx context.Context, req *ToggleActiveRequest) (*ToggleActiveResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ToggleActive not implemented")
 }
+func (*UnimplementedUserServiceServerServer) SetUserFlags(ctx context.Context, req *SetUserFlagsRequest) (*SetUserFlagsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetUserFlags not implemented")
+}
+func (*UnimplementedUserServiceServerServer) ListUsers(ctx context.Context, req *ListUsersRequest) (*ListUsersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListUsers not implemented")
+}
+func (*UnimplementedUserServiceServerServer) CreateServiceAccount(ctx context.Context, req *CreateServiceAccountRequest) (*CreateServiceAccountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateServiceAccount not implemented")
+}
 
 func RegisterUserServiceServerServer(s *grpc.Server, srv UserServiceServerServer) {
 	s.RegisterService(&_UserServiceServer_serviceDesc, srv)
@@ -979,6 +1785,42 @@ func _UserServiceServer_DeleteUser_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _UserServiceServer_RestoreUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServerServer).RestoreUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ranabd36.qaengine.UserServiceServer/RestoreUser",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServerServer).RestoreUser(ctx, req.(*RestoreUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserServiceServer_PurgeUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PurgeUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServerServer).PurgeUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ranabd36.qaengine.UserServiceServer/PurgeUser",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServerServer).PurgeUser(ctx, req.(*PurgeUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _UserServiceServer_ChangePassword_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ChangePasswordRequest)
 	if err := dec(in); err != nil {
@@ -1033,6 +1875,60 @@ func _UserServiceServer_ToggleActive_Handler(srv interface{}, ctx context.Contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _UserServiceServer_SetUserFlags_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetUserFlagsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServerServer).SetUserFlags(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ranabd36.qaengine.UserServiceServer/SetUserFlags",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServerServer).SetUserFlags(ctx, req.(*SetUserFlagsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserServiceServer_ListUsers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListUsersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServerServer).ListUsers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ranabd36.qaengine.UserServiceServer/ListUsers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServerServer).ListUsers(ctx, req.(*ListUsersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserServiceServer_CreateServiceAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateServiceAccountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServerServer).CreateServiceAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ranabd36.qaengine.UserServiceServer/CreateServiceAccount",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServerServer).CreateServiceAccount(ctx, req.(*CreateServiceAccountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _UserServiceServer_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "ranabd36.qaengine.UserServiceServer",
 	HandlerType: (*UserServiceServerServer)(nil),
@@ -1053,6 +1949,14 @@ var _UserServiceServer_serviceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteUser",
 			Handler:    _UserServiceServer_DeleteUser_Handler,
 		},
+		{
+			MethodName: "RestoreUser",
+			Handler:    _UserServiceServer_RestoreUser_Handler,
+		},
+		{
+			MethodName: "PurgeUser",
+			Handler:    _UserServiceServer_PurgeUser_Handler,
+		},
 		{
 			MethodName: "ChangePassword",
 			Handler:    _UserServiceServer_ChangePassword_Handler,
@@ -1065,6 +1969,18 @@ var _UserServiceServer_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ToggleActive",
 			Handler:    _UserServiceServer_ToggleActive_Handler,
 		},
+		{
+			MethodName: "SetUserFlags",
+			Handler:    _UserServiceServer_SetUserFlags_Handler,
+		},
+		{
+			MethodName: "ListUsers",
+			Handler:    _UserServiceServer_ListUsers_Handler,
+		},
+		{
+			MethodName: "CreateServiceAccount",
+			Handler:    _UserServiceServer_CreateServiceAccount_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "user_service_message.proto",
@@ -0,0 +1,415 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: vote_service_message.proto
+
+package pb
+
+import (
+	context "context"
+	fmt "fmt"
+	proto "github.com/golang/protobuf/proto"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	math "math"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
+
+type VoteTarget int32
+
+const (
+	VoteTarget_QUESTION VoteTarget = 0
+	VoteTarget_ANSWER   VoteTarget = 1
+)
+
+var VoteTarget_name = map[int32]string{
+	0: "QUESTION",
+	1: "ANSWER",
+}
+
+var VoteTarget_value = map[string]int32{
+	"QUESTION": 0,
+	"ANSWER":   1,
+}
+
+func (x VoteTarget) String() string {
+	return proto.EnumName(VoteTarget_name, int32(x))
+}
+
+func (VoteTarget) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_5d2f146974e13159, []int{0}
+}
+
+type VoteRequest_Direction int32
+
+const (
+	VoteRequest_DIRECTION_UNSPECIFIED VoteRequest_Direction = 0
+	VoteRequest_UP                    VoteRequest_Direction = 1
+	VoteRequest_DOWN                  VoteRequest_Direction = 2
+)
+
+var VoteRequest_Direction_name = map[int32]string{
+	0: "DIRECTION_UNSPECIFIED",
+	1: "UP",
+	2: "DOWN",
+}
+
+var VoteRequest_Direction_value = map[string]int32{
+	"DIRECTION_UNSPECIFIED": 0,
+	"UP":                    1,
+	"DOWN":                  2,
+}
+
+func (x VoteRequest_Direction) String() string {
+	return proto.EnumName(VoteRequest_Direction_name, int32(x))
+}
+
+func (VoteRequest_Direction) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_5d2f146974e13159, []int{0, 0}
+}
+
+type VoteRequest struct {
+	Target               VoteTarget            `protobuf:"varint,1,opt,name=target,proto3,enum=ranabd36.qaengine.VoteTarget" json:"target,omitempty"`
+	TargetId             int32                 `protobuf:"varint,2,opt,name=target_id,json=targetId,proto3" json:"target_id,omitempty"`
+	Direction            VoteRequest_Direction `protobuf:"varint,3,opt,name=direction,proto3,enum=ranabd36.qaengine.VoteRequest_Direction" json:"direction,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *VoteRequest) Reset()         { *m = VoteRequest{} }
+func (m *VoteRequest) String() string { return proto.CompactTextString(m) }
+func (*VoteRequest) ProtoMessage()    {}
+func (*VoteRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5d2f146974e13159, []int{0}
+}
+
+func (m *VoteRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_VoteRequest.Unmarshal(m, b)
+}
+func (m *VoteRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_VoteRequest.Marshal(b, m, deterministic)
+}
+func (m *VoteRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VoteRequest.Merge(m, src)
+}
+func (m *VoteRequest) XXX_Size() int {
+	return xxx_messageInfo_VoteRequest.Size(m)
+}
+func (m *VoteRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_VoteRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VoteRequest proto.InternalMessageInfo
+
+func (m *VoteRequest) GetTarget() VoteTarget {
+	if m != nil {
+		return m.Target
+	}
+	return VoteTarget_QUESTION
+}
+
+func (m *VoteRequest) GetTargetId() int32 {
+	if m != nil {
+		return m.TargetId
+	}
+	return 0
+}
+
+func (m *VoteRequest) GetDirection() VoteRequest_Direction {
+	if m != nil {
+		return m.Direction
+	}
+	return VoteRequest_DIRECTION_UNSPECIFIED
+}
+
+type VoteResponse struct {
+	Score                int32    `protobuf:"varint,1,opt,name=score,proto3" json:"score,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *VoteResponse) Reset()         { *m = VoteResponse{} }
+func (m *VoteResponse) String() string { return proto.CompactTextString(m) }
+func (*VoteResponse) ProtoMessage()    {}
+func (*VoteResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5d2f146974e13159, []int{1}
+}
+
+func (m *VoteResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_VoteResponse.Unmarshal(m, b)
+}
+func (m *VoteResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_VoteResponse.Marshal(b, m, deterministic)
+}
+func (m *VoteResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VoteResponse.Merge(m, src)
+}
+func (m *VoteResponse) XXX_Size() int {
+	return xxx_messageInfo_VoteResponse.Size(m)
+}
+func (m *VoteResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_VoteResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VoteResponse proto.InternalMessageInfo
+
+func (m *VoteResponse) GetScore() int32 {
+	if m != nil {
+		return m.Score
+	}
+	return 0
+}
+
+type RetractVoteRequest struct {
+	Target               VoteTarget `protobuf:"varint,1,opt,name=target,proto3,enum=ranabd36.qaengine.VoteTarget" json:"target,omitempty"`
+	TargetId             int32      `protobuf:"varint,2,opt,name=target_id,json=targetId,proto3" json:"target_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
+	XXX_unrecognized     []byte     `json:"-"`
+	XXX_sizecache        int32      `json:"-"`
+}
+
+func (m *RetractVoteRequest) Reset()         { *m = RetractVoteRequest{} }
+func (m *RetractVoteRequest) String() string { return proto.CompactTextString(m) }
+func (*RetractVoteRequest) ProtoMessage()    {}
+func (*RetractVoteRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5d2f146974e13159, []int{2}
+}
+
+func (m *RetractVoteRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RetractVoteRequest.Unmarshal(m, b)
+}
+func (m *RetractVoteRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RetractVoteRequest.Marshal(b, m, deterministic)
+}
+func (m *RetractVoteRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RetractVoteRequest.Merge(m, src)
+}
+func (m *RetractVoteRequest) XXX_Size() int {
+	return xxx_messageInfo_RetractVoteRequest.Size(m)
+}
+func (m *RetractVoteRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RetractVoteRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RetractVoteRequest proto.InternalMessageInfo
+
+func (m *RetractVoteRequest) GetTarget() VoteTarget {
+	if m != nil {
+		return m.Target
+	}
+	return VoteTarget_QUESTION
+}
+
+func (m *RetractVoteRequest) GetTargetId() int32 {
+	if m != nil {
+		return m.TargetId
+	}
+	return 0
+}
+
+type RetractVoteResponse struct {
+	Score                int32    `protobuf:"varint,1,opt,name=score,proto3" json:"score,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RetractVoteResponse) Reset()         { *m = RetractVoteResponse{} }
+func (m *RetractVoteResponse) String() string { return proto.CompactTextString(m) }
+func (*RetractVoteResponse) ProtoMessage()    {}
+func (*RetractVoteResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5d2f146974e13159, []int{3}
+}
+
+func (m *RetractVoteResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RetractVoteResponse.Unmarshal(m, b)
+}
+func (m *RetractVoteResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RetractVoteResponse.Marshal(b, m, deterministic)
+}
+func (m *RetractVoteResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RetractVoteResponse.Merge(m, src)
+}
+func (m *RetractVoteResponse) XXX_Size() int {
+	return xxx_messageInfo_RetractVoteResponse.Size(m)
+}
+func (m *RetractVoteResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RetractVoteResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RetractVoteResponse proto.InternalMessageInfo
+
+func (m *RetractVoteResponse) GetScore() int32 {
+	if m != nil {
+		return m.Score
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterEnum("ranabd36.qaengine.VoteTarget", VoteTarget_name, VoteTarget_value)
+	proto.RegisterEnum("ranabd36.qaengine.VoteRequest_Direction", VoteRequest_Direction_name, VoteRequest_Direction_value)
+	proto.RegisterType((*VoteRequest)(nil), "ranabd36.qaengine.VoteRequest")
+	proto.RegisterType((*VoteResponse)(nil), "ranabd36.qaengine.VoteResponse")
+	proto.RegisterType((*RetractVoteRequest)(nil), "ranabd36.qaengine.RetractVoteRequest")
+	proto.RegisterType((*RetractVoteResponse)(nil), "ranabd36.qaengine.RetractVoteResponse")
+}
+
+func init() {
+	proto.RegisterFile("vote_service_message.proto", fileDescriptor_5d2f146974e13159)
+}
+
+var fileDescriptor_5d2f146974e13159 = []byte{
+	// 376 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x92, 0x41, 0xaf, 0xd2, 0x40,
+	0x10, 0xc7, 0x69, 0x85, 0x06, 0x06, 0x62, 0xca, 0xa8, 0x49, 0xad, 0x51, 0x49, 0xa3, 0x84, 0x68,
+	0xd2, 0x03, 0x44, 0xe3, 0x55, 0x69, 0x49, 0x7a, 0x29, 0xb8, 0x05, 0x89, 0x5e, 0x9a, 0xa5, 0xdd,
+	0x40, 0x13, 0xed, 0x96, 0xee, 0x42, 0xe2, 0x57, 0xf0, 0xfb, 0xf8, 0x5d, 0xbc, 0xfb, 0x45, 0x4c,
+	0x5b, 0x7c, 0xbc, 0x17, 0x1e, 0xbc, 0xd3, 0xbb, 0xed, 0xcc, 0xfe, 0xf7, 0x37, 0xff, 0x99, 0x1d,
+	0x30, 0xf7, 0x5c, 0xb2, 0x50, 0xb0, 0x7c, 0x9f, 0x44, 0x2c, 0xfc, 0xc1, 0x84, 0xa0, 0x6b, 0x66,
+	0x67, 0x39, 0x97, 0x1c, 0xbb, 0x39, 0x4d, 0xe9, 0x2a, 0x1e, 0xbd, 0xb7, 0xb7, 0x94, 0xa5, 0xeb,
+	0x24, 0x65, 0x66, 0x97, 0xee, 0xe4, 0x26, 0xcc, 0xf8, 0xf7, 0x24, 0xfa, 0x59, 0xa9, 0xac, 0xbf,
+	0x0a, 0xb4, 0xbf, 0x70, 0xc9, 0x08, 0xdb, 0xee, 0x98, 0x90, 0xf8, 0x0e, 0x34, 0x49, 0xf3, 0x35,
+	0x93, 0x86, 0xd2, 0x53, 0x06, 0x0f, 0x87, 0xcf, 0xed, 0x13, 0x8c, 0x5d, 0xe8, 0xe7, 0xa5, 0x88,
+	0x1c, 0xc4, 0xf8, 0x0c, 0x5a, 0xd5, 0x29, 0x4c, 0x62, 0x43, 0xed, 0x29, 0x83, 0x06, 0x69, 0x56,
+	0x09, 0x2f, 0xc6, 0x09, 0xb4, 0xe2, 0x24, 0x67, 0x91, 0x4c, 0x78, 0x6a, 0x3c, 0x28, 0xb1, 0x83,
+	0x33, 0xd8, 0x83, 0x0d, 0xdb, 0xf9, 0xaf, 0x27, 0xc7, 0xa7, 0xd6, 0x07, 0x68, 0x5d, 0xe5, 0xf1,
+	0x29, 0x3c, 0x71, 0x3c, 0xe2, 0x8e, 0xe7, 0xde, 0xd4, 0x0f, 0x17, 0x7e, 0x30, 0x73, 0xc7, 0xde,
+	0xc4, 0x73, 0x1d, 0xbd, 0x86, 0x1a, 0xa8, 0x8b, 0x99, 0xae, 0x60, 0x13, 0xea, 0xce, 0x74, 0xe9,
+	0xeb, 0xaa, 0xf5, 0x0a, 0x3a, 0x15, 0x5d, 0x64, 0x3c, 0x15, 0x0c, 0x1f, 0x43, 0x43, 0x44, 0x3c,
+	0x67, 0x65, 0x93, 0x0d, 0x52, 0x05, 0xd6, 0x06, 0x90, 0x30, 0x99, 0xd3, 0x48, 0xde, 0xf3, 0x44,
+	0xac, 0xb7, 0xf0, 0xe8, 0x46, 0xa5, 0x4b, 0xb6, 0xde, 0xf4, 0x01, 0x8e, 0x7c, 0xec, 0x40, 0xf3,
+	0xf3, 0xc2, 0x0d, 0x8a, 0xb6, 0xf5, 0x1a, 0x02, 0x68, 0x1f, 0xfd, 0x60, 0xe9, 0x12, 0x5d, 0x19,
+	0xfe, 0x39, 0x7c, 0x65, 0x50, 0xad, 0x03, 0x7e, 0x85, 0x7a, 0x11, 0xe2, 0x8b, 0xcb, 0xb3, 0x36,
+	0x5f, 0x9e, 0xbd, 0xaf, 0x6c, 0x59, 0xfa, 0xaf, 0xdf, 0x46, 0x07, 0xa1, 0xd8, 0x35, 0x61, 0x47,
+	0x54, 0x48, 0x4c, 0xa1, 0x7d, 0xcd, 0x3f, 0xbe, 0xbe, 0x85, 0x70, 0x3a, 0x49, 0xb3, 0x7f, 0x97,
+	0xec, 0x5c, 0xbd, 0x4f, 0xf5, 0x6f, 0x6a, 0xb6, 0x5a, 0x69, 0xe5, 0xca, 0x8e, 0xfe, 0x0d, 0x00,
+	0x4f, 0x63, 0x09, 0x0e, 0xf6, 0x02, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConnInterface
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion6
+
+// VoteServiceClient is the client API for VoteService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type VoteServiceClient interface {
+	Vote(ctx context.Context, in *VoteRequest, opts ...grpc.CallOption) (*VoteResponse, error)
+	RetractVote(ctx context.Context, in *RetractVoteRequest, opts ...grpc.CallOption) (*RetractVoteResponse, error)
+}
+
+type voteServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewVoteServiceClient(cc grpc.ClientConnInterface) VoteServiceClient {
+	return &voteServiceClient{cc}
+}
+
+func (c *voteServiceClient) Vote(ctx context.Context, in *VoteRequest, opts ...grpc.CallOption) (*VoteResponse, error) {
+	out := new(VoteResponse)
+	err := c.cc.Invoke(ctx, "/ranabd36.qaengine.VoteService/Vote", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *voteServiceClient) RetractVote(ctx context.Context, in *RetractVoteRequest, opts ...grpc.CallOption) (*RetractVoteResponse, error) {
+	out := new(RetractVoteResponse)
+	err := c.cc.Invoke(ctx, "/ranabd36.qaengine.VoteService/RetractVote", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// VoteServiceServer is the server API for VoteService service.
+type VoteServiceServer interface {
+	Vote(context.Context, *VoteRequest) (*VoteResponse, error)
+	RetractVote(context.Context, *RetractVoteRequest) (*RetractVoteResponse, error)
+}
+
+// UnimplementedVoteServiceServer can be embedded to have forward compatible implementations.
+type UnimplementedVoteServiceServer struct {
+}
+
+func (*UnimplementedVoteServiceServer) Vote(ctx context.Context, req *VoteRequest) (*VoteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Vote not implemented")
+}
+func (*UnimplementedVoteServiceServer) RetractVote(ctx context.Context, req *RetractVoteRequest) (*RetractVoteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RetractVote not implemented")
+}
+
+func RegisterVoteServiceServer(s *grpc.Server, srv VoteServiceServer) {
+	s.RegisterService(&_VoteService_serviceDesc, srv)
+}
+
+func _VoteService_Vote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VoteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VoteServiceServer).Vote(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ranabd36.qaengine.VoteService/Vote",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VoteServiceServer).Vote(ctx, req.(*VoteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VoteService_RetractVote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RetractVoteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VoteServiceServer).RetractVote(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ranabd36.qaengine.VoteService/RetractVote",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VoteServiceServer).RetractVote(ctx, req.(*RetractVoteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _VoteService_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "ranabd36.qaengine.VoteService",
+	HandlerType: (*VoteServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Vote",
+			Handler:    _VoteService_Vote_Handler,
+		},
+		{
+			MethodName: "RetractVote",
+			Handler:    _VoteService_RetractVote_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "vote_service_message.proto",
+}
@@ -0,0 +1,242 @@
+package search
+
+import (
+	"github.com/ranabd36/project-qa/database/store"
+	"html"
+	"log"
+	"math"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+	"unicode"
+)
+
+// Weights of the terms by where they occur, as Postgres weighs titles (A)
+// against descriptions (B).
+const (
+	titleWeight = 1.0
+	bodyWeight  = 0.4
+)
+
+const snippetWords = 30
+
+// stopWords are left out of the index and queries like Postgres does.
+var stopWords = map[string]bool{
+	"a": true, "an": true, "and": true, "are": true, "as": true, "at": true, "be": true, "but": true, "by": true,
+	"for": true, "if": true, "in": true, "into": true, "is": true, "it": true, "no": true, "not": true, "of": true,
+	"on": true, "or": true, "such": true, "that": true, "the": true, "their": true, "then": true, "there": true,
+	"these": true, "they": true, "this": true, "to": true, "was": true, "will": true, "with": true,
+}
+
+type documentSource interface {
+	ListSearchDocuments() ([]*store.SearchDocument, error)
+}
+
+// MemoryIndex is an in-process inverted index over the published questions and
+// answers. It answers the same queries as the Postgres full-text search, but
+// without stemming, for tests and databases without full-text search.
+type MemoryIndex struct {
+	source    documentSource
+	mutex     sync.RWMutex
+	documents []*store.SearchDocument
+	postings  map[string]map[int]float64 // term -> document -> weighted term frequency
+}
+
+func NewMemoryIndex(source documentSource) *MemoryIndex {
+	return &MemoryIndex{
+		source:   source,
+		postings: make(map[string]map[int]float64),
+	}
+}
+
+// Load rebuilds the index from the documents of the source.
+func (index *MemoryIndex) Load() error {
+	documents, err := index.source.ListSearchDocuments()
+	if err != nil {
+		return err
+	}
+	postings := make(map[string]map[int]float64)
+	add := func(document int, text string, weight float64) {
+		for _, term := range tokenize(text) {
+			if postings[term] == nil {
+				postings[term] = make(map[int]float64)
+			}
+			postings[term][document] += weight
+		}
+	}
+	for i, document := range documents {
+		if document.Kind == store.SearchQuestion {
+			add(i, document.Title, titleWeight)
+		}
+		add(i, document.Body, bodyWeight)
+	}
+
+	index.mutex.Lock()
+	defer index.mutex.Unlock()
+	index.documents = documents
+	index.postings = postings
+	return nil
+}
+
+// Watch rebuilds the index every interval until stop is closed.
+func (index *MemoryIndex) Watch(interval time.Duration, stop <-chan struct{}) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-stop:
+			return
+		case <-ticker.C:
+			if err := index.Load(); err != nil {
+				log.Printf("failed to reload search index: %v", err)
+			}
+		}
+	}
+}
+
+// Search ranks the documents containing every term of the query text.
+func (index *MemoryIndex) Search(query store.SearchQuery) ([]*store.SearchResult, error) {
+	terms := unique(tokenize(query.Text))
+	if len(terms) == 0 {
+		return nil, nil
+	}
+
+	index.mutex.RLock()
+	defer index.mutex.RUnlock()
+
+	var results []*store.SearchResult
+	for document := range index.postings[terms[0]] {
+		d := index.documents[document]
+		if !index.matches(document, d, terms, query) {
+			continue
+		}
+		var rank float64
+		for _, term := range terms {
+			idf := math.Log(1 + float64(len(index.documents))/float64(len(index.postings[term])))
+			rank += index.postings[term][document] * idf
+		}
+		results = append(results, &store.SearchResult{
+			Kind:       d.Kind,
+			ID:         d.ID,
+			QuestionID: d.QuestionID,
+			AuthorID:   d.AuthorID,
+			Title:      d.Title,
+			Snippet:    snippet(d.Body, terms),
+			Rank:       rank,
+		})
+	}
+
+	// same order as the Postgres search, so pages do not overlap
+	sort.Slice(results, func(i, j int) bool {
+		if results[i].Rank != results[j].Rank {
+			return results[i].Rank > results[j].Rank
+		}
+		if results[i].Kind != results[j].Kind {
+			return results[i].Kind < results[j].Kind
+		}
+		return results[i].ID < results[j].ID
+	})
+	if int(query.Offset) >= len(results) {
+		return nil, nil
+	}
+	results = results[query.Offset:]
+	if len(results) > int(query.Limit) {
+		results = results[:query.Limit]
+	}
+	return results, nil
+}
+
+func (index *MemoryIndex) matches(document int, d *store.SearchDocument, terms []string, query store.SearchQuery) bool {
+	if query.Kind != "" && d.Kind != query.Kind {
+		return false
+	}
+	if query.AuthorID != 0 && d.AuthorID != query.AuthorID {
+		return false
+	}
+	for _, tag := range query.Tags {
+		if !contains(d.Tags, tag) {
+			return false
+		}
+	}
+	for _, term := range terms[1:] {
+		if _, ok := index.postings[term][document]; !ok {
+			return false
+		}
+	}
+	return true
+}
+
+// snippet returns the HTML-escaped words of the body around the first matched
+// term, with the matched terms highlighted.
+func snippet(body string, terms []string) string {
+	words := strings.Fields(body)
+	matched := func(word string) bool {
+		for _, token := range tokenize(word) {
+			if contains(terms, token) {
+				return true
+			}
+		}
+		return false
+	}
+
+	start := 0
+	for i, word := range words {
+		if matched(word) {
+			start = i - snippetWords/3
+			break
+		}
+	}
+	if start < 0 {
+		start = 0
+	}
+	end := start + snippetWords
+	if end > len(words) {
+		end = len(words)
+	}
+
+	parts := make([]string, 0, end-start)
+	for _, word := range words[start:end] {
+		escaped := html.EscapeString(word)
+		if matched(word) {
+			escaped = store.SnippetStart + escaped + store.SnippetStop
+		}
+		parts = append(parts, escaped)
+	}
+	return strings.Join(parts, " ")
+}
+
+// tokenize splits the text into lower-cased words, leaving out stop words.
+func tokenize(text string) []string {
+	words := strings.FieldsFunc(strings.ToLower(text), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+	terms := words[:0]
+	for _, word := range words {
+		if !stopWords[word] {
+			terms = append(terms, word)
+		}
+	}
+	return terms
+}
+
+func unique(values []string) []string {
+	seen := make(map[string]bool, len(values))
+	var result []string
+	for _, value := range values {
+		if !seen[value] {
+			seen[value] = true
+			result = append(result, value)
+		}
+	}
+	return result
+}
+
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
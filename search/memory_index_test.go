@@ -0,0 +1,163 @@
+package search
+
+import (
+	"fmt"
+	"github.com/ranabd36/project-qa/database/store"
+	"strings"
+	"testing"
+)
+
+type documents []*store.SearchDocument
+
+func (d documents) ListSearchDocuments() ([]*store.SearchDocument, error) {
+	return d, nil
+}
+
+var testDocuments = documents{
+	{Kind: store.SearchQuestion, ID: 1, QuestionID: 1, AuthorID: 10, Title: "Closing channels in Go", Body: "When should a goroutine close a channel?", Tags: []string{"go", "channels"}},
+	{Kind: store.SearchQuestion, ID: 2, QuestionID: 2, AuthorID: 11, Title: "Postgres indexes", Body: "Which index helps a channel lookup table?", Tags: []string{"postgres"}},
+	{Kind: store.SearchQuestion, ID: 3, QuestionID: 3, AuthorID: 10, Title: "Buffered channels", Body: "Do buffered channels block the goroutine?", Tags: []string{"go"}},
+	{Kind: store.SearchAnswer, ID: 1, QuestionID: 1, AuthorID: 11, Title: "Closing channels in Go", Body: "Only the sender should close the channel.", Tags: []string{"go", "channels"}},
+	{Kind: store.SearchAnswer, ID: 2, QuestionID: 3, AuthorID: 12, Title: "Buffered channels", Body: "A send blocks once the <buffer> is full & the goroutine waits.", Tags: []string{"go"}},
+}
+
+func newTestIndex(t *testing.T) *MemoryIndex {
+	index := NewMemoryIndex(testDocuments)
+	if err := index.Load(); err != nil {
+		t.Fatalf("Load() = %v", err)
+	}
+	return index
+}
+
+func search(t *testing.T, index *MemoryIndex, query store.SearchQuery) []string {
+	if query.Limit == 0 {
+		query.Limit = 10
+	}
+	results, err := index.Search(query)
+	if err != nil {
+		t.Fatalf("Search(%+v) = %v", query, err)
+	}
+	var keys []string
+	for _, result := range results {
+		keys = append(keys, fmt.Sprintf("%v:%v", result.Kind, result.ID))
+	}
+	return keys
+}
+
+func TestSearchMatchesEveryTerm(t *testing.T) {
+	index := newTestIndex(t)
+
+	got := search(t, index, store.SearchQuery{Text: "close channel"})
+	want := []string{"answer:1", "question:1"}
+	if !equal(got, want) {
+		t.Errorf("close channel: got %v, want %v", got, want)
+	}
+	if got := search(t, index, store.SearchQuery{Text: "goroutine postgres"}); len(got) != 0 {
+		t.Errorf("goroutine postgres: got %v, want no results", got)
+	}
+	if got := search(t, index, store.SearchQuery{Text: "the of"}); len(got) != 0 {
+		t.Errorf("stop words only: got %v, want no results", got)
+	}
+}
+
+func TestSearchFilters(t *testing.T) {
+	index := newTestIndex(t)
+
+	tests := []struct {
+		query store.SearchQuery
+		want  []string
+	}{
+		{store.SearchQuery{Text: "goroutine", Kind: store.SearchQuestion}, []string{"question:1", "question:3"}},
+		{store.SearchQuery{Text: "goroutine", Kind: store.SearchAnswer}, []string{"answer:2"}},
+		{store.SearchQuery{Text: "channel", Tags: []string{"postgres"}}, []string{"question:2"}},
+		{store.SearchQuery{Text: "channel", Tags: []string{"go", "channels"}}, []string{"answer:1", "question:1"}},
+		{store.SearchQuery{Text: "channel", Tags: []string{"go", "postgres"}}, nil},
+		{store.SearchQuery{Text: "goroutine", AuthorID: 10}, []string{"question:1", "question:3"}},
+		{store.SearchQuery{Text: "goroutine", AuthorID: 12}, []string{"answer:2"}},
+	}
+	for _, test := range tests {
+		got := search(t, index, test.query)
+		sortKeys(got)
+		if !equal(got, test.want) {
+			t.Errorf("%+v: got %v, want %v", test.query, got, test.want)
+		}
+	}
+}
+
+func TestSearchRanksTitlesFirst(t *testing.T) {
+	index := newTestIndex(t)
+
+	// "buffered" is in the title of question 3 and only in the bodies of others
+	got := search(t, index, store.SearchQuery{Text: "buffered"})
+	if len(got) == 0 || got[0] != "question:3" {
+		t.Errorf("buffered: got %v, want question:3 first", got)
+	}
+
+	results, _ := index.Search(store.SearchQuery{Text: "channel", Limit: 10})
+	for i := 1; i < len(results); i++ {
+		previous, result := results[i-1], results[i]
+		if previous.Rank < result.Rank {
+			t.Errorf("rank of %v:%v below %v:%v", previous.Kind, previous.ID, result.Kind, result.ID)
+		}
+		// equal ranks are ordered by kind and ID like the Postgres search
+		if previous.Rank == result.Rank && (previous.Kind > result.Kind || previous.Kind == result.Kind && previous.ID > result.ID) {
+			t.Errorf("equal ranks of %v:%v and %v:%v out of order", previous.Kind, previous.ID, result.Kind, result.ID)
+		}
+	}
+}
+
+func TestSearchPagesByOffset(t *testing.T) {
+	index := newTestIndex(t)
+
+	all := search(t, index, store.SearchQuery{Text: "channel"})
+	if len(all) != 3 {
+		t.Fatalf("channel: got %v, want 3 results", all)
+	}
+	var paged []string
+	for offset := int32(0); offset < 6; offset += 2 {
+		page := search(t, index, store.SearchQuery{Text: "channel", Offset: offset, Limit: 2})
+		if len(page) > 2 {
+			t.Fatalf("offset %v: got %v, want at most 2 results", offset, page)
+		}
+		paged = append(paged, page...)
+	}
+	if !equal(paged, all) {
+		t.Errorf("pages: got %v, want %v", paged, all)
+	}
+}
+
+func TestSearchSnippetIsEscaped(t *testing.T) {
+	index := newTestIndex(t)
+
+	results, _ := index.Search(store.SearchQuery{Text: "buffer", Limit: 10})
+	if len(results) != 1 {
+		t.Fatalf("buffer: got %v results, want 1", len(results))
+	}
+	snippet := results[0].Snippet
+	if !strings.Contains(snippet, store.SnippetStart+"&lt;buffer&gt;"+store.SnippetStop) || !strings.Contains(snippet, "&amp;") {
+		t.Errorf("snippet %q is not escaped", snippet)
+	}
+	if strings.Contains(snippet, "<buffer>") {
+		t.Errorf("snippet %q contains raw markup", snippet)
+	}
+}
+
+func equal(a []string, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func sortKeys(keys []string) {
+	for i := 1; i < len(keys); i++ {
+		for j := i; j > 0 && keys[j] < keys[j-1]; j-- {
+			keys[j], keys[j-1] = keys[j-1], keys[j]
+		}
+	}
+}
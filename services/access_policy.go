@@ -0,0 +1,99 @@
+package services
+
+import (
+	"github.com/ranabd36/project-qa/pb"
+	"log"
+	"sync"
+	"time"
+)
+
+// Permissions checked by the services beyond the method permissions, e.g. to
+// act on resources of other users.
+const (
+	PermissionManageUsers       = "users.manage"
+	PermissionManageRoles       = "roles.manage"
+	PermissionModerateQuestions = "questions.moderate"
+	PermissionModerateAnswers   = "answers.moderate"
+	PermissionWriteAnswers      = "answers.write"
+)
+
+type accessPolicyStorage interface {
+	ListRolePermissions() (map[string][]string, error)
+	ListPermissions() ([]*pb.Permission, error)
+}
+
+// AccessPolicy keeps an in-memory copy of the permissions granted by each role.
+// Access tokens only carry role names, so changed role permissions apply to
+// outstanding tokens once reloaded.
+type AccessPolicy struct {
+	store       accessPolicyStorage
+	mutex       sync.RWMutex
+	roles       map[string][]string
+	permissions map[string]bool
+}
+
+func NewAccessPolicy(store accessPolicyStorage) *AccessPolicy {
+	return &AccessPolicy{
+		store:       store,
+		roles:       make(map[string][]string),
+		permissions: make(map[string]bool),
+	}
+}
+
+// Load replaces the in-memory copy with the permissions from the store.
+func (policy *AccessPolicy) Load() error {
+	roles, err := policy.store.ListRolePermissions()
+	if err != nil {
+		return err
+	}
+	list, err := policy.store.ListPermissions()
+	if err != nil {
+		return err
+	}
+	permissions := make(map[string]bool, len(list))
+	for _, permission := range list {
+		permissions[permission.GetName()] = true
+	}
+
+	policy.mutex.Lock()
+	defer policy.mutex.Unlock()
+	policy.roles = roles
+	policy.permissions = permissions
+	return nil
+}
+
+// Watch reloads the permissions every interval until stop is closed.
+func (policy *AccessPolicy) Watch(interval time.Duration, stop <-chan struct{}) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-stop:
+			return
+		case <-ticker.C:
+			if err := policy.Load(); err != nil {
+				log.Printf("failed to reload permissions: %v", err)
+			}
+		}
+	}
+}
+
+// IsKnown reports whether the permission exists.
+func (policy *AccessPolicy) IsKnown(permission string) bool {
+	policy.mutex.RLock()
+	defer policy.mutex.RUnlock()
+	return policy.permissions[permission]
+}
+
+// Permissions returns the union of the permissions granted by the roles.
+func (policy *AccessPolicy) Permissions(roles []string) map[string]bool {
+	policy.mutex.RLock()
+	defer policy.mutex.RUnlock()
+	permissions := make(map[string]bool)
+	for _, role := range roles {
+		for _, permission := range policy.roles[role] {
+			permissions[permission] = true
+		}
+	}
+	return permissions
+}
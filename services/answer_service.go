@@ -0,0 +1,263 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"github.com/ranabd36/project-qa/pb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type answerStorage interface {
+	SaveAnswer(answer *pb.Answer) error
+	FindAnswer(id int32) (*pb.Answer, error)
+	ListAnswers(questionID int32) ([]*pb.Answer, error)
+	UpdateAnswer(answer *pb.Answer, editorID int32) error
+	DeleteAnswer(id int32) error
+	AcceptAnswer(questionID int32, answerID int32) error
+}
+
+type AnswerServiceServer struct {
+	answerStore   answerStorage
+	questionStore questionStorage
+	userStore     userStorage
+}
+
+func NewAnswerServiceServer(answerStore answerStorage, questionStore questionStorage, userStore userStorage) *AnswerServiceServer {
+	return &AnswerServiceServer{answerStore, questionStore, userStore}
+}
+
+func (server *AnswerServiceServer) PostAnswer(ctx context.Context, req *pb.PostAnswerRequest) (*pb.PostAnswerResponse, error) {
+	questionID := req.GetQuestionId()
+	if questionID <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "invalid question id given")
+	}
+	if err := server.validateDescription(req.GetDescription()); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	user, err := currentUser(ctx, server.userStore)
+	if err != nil {
+		return nil, err
+	}
+
+	question, err := server.questionStore.FindQuestion(questionID)
+	if err != nil || question.GetPublishedAt() == nil {
+		return nil, status.Errorf(codes.NotFound, "question not found with ID: %v", questionID)
+	}
+
+	answer := &pb.Answer{
+		UserId:      user.GetId(),
+		QuestionId:  questionID,
+		Description: req.GetDescription(),
+	}
+	if err := server.answerStore.SaveAnswer(answer); err != nil {
+		return nil, status.Error(codes.Internal, "unable to save answer")
+	}
+	return &pb.PostAnswerResponse{
+		Id: answer.GetId(),
+	}, nil
+}
+
+func (server *AnswerServiceServer) PostReply(ctx context.Context, req *pb.PostReplyRequest) (*pb.PostReplyResponse, error) {
+	answerID := req.GetAnswerId()
+	if err := server.validateAnswerId(answerID); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if err := server.validateDescription(req.GetDescription()); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	user, err := currentUser(ctx, server.userStore)
+	if err != nil {
+		return nil, err
+	}
+
+	parent, err := server.answerStore.FindAnswer(answerID)
+	if err != nil {
+		return nil, status.Errorf(codes.NotFound, "answer not found with ID: %v", answerID)
+	}
+
+	question, err := server.questionStore.FindQuestion(parent.GetQuestionId())
+	if err != nil || question.GetPublishedAt() == nil {
+		return nil, status.Errorf(codes.NotFound, "answer not found with ID: %v", answerID)
+	}
+
+	reply := &pb.Answer{
+		UserId:      user.GetId(),
+		QuestionId:  parent.GetQuestionId(),
+		AnswerId:    answerID,
+		Description: req.GetDescription(),
+	}
+	if err := server.answerStore.SaveAnswer(reply); err != nil {
+		return nil, status.Error(codes.Internal, "unable to save reply")
+	}
+	return &pb.PostReplyResponse{
+		Id: reply.GetId(),
+	}, nil
+}
+
+func (server *AnswerServiceServer) ListAnswers(ctx context.Context, req *pb.ListAnswersRequest) (*pb.ListAnswersResponse, error) {
+	questionID := req.GetQuestionId()
+	if questionID <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "invalid question id given")
+	}
+
+	question, err := server.questionStore.FindQuestion(questionID)
+	if err != nil {
+		return nil, status.Errorf(codes.NotFound, "question not found with ID: %v", questionID)
+	}
+
+	if question.GetPublishedAt() == nil {
+		user, err := currentUser(ctx, server.userStore)
+		if err != nil || (!hasPermission(ctx, PermissionModerateQuestions) && user.GetId() != question.GetUserId()) {
+			return nil, status.Errorf(codes.NotFound, "question not found with ID: %v", questionID)
+		}
+	}
+
+	answers, err := server.answerStore.ListAnswers(questionID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list answers of question with ID: %v", questionID)
+	}
+
+	return &pb.ListAnswersResponse{
+		Answers: buildAnswerTree(answers),
+	}, nil
+}
+
+func (server *AnswerServiceServer) UpdateAnswer(ctx context.Context, req *pb.UpdateAnswerRequest) (*pb.UpdateAnswerResponse, error) {
+	answerID := req.GetId()
+	if err := server.validateAnswerId(answerID); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if err := server.validateDescription(req.GetDescription()); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	answer, err := server.answerStore.FindAnswer(answerID)
+	if err != nil {
+		return nil, status.Errorf(codes.NotFound, "answer not found with ID: %v", answerID)
+	}
+
+	editor, err := server.authorizeOwner(ctx, answer)
+	if err != nil {
+		return nil, err
+	}
+
+	answer.Description = req.GetDescription()
+	if err := server.answerStore.UpdateAnswer(answer, editor.GetId()); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to update answer with ID: %v", answerID)
+	}
+
+	return &pb.UpdateAnswerResponse{
+		IsUpdated: true,
+	}, nil
+}
+
+func (server *AnswerServiceServer) DeleteAnswer(ctx context.Context, req *pb.DeleteAnswerRequest) (*pb.DeleteAnswerResponse, error) {
+	answerID := req.GetId()
+	if err := server.validateAnswerId(answerID); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	answer, err := server.answerStore.FindAnswer(answerID)
+	if err != nil {
+		return nil, status.Errorf(codes.NotFound, "answer not found with ID: %v", answerID)
+	}
+
+	if _, err := server.authorizeOwner(ctx, answer); err != nil {
+		return nil, err
+	}
+
+	if err := server.answerStore.DeleteAnswer(answerID); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to delete answer with ID: %v", answerID)
+	}
+
+	return &pb.DeleteAnswerResponse{
+		IsDeleted: true,
+	}, nil
+}
+
+func (server *AnswerServiceServer) AcceptAnswer(ctx context.Context, req *pb.AcceptAnswerRequest) (*pb.AcceptAnswerResponse, error) {
+	answerID := req.GetId()
+	if err := server.validateAnswerId(answerID); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	user, err := currentUser(ctx, server.userStore)
+	if err != nil {
+		return nil, err
+	}
+
+	answer, err := server.answerStore.FindAnswer(answerID)
+	if err != nil {
+		return nil, status.Errorf(codes.NotFound, "answer not found with ID: %v", answerID)
+	}
+
+	question, err := server.questionStore.FindQuestion(answer.GetQuestionId())
+	if err != nil {
+		return nil, status.Errorf(codes.NotFound, "question not found with ID: %v", answer.GetQuestionId())
+	}
+
+	if question.GetUserId() != user.GetId() {
+		return nil, status.Error(codes.PermissionDenied, "only the author of the question can accept an answer")
+	}
+
+	if answer.GetAnswerId() > 0 {
+		return nil, status.Error(codes.FailedPrecondition, "a reply cannot be accepted as answer")
+	}
+
+	if err := server.answerStore.AcceptAnswer(question.GetId(), answerID); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to accept answer with ID: %v", answerID)
+	}
+
+	return &pb.AcceptAnswerResponse{
+		IsAccepted: true,
+	}, nil
+}
+
+// authorizeOwner allows the answer owner and moderators to manage the answer.
+// It returns the current user.
+func (server *AnswerServiceServer) authorizeOwner(ctx context.Context, answer *pb.Answer) (*pb.User, error) {
+	user, err := currentUser(ctx, server.userStore)
+	if err != nil {
+		return nil, err
+	}
+	if hasPermission(ctx, PermissionModerateAnswers) || user.GetId() == answer.GetUserId() {
+		return user, nil
+	}
+	return nil, status.Error(codes.PermissionDenied, "only the owner or a moderator can change this answer")
+}
+
+func (server *AnswerServiceServer) validateAnswerId(answerID int32) error {
+	if answerID <= 0 {
+		return errors.New("invalid answer id given")
+	}
+	return nil
+}
+
+func (server *AnswerServiceServer) validateDescription(description string) error {
+	if description == "" {
+		return errors.New("description is required")
+	}
+	return nil
+}
+
+// buildAnswerTree nests replies under their parent answers, keeping the posting order.
+func buildAnswerTree(answers []*pb.Answer) []*pb.Answer {
+	byID := make(map[int32]*pb.Answer, len(answers))
+	for _, answer := range answers {
+		byID[answer.GetId()] = answer
+	}
+
+	var roots []*pb.Answer
+	for _, answer := range answers {
+		parent, ok := byID[answer.GetAnswerId()]
+		if answer.GetAnswerId() == 0 || !ok {
+			roots = append(roots, answer)
+			continue
+		}
+		parent.Replies = append(parent.Replies, answer)
+	}
+	return roots
+}
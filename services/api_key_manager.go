@@ -0,0 +1,92 @@
+package services
+
+import (
+	"fmt"
+	"github.com/dgrijalva/jwt-go"
+	"github.com/ranabd36/project-qa/database/store"
+	"log"
+	"strconv"
+	"time"
+)
+
+// apiKeyPrefix makes the keys easy to recognize, e.g. by secret scanners.
+const apiKeyPrefix = "qak_"
+
+type apiKeyStorage interface {
+	SaveAPIKey(key *store.APIKey) error
+	FindAPIKey(keyHash string) (*store.APIKey, error)
+	FindAPIKeyByID(id int32) (*store.APIKey, error)
+	ListAPIKeys(userID int32) ([]*store.APIKey, error)
+	RevokeAPIKey(id int32, now time.Time) error
+	TouchAPIKey(id int32, now time.Time) error
+}
+
+// APIKeyManager issues and verifies the API keys of machine clients. Like
+// refresh tokens, only the hash of a key is stored.
+type APIKeyManager struct {
+	store          apiKeyStorage
+	userStore      userStorage
+	revocationList *RevocationList
+}
+
+func NewAPIKeyManager(store apiKeyStorage, userStore userStorage, revocationList *RevocationList) *APIKeyManager {
+	return &APIKeyManager{store, userStore, revocationList}
+}
+
+// Generate creates a key for the user and returns it together with its record.
+func (manager *APIKeyManager) Generate(userID int32, name string, scopes []string, expiresAt *time.Time) (string, *store.APIKey, error) {
+	token, err := randomToken(32)
+	if err != nil {
+		return "", nil, err
+	}
+	key := apiKeyPrefix + token
+	record := &store.APIKey{
+		UserID:    userID,
+		Name:      name,
+		Prefix:    key[:len(apiKeyPrefix)+8],
+		KeyHash:   hashToken(key),
+		Scopes:    scopes,
+		ExpiresAt: expiresAt,
+	}
+	if err := manager.store.SaveAPIKey(record); err != nil {
+		return "", nil, err
+	}
+	return key, record, nil
+}
+
+// Verify returns the claims of a request made with the key. The claims carry
+// the current roles of the user, restricted to the scopes of the key by the
+// AuthInterceptor.
+func (manager *APIKeyManager) Verify(key string) (*UserClaims, error) {
+	record, err := manager.store.FindAPIKey(hashToken(key))
+	if err != nil {
+		return nil, fmt.Errorf("unknown api key")
+	}
+	now := time.Now().UTC()
+	if record.RevokedAt != nil || (record.ExpiresAt != nil && now.After(*record.ExpiresAt)) {
+		return nil, fmt.Errorf("api key is revoked or expired")
+	}
+
+	user, err := manager.userStore.Find(record.UserID)
+	if err != nil || !user.GetIsActive() {
+		return nil, fmt.Errorf("user of the api key is missing or deactivated")
+	}
+	// revoking the sessions of the user revokes the keys created until then too
+	if manager.revocationList.IsUserRevokedSince(user.GetUsername(), record.CreatedAt) {
+		return nil, fmt.Errorf("api key is revoked")
+	}
+
+	if err := manager.store.TouchAPIKey(record.ID, now); err != nil {
+		log.Printf("failed to record use of api key %v: %v", record.ID, err)
+	}
+	return &UserClaims{
+		StandardClaims: jwt.StandardClaims{
+			Id:      "apikey-" + strconv.Itoa(int(record.ID)),
+			Subject: strconv.Itoa(int(user.GetId())),
+		},
+		Username: user.GetUsername(),
+		Roles:    user.GetRoles(),
+		apiKey:   true,
+		scopes:   record.Scopes,
+	}, nil
+}
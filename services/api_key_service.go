@@ -0,0 +1,160 @@
+package services
+
+import (
+	"context"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/ranabd36/project-qa/database/store"
+	"github.com/ranabd36/project-qa/pb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"strings"
+	"time"
+)
+
+type APIKeyServiceServer struct {
+	apiKeyStore   apiKeyStorage
+	userStore     userStorage
+	apiKeyManager *APIKeyManager
+	accessPolicy  *AccessPolicy
+}
+
+func NewAPIKeyServiceServer(apiKeyStore apiKeyStorage, userStore userStorage, apiKeyManager *APIKeyManager, accessPolicy *AccessPolicy) *APIKeyServiceServer {
+	return &APIKeyServiceServer{apiKeyStore, userStore, apiKeyManager, accessPolicy}
+}
+
+// CreateAPIKey creates a key for the caller or, for users managing users, for
+// a service account. The scopes are limited to the permissions of the caller,
+// so a key never grants more than its creator has.
+func (server *APIKeyServiceServer) CreateAPIKey(ctx context.Context, req *pb.CreateAPIKeyRequest) (*pb.CreateAPIKeyResponse, error) {
+	claims, ok := ClaimsFromContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "authorization token is not provided")
+	}
+	if claims.IsAPIKey() {
+		return nil, status.Error(codes.PermissionDenied, "api keys cannot create api keys")
+	}
+
+	name := strings.TrimSpace(req.GetName())
+	if name == "" {
+		return nil, status.Error(codes.InvalidArgument, "name is required")
+	} else if len(name) > 100 {
+		return nil, status.Error(codes.InvalidArgument, "name must be less than or equal to 100 characters.")
+	}
+	if len(req.GetScopes()) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "at least one scope is required")
+	}
+	for _, scope := range req.GetScopes() {
+		if !server.accessPolicy.IsKnown(scope) {
+			return nil, status.Errorf(codes.InvalidArgument, "unknown scope: %v", scope)
+		}
+		if !claims.HasPermission(scope) {
+			return nil, status.Errorf(codes.PermissionDenied, "no permission to grant scope: %v", scope)
+		}
+	}
+
+	var expiresAt *time.Time
+	if req.GetExpiresAt() != nil {
+		t, err := ptypes.Timestamp(req.GetExpiresAt())
+		if err != nil || !t.After(time.Now()) {
+			return nil, status.Error(codes.InvalidArgument, "expires at must be in the future")
+		}
+		t = t.UTC()
+		expiresAt = &t
+	}
+
+	userID := claims.UserID()
+	if req.GetUserId() != 0 && req.GetUserId() != userID {
+		if !claims.HasPermission(PermissionManageUsers) {
+			return nil, status.Error(codes.PermissionDenied, "no permission to access this user")
+		}
+		user, err := server.userStore.Find(req.GetUserId())
+		if err != nil {
+			return nil, status.Errorf(codes.NotFound, "user not found with ID: %v", req.GetUserId())
+		}
+		if !user.GetIsServiceAccount() {
+			return nil, status.Error(codes.FailedPrecondition, "api keys can only be created for yourself or a service account")
+		}
+		userID = user.GetId()
+	}
+
+	key, record, err := server.apiKeyManager.Generate(userID, name, req.GetScopes(), expiresAt)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to create api key")
+	}
+	auditTarget(ctx, "api_key", record.ID)
+	auditChange(ctx, nil, map[string]interface{}{"user_id": record.UserID, "name": record.Name, "scopes": record.Scopes})
+	return &pb.CreateAPIKeyResponse{
+		ApiKey: apiKeyProto(record),
+		Key:    key,
+	}, nil
+}
+
+func (server *APIKeyServiceServer) ListAPIKeys(ctx context.Context, req *pb.ListAPIKeysRequest) (*pb.ListAPIKeysResponse, error) {
+	claims, ok := ClaimsFromContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "authorization token is not provided")
+	}
+	userID := req.GetUserId()
+	if userID == 0 {
+		userID = claims.UserID()
+	}
+	if err := authorizeSelfOrAdmin(ctx, userID); err != nil {
+		return nil, err
+	}
+
+	keys, err := server.apiKeyStore.ListAPIKeys(userID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list api keys of user with ID: %v", userID)
+	}
+	res := &pb.ListAPIKeysResponse{}
+	for _, key := range keys {
+		res.ApiKeys = append(res.ApiKeys, apiKeyProto(key))
+	}
+	return res, nil
+}
+
+func (server *APIKeyServiceServer) RevokeAPIKey(ctx context.Context, req *pb.RevokeAPIKeyRequest) (*pb.RevokeAPIKeyResponse, error) {
+	keyID := req.GetId()
+	if keyID <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "invalid api key id given")
+	}
+	auditTarget(ctx, "api_key", keyID)
+
+	key, err := server.apiKeyStore.FindAPIKeyByID(keyID)
+	if err != nil {
+		return nil, status.Errorf(codes.NotFound, "api key not found with ID: %v", keyID)
+	}
+	if err := authorizeSelfOrAdmin(ctx, key.UserID); err != nil {
+		return nil, status.Errorf(codes.NotFound, "api key not found with ID: %v", keyID)
+	}
+
+	if key.RevokedAt == nil {
+		if err := server.apiKeyStore.RevokeAPIKey(keyID, time.Now().UTC()); err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to revoke api key with ID: %v", keyID)
+		}
+	}
+	return &pb.RevokeAPIKeyResponse{
+		IsRevoked: true,
+	}, nil
+}
+
+func apiKeyProto(key *store.APIKey) *pb.APIKey {
+	res := &pb.APIKey{
+		Id:     key.ID,
+		UserId: key.UserID,
+		Name:   key.Name,
+		Prefix: key.Prefix,
+		Scopes: key.Scopes,
+	}
+	res.CreatedAt, _ = ptypes.TimestampProto(key.CreatedAt)
+	if key.ExpiresAt != nil {
+		res.ExpiresAt, _ = ptypes.TimestampProto(*key.ExpiresAt)
+	}
+	if key.LastUsedAt != nil {
+		res.LastUsedAt, _ = ptypes.TimestampProto(*key.LastUsedAt)
+	}
+	if key.RevokedAt != nil {
+		res.RevokedAt, _ = ptypes.TimestampProto(*key.RevokedAt)
+	}
+	return res
+}
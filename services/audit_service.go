@@ -0,0 +1,100 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/ranabd36/project-qa/database/store"
+	"github.com/ranabd36/project-qa/pb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type AuditServiceServer struct {
+	auditStore auditStorage
+}
+
+func NewAuditServiceServer(auditStore auditStorage) *AuditServiceServer {
+	return &AuditServiceServer{auditStore}
+}
+
+// ListAuditEvents returns the audit events matching the filter, newest first.
+func (server *AuditServiceServer) ListAuditEvents(ctx context.Context, req *pb.ListAuditEventsRequest) (*pb.ListAuditEventsResponse, error) {
+	filter, err := server.auditEventFilter(req)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	// fetch one extra row to find out whether there is a next page
+	limit := filter.Limit
+	filter.Limit++
+	events, err := server.auditStore.ListAuditEvents(filter)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to list audit events")
+	}
+
+	res := &pb.ListAuditEventsResponse{}
+	if int32(len(events)) > limit {
+		events = events[:limit]
+		res.NextPageToken = encodePageToken(pageToken{
+			Desc: true,
+			ID:   events[len(events)-1].ID,
+		})
+	}
+	for _, event := range events {
+		res.AuditEvents = append(res.AuditEvents, auditEventProto(event))
+	}
+	return res, nil
+}
+
+func (server *AuditServiceServer) auditEventFilter(req *pb.ListAuditEventsRequest) (store.AuditEventFilter, error) {
+	filter := store.AuditEventFilter{
+		ActorID:    req.GetActorId(),
+		Action:     req.GetAction(),
+		TargetType: req.GetTargetType(),
+		TargetID:   req.GetTargetId(),
+		Outcome:    req.GetOutcome(),
+		Limit:      pageSize(req.GetPageSize()),
+	}
+	if req.GetOccurredAfter() != nil {
+		occurredAfter, err := ptypes.Timestamp(req.GetOccurredAfter())
+		if err != nil {
+			return filter, errors.New("invalid occurred after given")
+		}
+		filter.OccurredAfter = occurredAfter
+	}
+	if req.GetOccurredBefore() != nil {
+		occurredBefore, err := ptypes.Timestamp(req.GetOccurredBefore())
+		if err != nil {
+			return filter, errors.New("invalid occurred before given")
+		}
+		filter.OccurredBefore = occurredBefore
+	}
+
+	before, err := decodePageToken(req.GetPageToken(), 0, true)
+	if err != nil {
+		return filter, err
+	}
+	if before != nil {
+		filter.BeforeID = before.ID
+	}
+	return filter, nil
+}
+
+func auditEventProto(event *store.AuditEvent) *pb.AuditEvent {
+	res := &pb.AuditEvent{
+		Id:            event.ID,
+		ActorId:       event.ActorID,
+		ActorUsername: event.ActorUsername,
+		Action:        event.Action,
+		TargetType:    event.TargetType,
+		TargetId:      event.TargetID,
+		Outcome:       event.Outcome,
+		PeerAddress:   event.PeerAddress,
+		RequestId:     event.RequestID,
+		Before:        string(event.Before),
+		After:         string(event.After),
+	}
+	res.OccurredAt, _ = ptypes.TimestampProto(event.OccurredAt)
+	return res
+}
@@ -0,0 +1,154 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/ranabd36/project-qa/database/store"
+	"github.com/ranabd36/project-qa/pb"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"log"
+	"time"
+	"unicode/utf8"
+)
+
+const auditEntryKey contextKey = "audit_entry"
+
+// requestIDHeader correlates the audit events of a call with the logs of the
+// client. It is returned as header metadata of every audited call.
+const requestIDHeader = "x-request-id"
+
+const maxRequestIDLength = 64
+
+// maxAuditValueLength bounds the actor and target of audit events, which are
+// indexed, so no value is too long to be recorded.
+const maxAuditValueLength = 255
+
+type auditStorage interface {
+	SaveAuditEvent(event *store.AuditEvent) error
+	ListAuditEvents(filter store.AuditEventFilter) ([]*store.AuditEvent, error)
+}
+
+type methodPolicySource interface {
+	MethodPolicy(method string) (*pb.AuthPolicy, bool)
+}
+
+// Auditor records calls of RPCs whose auth policy asks for it in the audit
+// log. The AuthInterceptor adds the caller, the services add the target and
+// the changed values through auditTarget and auditChange.
+type Auditor struct {
+	store    auditStorage
+	policies methodPolicySource
+}
+
+func NewAuditor(store auditStorage, policies methodPolicySource) *Auditor {
+	return &Auditor{store, policies}
+}
+
+// auditEntry collects the details of an audited call while it is handled.
+type auditEntry struct {
+	actorID       int32
+	actorUsername string
+	targetType    string
+	targetID      string
+	before        interface{}
+	after         interface{}
+}
+
+// Unary must run before the AuthInterceptor, so denied calls are recorded too.
+func (auditor *Auditor) Unary() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (resp interface{}, err error) {
+		policy, ok := auditor.policies.MethodPolicy(info.FullMethod)
+		if !ok || !policy.GetAudit() {
+			return handler(ctx, req)
+		}
+
+		entry := &auditEntry{}
+		requestID := requestIDFromContext(ctx)
+		if err := grpc.SetHeader(ctx, metadata.Pairs(requestIDHeader, requestID)); err != nil {
+			log.Printf("failed to set request id header: %v", err)
+		}
+		resp, err = handler(context.WithValue(ctx, auditEntryKey, entry), req)
+		auditor.record(ctx, info.FullMethod, requestID, entry, err)
+		return resp, err
+	}
+}
+
+func (auditor *Auditor) record(ctx context.Context, method string, requestID string, entry *auditEntry, err error) {
+	event := &store.AuditEvent{
+		OccurredAt:    time.Now().UTC(),
+		ActorID:       entry.actorID,
+		ActorUsername: truncate(entry.actorUsername, maxAuditValueLength),
+		Action:        method,
+		TargetType:    truncate(entry.targetType, maxAuditValueLength),
+		TargetID:      truncate(entry.targetID, maxAuditValueLength),
+		Outcome:       status.Code(err).String(),
+		PeerAddress:   peerAddress(ctx),
+		RequestID:     requestID,
+	}
+	if entry.before != nil {
+		event.Before, _ = json.Marshal(entry.before)
+	}
+	if entry.after != nil {
+		event.After, _ = json.Marshal(entry.after)
+	}
+	if err := auditor.store.SaveAuditEvent(event); err != nil {
+		log.Printf("failed to save audit event of %v (request %v): %v", method, requestID, err)
+	}
+}
+
+// truncate cuts value to at most length bytes without splitting a character.
+func truncate(value string, length int) string {
+	if len(value) <= length {
+		return value
+	}
+	for length > 0 && !utf8.RuneStart(value[length]) {
+		length--
+	}
+	return value[:length]
+}
+
+// requestIDFromContext returns the request ID sent by the client or a new one.
+func requestIDFromContext(ctx context.Context) string {
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if values := md[requestIDHeader]; len(values) > 0 && values[0] != "" {
+			if len(values[0]) > maxRequestIDLength {
+				return values[0][:maxRequestIDLength]
+			}
+			return values[0]
+		}
+	}
+	requestID, err := randomToken(12)
+	if err != nil {
+		return ""
+	}
+	return requestID
+}
+
+// auditActor sets the user making an audited call. Calls without access token,
+// like Login, set it once the user is known.
+func auditActor(ctx context.Context, userID int32, username string) {
+	if entry, ok := ctx.Value(auditEntryKey).(*auditEntry); ok {
+		entry.actorID = userID
+		entry.actorUsername = username
+	}
+}
+
+// auditTarget sets the resource an audited call acts on.
+func auditTarget(ctx context.Context, targetType string, targetID interface{}) {
+	if entry, ok := ctx.Value(auditEntryKey).(*auditEntry); ok {
+		entry.targetType = targetType
+		entry.targetID = fmt.Sprint(targetID)
+	}
+}
+
+// auditChange sets the values an audited call changed. They are stored as
+// JSON, so never pass secrets like password hashes.
+func auditChange(ctx context.Context, before interface{}, after interface{}) {
+	if entry, ok := ctx.Value(auditEntryKey).(*auditEntry); ok {
+		entry.before = before
+		entry.after = after
+	}
+}
@@ -0,0 +1,60 @@
+package services
+
+import (
+	"context"
+	"github.com/ranabd36/project-qa/database/store"
+	"github.com/ranabd36/project-qa/pb"
+	"google.golang.org/grpc"
+	"strings"
+	"testing"
+)
+
+type auditEvents []*store.AuditEvent
+
+func (events *auditEvents) SaveAuditEvent(event *store.AuditEvent) error {
+	*events = append(*events, event)
+	return nil
+}
+
+func (events *auditEvents) ListAuditEvents(filter store.AuditEventFilter) ([]*store.AuditEvent, error) {
+	return *events, nil
+}
+
+type auditedMethods struct{}
+
+func (auditedMethods) MethodPolicy(method string) (*pb.AuthPolicy, bool) {
+	return &pb.AuthPolicy{Public: true, Audit: true}, true
+}
+
+func TestAuditorRecordsLongTargets(t *testing.T) {
+	tests := []struct {
+		username string
+		target   string
+		want     string
+	}{
+		{"svc-nightly-report-exporter", "a.very.long.mailbox.name@subdomain.example.com", "a.very.long.mailbox.name@subdomain.example.com"},
+		{"alice", strings.Repeat("x", maxAuditValueLength+10), strings.Repeat("x", maxAuditValueLength)},
+		{"bob", strings.Repeat("x", maxAuditValueLength-1) + "é", strings.Repeat("x", maxAuditValueLength-1)},
+	}
+	for _, test := range tests {
+		events := &auditEvents{}
+		auditor := NewAuditor(events, auditedMethods{})
+		handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+			auditActor(ctx, 7, test.username)
+			auditTarget(ctx, "password_reset", test.target)
+			return nil, nil
+		}
+		info := &grpc.UnaryServerInfo{FullMethod: "/ranabd36.qaengine.AuthService/RequestPasswordReset"}
+		if _, err := auditor.Unary()(context.Background(), nil, info, handler); err != nil {
+			t.Fatalf("Unary() = %v", err)
+		}
+
+		if len(*events) != 1 {
+			t.Fatalf("target %q: got %v events, want 1", test.target, len(*events))
+		}
+		event := (*events)[0]
+		if event.ActorUsername != test.username || event.TargetType != "password_reset" || event.TargetID != test.want {
+			t.Errorf("target %q: recorded %q by %q, want %q by %q", test.target, event.TargetID, event.ActorUsername, test.want, test.username)
+		}
+	}
+}
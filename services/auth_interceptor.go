@@ -1,64 +1,339 @@
 package services
 
 import (
+	"bytes"
+	"compress/gzip"
 	"context"
+	"fmt"
+	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/protoc-gen-go/descriptor"
+	"github.com/ranabd36/project-qa/database/store"
+	"github.com/ranabd36/project-qa/pb"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
+	"io/ioutil"
 )
 
+type contextKey string
+
+const userClaimsKey contextKey = "user_claims"
+
+const reauthorizeKey contextKey = "reauthorize"
+
+const apiKeyHeader = "x-api-key"
+
 type AuthInterceptor struct {
 	jwtManager      *JWTManager
-	accessibleRoles map[string][]string
+	apiKeyManager   *APIKeyManager
+	revocationList  *RevocationList
+	accessPolicy    *AccessPolicy
+	requireAdminMFA bool
+	methodPolicies  map[string]*pb.AuthPolicy
+}
+
+// NewAuthInterceptor returns an interceptor authorizing RPCs by the permissions
+// the roles of the caller grant. With requireAdminMFA admins logged in without
+// a second factor only get the permissions of the default role, enough to
+// enroll TOTP. Machine clients send an API key in the x-api-key metadata
+// instead of an access token. Every RPC is denied until LoadMethodPolicies is
+// called.
+func NewAuthInterceptor(manager *JWTManager, apiKeyManager *APIKeyManager, revocationList *RevocationList, accessPolicy *AccessPolicy, requireAdminMFA bool) *AuthInterceptor {
+	return &AuthInterceptor{
+		jwtManager:      manager,
+		apiKeyManager:   apiKeyManager,
+		revocationList:  revocationList,
+		accessPolicy:    accessPolicy,
+		requireAdminMFA: requireAdminMFA,
+		methodPolicies:  make(map[string]*pb.AuthPolicy),
+	}
 }
 
-func NewAuthInterceptor(manager *JWTManager, accessibleRoles map[string][]string) *AuthInterceptor {
-	return &AuthInterceptor{manager, accessibleRoles}
+// LoadMethodPolicies reads the auth option of every method of the registered
+// services. It fails when a method declares no policy or requires an unknown
+// permission, so no RPC is exposed by accident. publicServices are services
+// not generated from our protos, e.g. reflection, that anyone can call. It
+// must be called before the server starts serving.
+func (interceptor *AuthInterceptor) LoadMethodPolicies(services map[string]grpc.ServiceInfo, publicServices ...string) error {
+	public := make(map[string]bool, len(publicServices))
+	for _, service := range publicServices {
+		public[service] = true
+	}
+	
+	policies := make(map[string]*pb.AuthPolicy)
+	for serviceName, info := range services {
+		if public[serviceName] {
+			for _, method := range info.Methods {
+				policies["/"+serviceName+"/"+method.Name] = &pb.AuthPolicy{Public: true}
+			}
+			continue
+		}
+		
+		filename, ok := info.Metadata.(string)
+		if !ok {
+			return fmt.Errorf("service %v has no proto file", serviceName)
+		}
+		file, err := fileDescriptor(filename)
+		if err != nil {
+			return err
+		}
+		for _, service := range file.GetService() {
+			if file.GetPackage()+"."+service.GetName() != serviceName {
+				continue
+			}
+			for _, method := range service.GetMethod() {
+				fullMethod := "/" + serviceName + "/" + method.GetName()
+				policy, err := methodPolicy(method)
+				if err != nil {
+					return fmt.Errorf("%v: %w", fullMethod, err)
+				}
+				if !policy.GetPublic() && !interceptor.accessPolicy.IsKnown(policy.GetPermission()) {
+					return fmt.Errorf("%v: unknown permission %v", fullMethod, policy.GetPermission())
+				}
+				policies[fullMethod] = policy
+			}
+		}
+		for _, method := range info.Methods {
+			if _, ok := policies["/"+serviceName+"/"+method.Name]; !ok {
+				return fmt.Errorf("/%v/%v: method not found in %v", serviceName, method.Name, filename)
+			}
+		}
+	}
+	interceptor.methodPolicies = policies
+	return nil
 }
 
 func (interceptor *AuthInterceptor) Unary() grpc.UnaryServerInterceptor {
 	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (resp interface{}, err error) {
-		if err := interceptor.authorize(ctx, info.FullMethod); err != nil {
+		claims, err := interceptor.authorize(ctx, info.FullMethod)
+		if claims != nil {
+			auditActor(ctx, claims.UserID(), claims.Username)
+		}
+		if err != nil {
 			return nil, err
 		}
+		if claims != nil {
+			ctx = context.WithValue(ctx, userClaimsKey, claims)
+		}
 		return handler(ctx, req)
 	}
 }
 
 func (interceptor *AuthInterceptor) Stream() grpc.StreamServerInterceptor {
 	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
-		if err := interceptor.authorize(ss.Context(), info.FullMethod); err != nil {
+		claims, err := interceptor.authorize(ss.Context(), info.FullMethod)
+		if err != nil {
 			return err
 		}
+		if claims != nil {
+			incoming := ss.Context()
+			ctx := context.WithValue(incoming, userClaimsKey, claims)
+			// long-lived streams check now and then that the credentials still hold
+			ctx = context.WithValue(ctx, reauthorizeKey, func() error {
+				claims, err := interceptor.authorize(incoming, info.FullMethod)
+				if err != nil {
+					return err
+				}
+				if claims == nil {
+					return status.Error(codes.Unauthenticated, "credentials are no longer valid")
+				}
+				return nil
+			})
+			ss = &claimsServerStream{ss, ctx}
+		}
 		return handler(srv, ss)
 	}
 }
 
-func (interceptor *AuthInterceptor) authorize(ctx context.Context, method string) error {
-	accessibleRoles, ok := interceptor.accessibleRoles[method]
+func (interceptor *AuthInterceptor) authorize(ctx context.Context, method string) (*UserClaims, error) {
+	policy, ok := interceptor.methodPolicies[method]
 	if !ok {
-		return nil //everyone can access
+		return nil, status.Error(codes.PermissionDenied, "no access policy for this RPC")
 	}
 	md, ok := metadata.FromIncomingContext(ctx)
+	if policy.GetPublic() {
+		//everyone can access, callers sending valid credentials are identified
+		if !ok || (len(md["authorization"]) == 0 && len(md[apiKeyHeader]) == 0) {
+			return nil, nil
+		}
+		claims, err := interceptor.authenticate(md)
+		if err != nil {
+			return nil, nil
+		}
+		interceptor.grantPermissions(claims)
+		return claims, nil
+	}
 	
 	if !ok {
-		return status.Error(codes.Unauthenticated, "metadata is not provided")
+		return nil, status.Error(codes.Unauthenticated, "metadata is not provided")
+	}
+	claims, err := interceptor.authenticate(md)
+	if err != nil {
+		return nil, err
 	}
-	values := md["authorization"]
 	
+	interceptor.grantPermissions(claims)
+	if !claims.HasPermission(policy.GetPermission()) {
+		// the claims are returned so denied calls are audited with their caller
+		return claims, status.Error(codes.PermissionDenied, "no permission to access this RPC")
+	}
+	return claims, nil
+}
+
+// grantPermissions sets the permissions the roles of the claims grant.
+func (interceptor *AuthInterceptor) grantPermissions(claims *UserClaims) {
+	permissions := interceptor.accessPolicy.Permissions(claims.Roles)
+	if claims.IsAPIKey() {
+		// an API key never grants more than its user has
+		scoped := make(map[string]bool, len(claims.scopes))
+		for _, scope := range claims.scopes {
+			scoped[scope] = permissions[scope]
+		}
+		permissions = scoped
+	}
+	claims.permissions = permissions
+}
+
+// MethodPolicy returns the auth policy of the full method name.
+func (interceptor *AuthInterceptor) MethodPolicy(method string) (*pb.AuthPolicy, bool) {
+	policy, ok := interceptor.methodPolicies[method]
+	return policy, ok
+}
+
+// authenticate verifies the access token or, when there is none, the API key
+// of the request.
+func (interceptor *AuthInterceptor) authenticate(md metadata.MD) (*UserClaims, error) {
+	values := md["authorization"]
 	if len(values) == 0 {
-		return status.Error(codes.Unauthenticated, "authorization token is not provided")
+		if keys := md[apiKeyHeader]; len(keys) > 0 {
+			claims, err := interceptor.apiKeyManager.Verify(keys[0])
+			if err != nil {
+				return nil, status.Error(codes.Unauthenticated, "api key is invalid")
+			}
+			return claims, nil
+		}
+		return nil, status.Error(codes.Unauthenticated, "authorization token is not provided")
 	}
+	
 	accessToken := values[0]
 	claims, err := interceptor.jwtManager.Verify(accessToken)
 	if err != nil {
-		return status.Error(codes.Unauthenticated, "access token is invalid")
+		return nil, status.Error(codes.Unauthenticated, "access token is invalid")
+	}
+	if interceptor.revocationList.IsRevoked(claims) {
+		return nil, status.Error(codes.Unauthenticated, "access token is revoked")
+	}
+	if interceptor.requireAdminMFA && !claims.MFA && hasRole(claims.Roles, store.AdminRole) {
+		claims.Roles = []string{store.DefaultRole}
 	}
-	for _, role := range accessibleRoles {
-		if role == claims.Role {
-			return nil
+	return claims, nil
+}
+
+func hasRole(roles []string, name string) bool {
+	for _, role := range roles {
+		if role == name {
+			return true
 		}
 	}
-	return status.Error(codes.PermissionDenied, "no permission to access this RPC")
+	return false
+}
+
+// ClaimsFromContext returns the claims of the caller attached by the AuthInterceptor.
+// It reports false for RPCs that were called without an access token.
+func ClaimsFromContext(ctx context.Context) (*UserClaims, bool) {
+	claims, ok := ctx.Value(userClaimsKey).(*UserClaims)
+	return claims, ok
+}
+
+// reauthorize authorizes the credentials a stream was opened with again, so
+// expired or revoked tokens and keys do not keep streams open. Streams opened
+// without credentials have nothing to check.
+func reauthorize(ctx context.Context) error {
+	check, ok := ctx.Value(reauthorizeKey).(func() error)
+	if !ok {
+		return nil
+	}
+	return check()
+}
+
+// currentUser loads the user the request was authorized for.
+func currentUser(ctx context.Context, userStore userStorage) (*pb.User, error) {
+	claims, ok := ClaimsFromContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "authorization token is not provided")
+	}
+	user, err := userStore.Find(claims.UserID())
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "user of the access token no longer exists")
+	}
+	return user, nil
+}
+
+// methodPolicy returns the auth option of the method, which must either be
+// public or require a permission.
+func methodPolicy(method *descriptor.MethodDescriptorProto) (*pb.AuthPolicy, error) {
+	if method.GetOptions() == nil {
+		return nil, fmt.Errorf("no auth policy declared")
+	}
+	extension, err := proto.GetExtension(method.GetOptions(), pb.E_Auth)
+	if err != nil {
+		return nil, fmt.Errorf("no auth policy declared")
+	}
+	policy, ok := extension.(*pb.AuthPolicy)
+	if !ok || policy.GetPublic() == (policy.GetPermission() != "") {
+		return nil, fmt.Errorf("auth policy must either be public or require a permission")
+	}
+	return policy, nil
+}
+
+// fileDescriptor returns the registered descriptor of the proto file.
+func fileDescriptor(filename string) (*descriptor.FileDescriptorProto, error) {
+	compressed := proto.FileDescriptor(filename)
+	if compressed == nil {
+		return nil, fmt.Errorf("proto file %v is not registered", filename)
+	}
+	reader, err := gzip.NewReader(bytes.NewReader(compressed))
+	if err != nil {
+		return nil, err
+	}
+	defer reader.Close()
+	data, err := ioutil.ReadAll(reader)
+	if err != nil {
+		return nil, err
+	}
+	file := &descriptor.FileDescriptorProto{}
+	if err := proto.Unmarshal(data, file); err != nil {
+		return nil, err
+	}
+	return file, nil
+}
+
+// hasPermission reports whether the caller was granted the permission.
+func hasPermission(ctx context.Context, permission string) bool {
+	claims, ok := ClaimsFromContext(ctx)
+	return ok && claims.HasPermission(permission)
+}
+
+// authorizeSelfOrAdmin allows users managing users to act on any user and
+// everyone else only on themselves.
+func authorizeSelfOrAdmin(ctx context.Context, userID int32) error {
+	claims, ok := ClaimsFromContext(ctx)
+	if !ok {
+		return status.Error(codes.Unauthenticated, "authorization token is not provided")
+	}
+	if claims.HasPermission(PermissionManageUsers) || claims.UserID() == userID {
+		return nil
+	}
+	return status.Error(codes.PermissionDenied, "no permission to access this user")
+}
+
+// claimsServerStream carries the caller claims into stream handlers.
+type claimsServerStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (stream *claimsServerStream) Context() context.Context {
+	return stream.ctx
 }
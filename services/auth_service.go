@@ -2,20 +2,95 @@ package services
 
 import (
 	"context"
-	"errors"
+	"crypto/rand"
+	"crypto/sha256"
+	"database/sql"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"github.com/ranabd36/project-qa/database/store"
 	"github.com/ranabd36/project-qa/pb"
 	"golang.org/x/crypto/bcrypt"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/peer"
 	"google.golang.org/grpc/status"
+	"log"
+	"net"
+	"strings"
+	"time"
 )
 
+type refreshTokenStorage interface {
+	SaveRefreshToken(token *store.RefreshToken) error
+	FindRefreshToken(tokenHash string) (*store.RefreshToken, error)
+	RotateRefreshToken(usedID int32, next *store.RefreshToken) error
+	RevokeRefreshTokenFamily(familyID string) error
+	RevokeUserRefreshTokens(userID int32) error
+}
+
+type passwordResetStorage interface {
+	SavePasswordResetToken(token *store.PasswordResetToken) error
+	FindPasswordResetToken(tokenHash string, now time.Time) (*store.PasswordResetToken, error)
+	ResetPassword(tokenHash string, newPassword string, now time.Time) (int32, error)
+}
+
+// Mailer delivers emails to users.
+type Mailer interface {
+	Send(to string, subject string, body string) error
+}
+
+// TokenDurations are the lifetimes of the tokens issued by the AuthServer,
+// besides the access token lifetime which belongs to the JWTManager.
+type TokenDurations struct {
+	RefreshToken      time.Duration
+	EmailVerification time.Duration
+	PasswordReset     time.Duration
+	MFAChallenge      time.Duration
+}
+
 type AuthServer struct {
-	userStore  userStorage
-	jwtManager *JWTManager
+	userStore          userStorage
+	refreshTokenStore  refreshTokenStorage
+	passwordResetStore passwordResetStorage
+	jwtManager         *JWTManager
+	revocationList     *RevocationList
+	mailer             Mailer
+	passwordPolicy     *PasswordPolicy
+	loginThrottler     *LoginThrottler
+	totpManager        *TOTPManager
+	durations          TokenDurations
+	passwordResets     chan string
 }
 
-func NewAuthServer(userStore userStorage, manager *JWTManager) *AuthServer {
-	return &AuthServer{userStore, manager}
+// passwordResetQueueSize is how many password resets can wait to be sent,
+// further requests are dropped until the queue drains.
+const passwordResetQueueSize = 100
+
+func NewAuthServer(
+	userStore userStorage,
+	refreshTokenStore refreshTokenStorage,
+	passwordResetStore passwordResetStorage,
+	manager *JWTManager,
+	revocationList *RevocationList,
+	mailer Mailer,
+	passwordPolicy *PasswordPolicy,
+	loginThrottler *LoginThrottler,
+	totpManager *TOTPManager,
+	durations TokenDurations,
+) *AuthServer {
+	return &AuthServer{
+		userStore,
+		refreshTokenStore,
+		passwordResetStore,
+		manager,
+		revocationList,
+		mailer,
+		passwordPolicy,
+		loginThrottler,
+		totpManager,
+		durations,
+		make(chan string, passwordResetQueueSize),
+	}
 }
 
 func (server *AuthServer) Login(ctx context.Context, req *pb.LoginRequest) (*pb.LoginResponse, error) {
@@ -23,47 +98,610 @@ func (server *AuthServer) Login(ctx context.Context, req *pb.LoginRequest) (*pb.
 		return nil, err
 	}
 	
-	user, err := server.userStore.FindByUsername(req.GetUsername())
+	// the account is throttled by its username whichever identifier was used,
+	// unknown identifiers are throttled by themselves
+	user := server.findLoginUser(req)
+	account := server.loginIdentifier(req)
+	if user != nil {
+		account = user.GetUsername()
+		auditTarget(ctx, "user", user.GetId())
+	} else {
+		auditTarget(ctx, "login", account)
+	}
+	
+	address := peerAddress(ctx)
+	if err := server.loginThrottler.Check(account, address); err != nil {
+		return nil, err
+	}
+	
+	// unknown users and wrong passwords get the same answer after the same
+	// amount of work, so neither tells whether the account exists
+	if !server.isPasswordMatch(user, req.GetPassword()) {
+		if err := server.loginThrottler.Fail(account, address); err != nil {
+			log.Printf("failed to record failed login: %v", err)
+		}
+		return nil, status.Errorf(codes.Unauthenticated, "incorrect username/password")
+	}
+	
+	if err := server.loginThrottler.Succeed(account); err != nil {
+		log.Printf("failed to reset failed logins: %v", err)
+	}
+	
+	if !user.GetIsActive() {
+		return nil, status.Error(codes.PermissionDenied, "user account is deactivated")
+	}
+	auditActor(ctx, user.GetId(), user.GetUsername())
+	
+	if user.GetIsTotpEnabled() {
+		mfaToken, err := server.jwtManager.GenerateMFAChallenge(user, server.durations.MFAChallenge)
+		if err != nil {
+			return nil, status.Error(codes.Internal, "failed to generate mfa token")
+		}
+		return &pb.LoginResponse{
+			MfaRequired: true,
+			MfaToken:    mfaToken,
+		}, nil
+	}
+	return server.issueTokens(user, false)
+}
+
+// VerifyMFA completes a login of a user with TOTP enabled. Wrong codes count
+// as failed logins of the user.
+func (server *AuthServer) VerifyMFA(ctx context.Context, req *pb.VerifyMFARequest) (*pb.LoginResponse, error) {
+	if req.GetMfaToken() == "" {
+		return nil, status.Error(codes.InvalidArgument, "mfa token is required")
+	}
+	if strings.TrimSpace(req.GetCode()) == "" {
+		return nil, status.Error(codes.InvalidArgument, "code is required")
+	}
+	
+	userID, err := server.jwtManager.VerifyMFAChallenge(req.GetMfaToken())
 	if err != nil {
-		return nil, status.Errorf(
-			codes.Internal,
-			"cannot find user with username: %v", req.GetUsername(),
-		)
+		return nil, status.Error(codes.Unauthenticated, "mfa token is invalid or expired")
+	}
+	user, err := server.userStore.Find(userID)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "mfa token is invalid or expired")
+	}
+	auditTarget(ctx, "user", user.GetId())
+	
+	address := peerAddress(ctx)
+	if err := server.loginThrottler.Check(user.GetUsername(), address); err != nil {
+		return nil, err
+	}
+	
+	ok, err := server.totpManager.Verify(user.GetId(), req.GetCode())
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to verify code")
+	}
+	if !ok {
+		if err := server.loginThrottler.Fail(user.GetUsername(), address); err != nil {
+			log.Printf("failed to record failed login: %v", err)
+		}
+		return nil, status.Error(codes.Unauthenticated, "incorrect code")
 	}
 	
-	if user == nil || !server.isPasswordMatch(user, req.GetPassword()) {
-		return nil, status.Errorf(codes.NotFound, "incorrect username/password")
+	if err := server.loginThrottler.Succeed(user.GetUsername()); err != nil {
+		log.Printf("failed to reset failed logins: %v", err)
 	}
 	
-	token, err := server.jwtManager.Generate(user)
+	if !user.GetIsActive() {
+		return nil, status.Error(codes.PermissionDenied, "user account is deactivated")
+	}
+	auditActor(ctx, user.GetId(), user.GetUsername())
+	return server.issueTokens(user, true)
+}
+
+// BeginTOTPEnrollment creates a TOTP secret for the caller. TOTP is enabled
+// once ConfirmTOTP proves the secret was added to an authenticator app.
+func (server *AuthServer) BeginTOTPEnrollment(ctx context.Context, req *pb.BeginTOTPEnrollmentRequest) (*pb.BeginTOTPEnrollmentResponse, error) {
+	if claims, ok := ClaimsFromContext(ctx); ok && claims.IsAPIKey() {
+		return nil, status.Error(codes.PermissionDenied, "TOTP cannot be managed with an api key")
+	}
+	user, err := currentUser(ctx, server.userStore)
+	if err != nil {
+		return nil, err
+	}
+	auditTarget(ctx, "user", user.GetId())
+	if user.GetIsTotpEnabled() {
+		return nil, status.Error(codes.FailedPrecondition, "TOTP is already enabled")
+	}
+	
+	secret, uri, err := server.totpManager.Begin(user)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to begin TOTP enrollment")
+	}
+	return &pb.BeginTOTPEnrollmentResponse{
+		Secret:     secret,
+		OtpauthUri: uri,
+	}, nil
+}
+
+// ConfirmTOTP enables TOTP for the caller and returns their recovery codes.
+func (server *AuthServer) ConfirmTOTP(ctx context.Context, req *pb.ConfirmTOTPRequest) (*pb.ConfirmTOTPResponse, error) {
+	if strings.TrimSpace(req.GetCode()) == "" {
+		return nil, status.Error(codes.InvalidArgument, "code is required")
+	}
+	
+	if claims, ok := ClaimsFromContext(ctx); ok && claims.IsAPIKey() {
+		return nil, status.Error(codes.PermissionDenied, "TOTP cannot be managed with an api key")
+	}
+	user, err := currentUser(ctx, server.userStore)
+	if err != nil {
+		return nil, err
+	}
+	auditTarget(ctx, "user", user.GetId())
+	if user.GetIsTotpEnabled() {
+		return nil, status.Error(codes.FailedPrecondition, "TOTP is already enabled")
+	}
+	
+	recoveryCodes, ok, err := server.totpManager.Confirm(user.GetId(), req.GetCode())
+	if err != nil {
+		return nil, status.Error(codes.FailedPrecondition, "no pending TOTP enrollment, call BeginTOTPEnrollment first")
+	}
+	if !ok {
+		return nil, status.Error(codes.InvalidArgument, "incorrect code")
+	}
+	return &pb.ConfirmTOTPResponse{
+		IsEnabled:     true,
+		RecoveryCodes: recoveryCodes,
+	}, nil
+}
+
+// GetPublicKeys returns the JSON Web Key Set other services verify access tokens with.
+func (server *AuthServer) GetPublicKeys(ctx context.Context, req *pb.GetPublicKeysRequest) (*pb.GetPublicKeysResponse, error) {
+	return &pb.GetPublicKeysResponse{
+		Keys: server.jwtManager.PublicKeys(),
+	}, nil
+}
+
+// issueTokens starts a new session for the user with a fresh access token and
+// the first refresh token of a new token family. mfa tells whether the login
+// passed a second factor.
+func (server *AuthServer) issueTokens(user *pb.User, mfa bool) (*pb.LoginResponse, error) {
+	token, err := server.jwtManager.Generate(user, mfa)
 	if err != nil {
 		return nil, status.Error(codes.Internal, "failed to generate token")
 	}
+	
+	familyID, err := randomToken(16)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to generate refresh token")
+	}
+	refreshToken, record, err := server.newRefreshToken(user.GetId(), familyID, mfa)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to generate refresh token")
+	}
+	if err := server.refreshTokenStore.SaveRefreshToken(record); err != nil {
+		return nil, status.Error(codes.Internal, "failed to save refresh token")
+	}
 	return &pb.LoginResponse{
-		AccessToken: token,
+		AccessToken:  token,
+		RefreshToken: refreshToken,
+	}, nil
+}
+
+// UnlockUser lifts the login lock of a user caused by failed logins.
+func (server *AuthServer) UnlockUser(ctx context.Context, req *pb.UnlockUserRequest) (*pb.UnlockUserResponse, error) {
+	userID := req.GetUserId()
+	if userID <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "invalid user id given")
+	}
+	auditTarget(ctx, "user", userID)
+	
+	user, err := server.userStore.Find(userID)
+	if err != nil {
+		return nil, status.Errorf(codes.NotFound, "user not found with ID: %v", userID)
+	}
+	
+	if err := server.loginThrottler.Unlock(user.GetUsername()); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to unlock user with ID: %v", userID)
+	}
+	return &pb.UnlockUserResponse{
+		IsUnlocked: true,
 	}, nil
 }
 
+// RefreshToken exchanges a refresh token for a new access token and a new
+// refresh token. Presenting an already used refresh token is treated as theft
+// and revokes every token of its family.
+func (server *AuthServer) RefreshToken(ctx context.Context, req *pb.RefreshTokenRequest) (*pb.RefreshTokenResponse, error) {
+	if req.GetRefreshToken() == "" {
+		return nil, status.Error(codes.InvalidArgument, "refresh token is required")
+	}
+	
+	record, err := server.refreshTokenStore.FindRefreshToken(hashToken(req.GetRefreshToken()))
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "refresh token is invalid")
+	}
+	
+	if record.UsedAt != nil {
+		return nil, server.revokeFamily(record.FamilyID)
+	}
+	if record.RevokedAt != nil || time.Now().UTC().After(record.ExpiresAt) {
+		return nil, status.Error(codes.Unauthenticated, "refresh token is invalid")
+	}
+	
+	user, err := server.userStore.Find(record.UserID)
+	if err != nil || !user.GetIsActive() {
+		return nil, status.Error(codes.Unauthenticated, "refresh token is invalid")
+	}
+	
+	refreshToken, next, err := server.newRefreshToken(user.GetId(), record.FamilyID, record.MFA)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to generate refresh token")
+	}
+	if err := server.refreshTokenStore.RotateRefreshToken(record.ID, next); err != nil {
+		if err == store.ErrAlreadyUsed {
+			return nil, server.revokeFamily(record.FamilyID)
+		}
+		return nil, status.Error(codes.Internal, "failed to rotate refresh token")
+	}
+	
+	token, err := server.jwtManager.Generate(user, record.MFA)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to generate token")
+	}
+	return &pb.RefreshTokenResponse{
+		AccessToken:  token,
+		RefreshToken: refreshToken,
+	}, nil
+}
+
+// Logout revokes the access token of the request and, when given, the refresh
+// token issued with it.
+func (server *AuthServer) Logout(ctx context.Context, req *pb.LogoutRequest) (*pb.LogoutResponse, error) {
+	claims, ok := ClaimsFromContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "authorization token is not provided")
+	}
+	if claims.IsAPIKey() {
+		return nil, status.Error(codes.FailedPrecondition, "api keys cannot log out, revoke the key instead")
+	}
+	auditTarget(ctx, "user", claims.UserID())
+	
+	if req.GetRefreshToken() != "" {
+		record, err := server.refreshTokenStore.FindRefreshToken(hashToken(req.GetRefreshToken()))
+		if err != nil || record.UserID != claims.UserID() {
+			return nil, status.Error(codes.InvalidArgument, "refresh token is invalid")
+		}
+		if err := server.refreshTokenStore.RevokeRefreshTokenFamily(record.FamilyID); err != nil {
+			return nil, status.Error(codes.Internal, "failed to revoke refresh token")
+		}
+	}
+	
+	if err := server.revocationList.RevokeToken(claims); err != nil {
+		return nil, status.Error(codes.Internal, "failed to revoke access token")
+	}
+	return &pb.LogoutResponse{
+		IsLoggedOut: true,
+	}, nil
+}
+
+// RevokeUserSessions revokes every access token, refresh token and API key
+// issued to a user so far.
+func (server *AuthServer) RevokeUserSessions(ctx context.Context, req *pb.RevokeUserSessionsRequest) (*pb.RevokeUserSessionsResponse, error) {
+	userID := req.GetUserId()
+	if userID <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "invalid user id given")
+	}
+	auditTarget(ctx, "user", userID)
+	
+	user, err := server.userStore.Find(userID)
+	if err != nil {
+		return nil, status.Errorf(codes.NotFound, "user not found with ID: %v", userID)
+	}
+	
+	if err := server.refreshTokenStore.RevokeUserRefreshTokens(userID); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to revoke refresh tokens of user with ID: %v", userID)
+	}
+	if err := server.revocationList.RevokeUser(user.GetUsername()); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to revoke access tokens of user with ID: %v", userID)
+	}
+	return &pb.RevokeUserSessionsResponse{
+		IsRevoked: true,
+	}, nil
+}
+
+// Register creates an inactive user and mails a verification token to the
+// given email address. The account is activated by VerifyEmail.
+func (server *AuthServer) Register(ctx context.Context, req *pb.RegisterRequest) (*pb.RegisterResponse, error) {
+	user := &pb.User{
+		FirstName: req.GetFirstName(),
+		LastName:  req.GetLastName(),
+		Username:  req.GetUsername(),
+		Email:     req.GetEmail(),
+		Password:  req.GetPassword(),
+		IsActive:  false,
+		IsAdmin:   false,
+	}
+	normalizeUser(user)
+	if err := validateUser(user); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if err := server.passwordPolicy.Validate("password", user.GetPassword(), user.GetUsername(), user.GetEmail()); err != nil {
+		return nil, err
+	}
+	
+	if err := server.userStore.Save(user); err != nil {
+		if err == store.ErrAlreadyExists {
+			return nil, status.Error(codes.AlreadyExists, "user already exists!")
+		}
+		return nil, status.Error(codes.Internal, "unable to save user")
+	}
+	auditTarget(ctx, "user", user.GetId())
+	
+	if err := server.sendVerificationEmail(user); err != nil {
+		// without the email the account could never be activated, so the
+		// username and email are freed for another attempt
+		if err := server.userStore.DeleteUnverified(user.GetId()); err != nil {
+			log.Printf("failed to delete user %v after the verification email failed: %v", user.GetId(), err)
+		}
+		return nil, status.Error(codes.Internal, "failed to send verification email")
+	}
+	auditChange(ctx, nil, auditUser(user))
+	return &pb.RegisterResponse{
+		Id: user.GetId(),
+	}, nil
+}
+
+// sendVerificationEmail mails the user a token verifying their email address.
+func (server *AuthServer) sendVerificationEmail(user *pb.User) error {
+	token, err := server.jwtManager.GenerateEmailVerification(user, server.durations.EmailVerification)
+	if err != nil {
+		return err
+	}
+	body := fmt.Sprintf("Hi %v,\n\nUse the following token to verify your email address:\n\n%v\n", user.GetFirstName(), token)
+	return server.mailer.Send(user.GetEmail(), "Verify your email address", body)
+}
+
+func (server *AuthServer) VerifyEmail(ctx context.Context, req *pb.VerifyEmailRequest) (*pb.VerifyEmailResponse, error) {
+	if req.GetToken() == "" {
+		return nil, status.Error(codes.InvalidArgument, "token is required")
+	}
+	
+	claims, err := server.jwtManager.VerifyEmailVerification(req.GetToken())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "verification token is invalid")
+	}
+	
+	user, err := server.userStore.Find(claims.UserID())
+	if err != nil || user.GetEmail() != claims.Email {
+		return nil, status.Error(codes.InvalidArgument, "verification token is invalid")
+	}
+	auditTarget(ctx, "user", user.GetId())
+	
+	if !user.GetIsEmailVerified() {
+		if err := server.userStore.VerifyEmail(user.GetId()); err != nil {
+			return nil, status.Error(codes.Internal, "failed to verify email")
+		}
+	}
+	return &pb.VerifyEmailResponse{
+		IsVerified: true,
+	}, nil
+}
+
+// RequestPasswordReset mails a single-use reset token to the user owning the
+// email. It succeeds for unknown emails too, so it cannot be used to find out
+// which emails are registered.
+func (server *AuthServer) RequestPasswordReset(ctx context.Context, req *pb.RequestPasswordResetRequest) (*pb.RequestPasswordResetResponse, error) {
+	email := strings.ToLower(strings.TrimSpace(req.GetEmail()))
+	if email == "" {
+		return nil, status.Error(codes.InvalidArgument, "email is required")
+	} else if len(email) > 50 {
+		return nil, status.Error(codes.InvalidArgument, "email must be less than or equal to 50 characters.")
+	} else if !emailPattern.MatchString(email) {
+		return nil, status.Error(codes.InvalidArgument, "invalid email address")
+	}
+	auditTarget(ctx, "password_reset", email)
+	
+	// the reset is sent in the background, so neither the response nor its
+	// timing tell whether an account with the email exists
+	select {
+	case server.passwordResets <- email:
+	default:
+		log.Printf("password reset queue is full, dropped a request")
+	}
+	return &pb.RequestPasswordResetResponse{
+		IsRequested: true,
+	}, nil
+}
+
+// SendPasswordResets sends the requested password resets one at a time until
+// stop is closed.
+func (server *AuthServer) SendPasswordResets(stop <-chan struct{}) {
+	for {
+		select {
+		case <-stop:
+			return
+		case email := <-server.passwordResets:
+			server.sendPasswordReset(email)
+		}
+	}
+}
+
+// sendPasswordReset mails a password reset token to the user with the email,
+// if there is one.
+func (server *AuthServer) sendPasswordReset(email string) {
+	user, err := server.userStore.FindByEmail(email)
+	if err != nil || user.GetIsServiceAccount() {
+		return
+	}
+	
+	token, err := randomToken(32)
+	if err != nil {
+		log.Printf("failed to generate reset token for user %v: %v", user.GetId(), err)
+		return
+	}
+	record := &store.PasswordResetToken{
+		UserID:    user.GetId(),
+		TokenHash: hashToken(token),
+		ExpiresAt: time.Now().UTC().Add(server.durations.PasswordReset),
+	}
+	if err := server.passwordResetStore.SavePasswordResetToken(record); err != nil {
+		log.Printf("failed to save reset token for user %v: %v", user.GetId(), err)
+		return
+	}
+	
+	body := fmt.Sprintf("Hi %v,\n\nUse the following token to reset your password:\n\n%v\n\nIf you did not ask for a password reset, you can ignore this email.\n", user.GetFirstName(), token)
+	if err := server.mailer.Send(user.GetEmail(), "Reset your password", body); err != nil {
+		log.Printf("failed to send password reset email to user %v: %v", user.GetId(), err)
+	}
+}
+
+// ResetPassword sets a new password using a reset token and signs the user
+// out of every session.
+func (server *AuthServer) ResetPassword(ctx context.Context, req *pb.ResetPasswordRequest) (*pb.ResetPasswordResponse, error) {
+	if req.GetToken() == "" {
+		return nil, status.Error(codes.InvalidArgument, "token is required")
+	}
+	if err := validatePassword(req.GetNewPassword(), req.GetRetypeNewPassword()); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	
+	tokenHash := hashToken(req.GetToken())
+	now := time.Now().UTC()
+	record, err := server.passwordResetStore.FindPasswordResetToken(tokenHash, now)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "reset token is invalid or expired")
+	}
+	
+	user, err := server.userStore.Find(record.UserID)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "reset token is invalid or expired")
+	}
+	auditTarget(ctx, "user", user.GetId())
+	
+	if err := server.passwordPolicy.Validate("new_password", req.GetNewPassword(), user.GetUsername(), user.GetEmail()); err != nil {
+		return nil, err
+	}
+	
+	userID, err := server.passwordResetStore.ResetPassword(tokenHash, req.GetNewPassword(), now)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, status.Error(codes.InvalidArgument, "reset token is invalid or expired")
+		}
+		return nil, status.Error(codes.Internal, "failed to reset password")
+	}
+	if err := server.refreshTokenStore.RevokeUserRefreshTokens(userID); err != nil {
+		return nil, status.Error(codes.Internal, "failed to revoke refresh tokens")
+	}
+	if err := server.revocationList.RevokeUser(user.GetUsername()); err != nil {
+		return nil, status.Error(codes.Internal, "failed to revoke access tokens")
+	}
+	return &pb.ResetPasswordResponse{
+		IsPasswordReset: true,
+	}, nil
+}
+
+func (server *AuthServer) revokeFamily(familyID string) error {
+	if err := server.refreshTokenStore.RevokeRefreshTokenFamily(familyID); err != nil {
+		return status.Error(codes.Internal, "failed to revoke refresh tokens")
+	}
+	return status.Error(codes.Unauthenticated, "refresh token was already used, all sessions of this login are revoked")
+}
+
+// newRefreshToken returns a new refresh token together with the record to store.
+// Only the hash of the token is stored.
+func (server *AuthServer) newRefreshToken(userID int32, familyID string, mfa bool) (string, *store.RefreshToken, error) {
+	token, err := randomToken(32)
+	if err != nil {
+		return "", nil, err
+	}
+	return token, &store.RefreshToken{
+		UserID:    userID,
+		FamilyID:  familyID,
+		TokenHash: hashToken(token),
+		ExpiresAt: time.Now().UTC().Add(server.durations.RefreshToken),
+		MFA:       mfa,
+	}, nil
+}
+
+func randomToken(size int) (string, error) {
+	data, err := randomBytes(size)
+	if err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(data), nil
+}
+
+func randomBytes(size int) ([]byte, error) {
+	data := make([]byte, size)
+	if _, err := rand.Read(data); err != nil {
+		return nil, err
+	}
+	return data, nil
+}
+
+func hashToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
+
+// dummyPasswordHash is compared against when the user does not exist, so the
+// bcrypt cost is paid on every login attempt.
+var dummyPasswordHash, _ = bcrypt.GenerateFromPassword([]byte("dummy password"), bcrypt.DefaultCost)
+
+// findLoginUser looks the user up by email or username, nil when there is none.
+func (server *AuthServer) findLoginUser(req *pb.LoginRequest) *pb.User {
+	// looked up by the same identifier the login is throttled by
+	identifier := server.loginIdentifier(req)
+	var user *pb.User
+	var err error
+	if req.GetEmail() != "" {
+		user, err = server.userStore.FindByEmail(identifier)
+	} else {
+		user, err = server.userStore.FindByUsername(identifier)
+	}
+	// service accounts authenticate with API keys only
+	if err != nil || user.GetIsServiceAccount() {
+		return nil
+	}
+	return user
+}
+
+func (server *AuthServer) loginIdentifier(req *pb.LoginRequest) string {
+	if req.GetEmail() != "" {
+		return strings.TrimSpace(req.GetEmail())
+	}
+	return strings.TrimSpace(req.GetUsername())
+}
+
 func (server *AuthServer) isPasswordMatch(user *pb.User, password string) bool {
+	if user == nil {
+		_ = bcrypt.CompareHashAndPassword(dummyPasswordHash, []byte(password))
+		return false
+	}
 	if err := bcrypt.CompareHashAndPassword([]byte(user.GetPassword()), []byte(password)); err != nil {
 		return false
 	}
 	return true
 }
 
+// peerAddress returns the host of the calling peer without the port.
+func peerAddress(ctx context.Context) string {
+	p, ok := peer.FromContext(ctx)
+	if !ok || p.Addr == nil {
+		return ""
+	}
+	address := p.Addr.String()
+	if host, _, err := net.SplitHostPort(address); err == nil {
+		return host
+	}
+	return address
+}
+
 func (server *AuthServer) validateLoginRequest(req *pb.LoginRequest) error {
 	err := ""
-	if req.GetUsername() == "" {
-		err = "username is required"
+	if server.loginIdentifier(req) == "" {
+		err = "username or email is required"
+	} else if len(server.loginIdentifier(req)) > 50 {
+		err = "username or email must be less than or equal to 50 characters."
 	} else if req.GetPassword() == "" {
 		err = "password is required"
-	} else if len(req.GetPassword()) < 6 {
-		err = "password must be at least 6 characters longs"
-	} else if len(req.GetPassword()) > 12 {
-		err = "password must be less than or equal to 12 characters longs"
 	}
 	if len(err) > 0 {
-		return errors.New(err)
+		return status.Error(codes.InvalidArgument, err)
 	}
 	return nil
 }
@@ -1,53 +1,140 @@
 package services
 
 import (
+	"crypto/hmac"
+	"crypto/sha256"
 	"fmt"
 	"github.com/dgrijalva/jwt-go"
 	"github.com/ranabd36/project-qa/pb"
+	"strconv"
 	"time"
 )
 
+// JWTManager issues and verifies access tokens. Without a key set access
+// tokens are signed with the HS256 secret key, with one they are signed by the
+// active asymmetric key and carry its ID in the kid header. The secret key
+// always signs the purpose tokens, which never leave this service.
 type JWTManager struct {
 	secretKey     string
 	tokenDuration time.Duration
+	keySet        *KeySet
 }
 
+// UserClaims are the claims of an access token. The subject holds the user ID
+// and the standard ID (jti) identifies the token for revocation. MFA tells
+// whether the login passed a second factor. The permissions granted by the
+// roles are resolved by the AuthInterceptor. Claims of API key requests are
+// never serialized, they are limited to the scopes of the key.
 type UserClaims struct {
 	jwt.StandardClaims
-	Username string `json:"username"`
-	Role     string `json:"role"`
+	Username    string   `json:"username"`
+	Roles       []string `json:"roles"`
+	MFA         bool     `json:"mfa,omitempty"`
+	IssuedAtUs  int64    `json:"iat_us,omitempty"` // issue time in microseconds, iat only has seconds
+	permissions map[string]bool
+	apiKey      bool
+	scopes      []string
 }
 
-func NewJWTManager(secretKey string, tokenDuration time.Duration) *JWTManager {
-	return &JWTManager{secretKey, tokenDuration}
+// UserID returns the ID of the user the token was issued to, 0 if the subject is invalid.
+func (claims *UserClaims) UserID() int32 {
+	return subjectUserID(claims.Subject)
 }
 
-func (manager *JWTManager) Generate(user *pb.User) (string, error) {
-	role := "user"
-	if user.GetIsAdmin() {
-		role = "admin"
+// IsAPIKey reports whether the request was made with an API key instead of an access token.
+func (claims *UserClaims) IsAPIKey() bool {
+	return claims.apiKey
+}
+
+// HasPermission reports whether one of the roles of the caller grants the permission.
+func (claims *UserClaims) HasPermission(permission string) bool {
+	return claims.permissions[permission]
+}
+
+// minSecretKeyLength is the length of the HS256 key, shorter secrets are
+// easier to guess than the signatures made with them.
+const minSecretKeyLength = 32
+
+// NewJWTManager fails for a secret key shorter than 32 bytes, which rules out
+// an unset key and the old "secret" default. Anyone knowing the secret can
+// forge verification tokens and MFA challenges for any user.
+func NewJWTManager(secretKey string, tokenDuration time.Duration, keySet *KeySet) (*JWTManager, error) {
+	if len(secretKey) < minSecretKeyLength {
+		return nil, fmt.Errorf("secret key must be set to a random value of at least %v bytes", minSecretKeyLength)
+	}
+	return &JWTManager{secretKey, tokenDuration, keySet}, nil
+}
+
+func (manager *JWTManager) Generate(user *pb.User, mfa bool) (string, error) {
+	tokenID, err := randomToken(16)
+	if err != nil {
+		return "", err
 	}
+	now := time.Now()
 	claims := UserClaims{
 		StandardClaims: jwt.StandardClaims{
-			ExpiresAt: time.Now().Add(manager.tokenDuration).Unix(),
+			Id:        tokenID,
+			Subject:   strconv.Itoa(int(user.GetId())),
+			IssuedAt:  now.Unix(),
+			ExpiresAt: now.Add(manager.tokenDuration).Unix(),
 		},
-		Username: user.GetUsername(),
-		Role:     role,
+		Username:   user.GetUsername(),
+		Roles:      user.GetRoles(),
+		MFA:        mfa,
+		IssuedAtUs: now.UnixNano() / int64(time.Microsecond),
+	}
+	if manager.keySet == nil {
+		token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
+		return token.SignedString([]byte(manager.secretKey))
+	}
+	key := manager.keySet.Active()
+	token := jwt.NewWithClaims(key.Method, claims)
+	token.Header["kid"] = key.ID
+	return token.SignedString(key.PrivateKey)
+}
+
+// PublicKeys returns the keys verifying access tokens, none when they are
+// signed with the secret key.
+func (manager *JWTManager) PublicKeys() []*pb.JSONWebKey {
+	if manager.keySet == nil {
+		return nil
+	}
+	return manager.keySet.PublicKeys()
+}
+
+// VerificationClaims are the claims of an email verification token. The
+// subject holds the user ID and Email the address the token was sent to.
+type VerificationClaims struct {
+	jwt.StandardClaims
+	Email string `json:"email"`
+}
+
+// GenerateEmailVerification signs a token proving the ownership of the user email.
+// It is signed with a key derived from the secret key, so it can never pass as an access token.
+func (manager *JWTManager) GenerateEmailVerification(user *pb.User, duration time.Duration) (string, error) {
+	now := time.Now()
+	claims := VerificationClaims{
+		StandardClaims: jwt.StandardClaims{
+			Subject:   strconv.Itoa(int(user.GetId())),
+			IssuedAt:  now.Unix(),
+			ExpiresAt: now.Add(duration).Unix(),
+		},
+		Email: user.GetEmail(),
 	}
 	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
-	return token.SignedString([]byte(manager.secretKey))
+	return token.SignedString(manager.purposeKey(emailVerificationPurpose))
 }
 
-func (manager *JWTManager) Verify(accessToken string) (*UserClaims, error) {
+func (manager *JWTManager) VerifyEmailVerification(verificationToken string) (*VerificationClaims, error) {
 	token, err := jwt.ParseWithClaims(
-		accessToken,
-		&UserClaims{},
+		verificationToken,
+		&VerificationClaims{},
 		func(token *jwt.Token) (interface{}, error) {
 			_, ok := token.Method.(*jwt.SigningMethodHMAC)
 			if !ok {
 				return nil, fmt.Errorf("unexpected token signing method")
 			}
-			return []byte(manager.secretKey), nil
+			return manager.purposeKey(emailVerificationPurpose), nil
 		},
 	)
 	
@@ -55,9 +142,106 @@ func (manager *JWTManager) Verify(accessToken string) (*UserClaims, error) {
 		return nil, fmt.Errorf("invalid token %w", err)
 	}
 	
+	claims, ok := token.Claims.(*VerificationClaims)
+	if !ok || claims.UserID() == 0 || claims.Email == "" {
+		return nil, fmt.Errorf("invalid token claims")
+	}
+	return claims, nil
+}
+
+func (claims *VerificationClaims) UserID() int32 {
+	return subjectUserID(claims.Subject)
+}
+
+// GenerateMFAChallenge signs a token proving the user passed the password step
+// of a login, to be exchanged for access tokens together with a second factor.
+func (manager *JWTManager) GenerateMFAChallenge(user *pb.User, duration time.Duration) (string, error) {
+	now := time.Now()
+	claims := jwt.StandardClaims{
+		Subject:   strconv.Itoa(int(user.GetId())),
+		IssuedAt:  now.Unix(),
+		ExpiresAt: now.Add(duration).Unix(),
+	}
+	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
+	return token.SignedString(manager.purposeKey(mfaChallengePurpose))
+}
+
+// VerifyMFAChallenge returns the ID of the user the challenge was issued to.
+func (manager *JWTManager) VerifyMFAChallenge(challengeToken string) (int32, error) {
+	token, err := jwt.ParseWithClaims(
+		challengeToken,
+		&jwt.StandardClaims{},
+		func(token *jwt.Token) (interface{}, error) {
+			_, ok := token.Method.(*jwt.SigningMethodHMAC)
+			if !ok {
+				return nil, fmt.Errorf("unexpected token signing method")
+			}
+			return manager.purposeKey(mfaChallengePurpose), nil
+		},
+	)
+	
+	if err != nil {
+		return 0, fmt.Errorf("invalid token %w", err)
+	}
+	
+	claims, ok := token.Claims.(*jwt.StandardClaims)
+	if !ok || subjectUserID(claims.Subject) == 0 {
+		return 0, fmt.Errorf("invalid token claims")
+	}
+	return subjectUserID(claims.Subject), nil
+}
+
+const (
+	emailVerificationPurpose = "email-verification"
+	mfaChallengePurpose      = "mfa-challenge"
+)
+
+func (manager *JWTManager) purposeKey(purpose string) []byte {
+	mac := hmac.New(sha256.New, []byte(manager.secretKey))
+	mac.Write([]byte(purpose))
+	return mac.Sum(nil)
+}
+
+func (manager *JWTManager) Verify(accessToken string) (*UserClaims, error) {
+	token, err := jwt.ParseWithClaims(accessToken, &UserClaims{}, manager.accessTokenKey)
+	
+	if err != nil {
+		return nil, fmt.Errorf("invalid token %w", err)
+	}
+	
 	claims, ok := token.Claims.(*UserClaims)
-	if !ok {
+	if !ok || claims.UserID() == 0 {
 		return nil, fmt.Errorf("invalid token claims")
 	}
 	return claims, nil
 }
+
+// accessTokenKey returns the key verifying the token. The algorithm must be the
+// one of the key, otherwise a public key could be used as HMAC secret.
+func (manager *JWTManager) accessTokenKey(token *jwt.Token) (interface{}, error) {
+	if manager.keySet == nil {
+		_, ok := token.Method.(*jwt.SigningMethodHMAC)
+		if !ok {
+			return nil, fmt.Errorf("unexpected token signing method")
+		}
+		return []byte(manager.secretKey), nil
+	}
+	
+	kid, _ := token.Header["kid"].(string)
+	key, ok := manager.keySet.Find(kid)
+	if !ok {
+		return nil, fmt.Errorf("unknown signing key: %v", kid)
+	}
+	if token.Method.Alg() != key.Method.Alg() {
+		return nil, fmt.Errorf("unexpected token signing method")
+	}
+	return key.PrivateKey.Public(), nil
+}
+
+func subjectUserID(subject string) int32 {
+	id, err := strconv.ParseInt(subject, 10, 32)
+	if err != nil || id <= 0 {
+		return 0
+	}
+	return int32(id)
+}
@@ -0,0 +1,54 @@
+package services
+
+import (
+	"github.com/ranabd36/project-qa/pb"
+	"strings"
+	"testing"
+	"time"
+)
+
+const testSecretKey = "0123456789abcdef0123456789abcdef"
+
+func TestNewJWTManagerRejectsWeakSecrets(t *testing.T) {
+	for _, secret := range []string{"", "secret", strings.Repeat("x", minSecretKeyLength-1)} {
+		if _, err := NewJWTManager(secret, time.Minute, nil); err == nil {
+			t.Errorf("secret %q: accepted", secret)
+		}
+	}
+	if _, err := NewJWTManager(testSecretKey, time.Minute, nil); err != nil {
+		t.Errorf("secret of %v bytes: %v", len(testSecretKey), err)
+	}
+}
+
+func TestMFAChallengeNeedsTheSecretKey(t *testing.T) {
+	manager, _ := NewJWTManager(testSecretKey, time.Minute, nil)
+	user := &pb.User{Id: 42, Username: "alice", Email: "alice@example.com"}
+
+	challenge, err := manager.GenerateMFAChallenge(user, time.Minute)
+	if err != nil {
+		t.Fatalf("GenerateMFAChallenge() = %v", err)
+	}
+	if id, err := manager.VerifyMFAChallenge(challenge); err != nil || id != user.GetId() {
+		t.Errorf("VerifyMFAChallenge() = %v, %v, want %v", id, err, user.GetId())
+	}
+
+	other, _ := NewJWTManager(strings.ToUpper(testSecretKey), time.Minute, nil)
+	forged, _ := other.GenerateMFAChallenge(user, time.Minute)
+	if _, err := manager.VerifyMFAChallenge(forged); err == nil {
+		t.Error("challenge signed with another secret key verified")
+	}
+
+	// purpose tokens cannot stand in for each other
+	verification, _ := manager.GenerateEmailVerification(user, time.Minute)
+	if _, err := manager.VerifyMFAChallenge(verification); err == nil {
+		t.Error("email verification token verified as an MFA challenge")
+	}
+	if _, err := manager.VerifyEmailVerification(challenge); err == nil {
+		t.Error("MFA challenge verified as an email verification token")
+	}
+
+	expired, _ := manager.GenerateMFAChallenge(user, -time.Minute)
+	if _, err := manager.VerifyMFAChallenge(expired); err == nil {
+		t.Error("expired challenge verified")
+	}
+}
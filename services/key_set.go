@@ -0,0 +1,258 @@
+package services
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"fmt"
+	"github.com/dgrijalva/jwt-go"
+	"github.com/ranabd36/project-qa/pb"
+	"io/ioutil"
+	"log"
+	"math/big"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+// SigningKey is a private key used to sign access tokens, identified by the
+// kid header of the tokens it signed.
+type SigningKey struct {
+	ID         string
+	Method     jwt.SigningMethod
+	PrivateKey crypto.Signer
+}
+
+// KeySet holds the keys loaded from a key directory. Every *.pem file holds a
+// PKCS#8, PKCS#1 (RSA) or SEC 1 (EC) private key and its file name without the
+// extension is the key ID. The active key signs new tokens, all others stay
+// valid for verification, so a key can be rotated by adding a new file and
+// removing the old one once the tokens it signed expired.
+type KeySet struct {
+	dir      string
+	activeID string
+	mutex    sync.RWMutex
+	keys     map[string]*SigningKey
+	active   *SigningKey
+}
+
+// NewKeySet returns a key set reading the keys of dir. activeID selects the key
+// signing new tokens; when empty the key with the greatest ID is used, so keys
+// named by their creation date rotate without a configuration change.
+func NewKeySet(dir string, activeID string) *KeySet {
+	return &KeySet{dir: dir, activeID: activeID}
+}
+
+// Load replaces the keys with the ones currently in the key directory.
+func (set *KeySet) Load() error {
+	paths, err := filepath.Glob(filepath.Join(set.dir, "*.pem"))
+	if err != nil {
+		return err
+	}
+
+	keys := make(map[string]*SigningKey)
+	var ids []string
+	for _, path := range paths {
+		id := strings.TrimSuffix(filepath.Base(path), ".pem")
+		key, err := loadSigningKey(id, path)
+		if err != nil {
+			return fmt.Errorf("failed to load key %v: %w", path, err)
+		}
+		keys[id] = key
+		ids = append(ids, id)
+	}
+	if len(keys) == 0 {
+		return fmt.Errorf("no keys found in %v", set.dir)
+	}
+
+	sort.Strings(ids)
+	activeID := set.activeID
+	if activeID == "" {
+		activeID = ids[len(ids)-1]
+	}
+	active, ok := keys[activeID]
+	if !ok {
+		return fmt.Errorf("active key %v not found in %v", activeID, set.dir)
+	}
+
+	set.mutex.Lock()
+	defer set.mutex.Unlock()
+	set.keys = keys
+	set.active = active
+	return nil
+}
+
+// Watch reloads the keys every interval until stop is closed. A failed reload
+// keeps the previous keys.
+func (set *KeySet) Watch(interval time.Duration, stop <-chan struct{}) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-stop:
+			return
+		case <-ticker.C:
+			if err := set.Load(); err != nil {
+				log.Printf("failed to reload signing keys: %v", err)
+			}
+		}
+	}
+}
+
+// Active returns the key signing new tokens.
+func (set *KeySet) Active() *SigningKey {
+	set.mutex.RLock()
+	defer set.mutex.RUnlock()
+	return set.active
+}
+
+// Find returns the key with the given ID.
+func (set *KeySet) Find(id string) (*SigningKey, bool) {
+	set.mutex.RLock()
+	defer set.mutex.RUnlock()
+	key, ok := set.keys[id]
+	return key, ok
+}
+
+// PublicKeys returns the public part of every key as JSON Web Keys (RFC 7517).
+func (set *KeySet) PublicKeys() []*pb.JSONWebKey {
+	set.mutex.RLock()
+	defer set.mutex.RUnlock()
+
+	jwks := make([]*pb.JSONWebKey, 0, len(set.keys))
+	for _, key := range set.keys {
+		jwks = append(jwks, key.JSONWebKey())
+	}
+	sort.Slice(jwks, func(i, j int) bool {
+		return jwks[i].GetKid() < jwks[j].GetKid()
+	})
+	return jwks
+}
+
+// JSONWebKey returns the public key as JSON Web Key.
+func (key *SigningKey) JSONWebKey() *pb.JSONWebKey {
+	jwk := &pb.JSONWebKey{
+		Kid: key.ID,
+		Use: "sig",
+		Alg: key.Method.Alg(),
+	}
+	switch public := key.PrivateKey.Public().(type) {
+	case *rsa.PublicKey:
+		jwk.Kty = "RSA"
+		jwk.N = encodeKeyPart(public.N.Bytes())
+		jwk.E = encodeKeyPart(big.NewInt(int64(public.E)).Bytes())
+	case *ecdsa.PublicKey:
+		size := (public.Curve.Params().BitSize + 7) / 8
+		jwk.Kty = "EC"
+		jwk.Crv = public.Curve.Params().Name
+		jwk.X = encodeKeyPart(padKeyPart(public.X.Bytes(), size))
+		jwk.Y = encodeKeyPart(padKeyPart(public.Y.Bytes(), size))
+	case ed25519.PublicKey:
+		jwk.Kty = "OKP"
+		jwk.Crv = "Ed25519"
+		jwk.X = encodeKeyPart(public)
+	}
+	return jwk
+}
+
+func loadSigningKey(id string, path string) (*SigningKey, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, fmt.Errorf("no PEM data found")
+	}
+
+	var privateKey interface{}
+	switch block.Type {
+	case "PRIVATE KEY":
+		privateKey, err = x509.ParsePKCS8PrivateKey(block.Bytes)
+	case "RSA PRIVATE KEY":
+		privateKey, err = x509.ParsePKCS1PrivateKey(block.Bytes)
+	case "EC PRIVATE KEY":
+		privateKey, err = x509.ParseECPrivateKey(block.Bytes)
+	default:
+		return nil, fmt.Errorf("unsupported PEM block type: %v", block.Type)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	switch privateKey := privateKey.(type) {
+	case *rsa.PrivateKey:
+		if privateKey.N.BitLen() < 2048 {
+			return nil, fmt.Errorf("RSA keys must have at least 2048 bits")
+		}
+		return &SigningKey{id, jwt.SigningMethodRS256, privateKey}, nil
+	case *ecdsa.PrivateKey:
+		if privateKey.Curve != elliptic.P256() {
+			return nil, fmt.Errorf("only P-256 EC keys are supported")
+		}
+		return &SigningKey{id, jwt.SigningMethodES256, privateKey}, nil
+	case ed25519.PrivateKey:
+		return &SigningKey{id, SigningMethodEdDSA, privateKey}, nil
+	}
+	return nil, fmt.Errorf("unsupported key type: %T", privateKey)
+}
+
+func encodeKeyPart(data []byte) string {
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+// padKeyPart left pads EC coordinates to the curve size as RFC 7518 requires.
+func padKeyPart(data []byte, size int) []byte {
+	if len(data) >= size {
+		return data
+	}
+	padded := make([]byte, size)
+	copy(padded[size-len(data):], data)
+	return padded
+}
+
+// SigningMethodEdDSA signs tokens with Ed25519 keys (RFC 8037), which jwt-go
+// does not support by itself.
+var SigningMethodEdDSA = &signingMethodEdDSA{}
+
+type signingMethodEdDSA struct{}
+
+func init() {
+	jwt.RegisterSigningMethod(SigningMethodEdDSA.Alg(), func() jwt.SigningMethod {
+		return SigningMethodEdDSA
+	})
+}
+
+func (method *signingMethodEdDSA) Alg() string {
+	return "EdDSA"
+}
+
+func (method *signingMethodEdDSA) Sign(signingString string, key interface{}) (string, error) {
+	privateKey, ok := key.(ed25519.PrivateKey)
+	if !ok {
+		return "", jwt.ErrInvalidKeyType
+	}
+	return jwt.EncodeSegment(ed25519.Sign(privateKey, []byte(signingString))), nil
+}
+
+func (method *signingMethodEdDSA) Verify(signingString string, signature string, key interface{}) error {
+	publicKey, ok := key.(ed25519.PublicKey)
+	if !ok {
+		return jwt.ErrInvalidKeyType
+	}
+	sig, err := jwt.DecodeSegment(signature)
+	if err != nil {
+		return err
+	}
+	if !ed25519.Verify(publicKey, []byte(signingString), sig) {
+		return jwt.ErrSignatureInvalid
+	}
+	return nil
+}
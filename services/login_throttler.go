@@ -0,0 +1,115 @@
+package services
+
+import (
+	"github.com/golang/protobuf/ptypes"
+	"github.com/ranabd36/project-qa/config"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"strings"
+	"time"
+)
+
+type loginFailureStorage interface {
+	RecordLoginFailure(key string, now time.Time, windowStart time.Time) (int32, error)
+	LockLogin(key string, until time.Time) error
+	FindLoginLock(keys []string, now time.Time) (time.Time, error)
+	ClearLoginFailures(key string) error
+}
+
+// LoginThrottler slows down password guessing. Failed logins are counted per
+// username and per peer address; once a counter exceeds its limit the key is
+// locked for a period doubling with every further failure.
+type LoginThrottler struct {
+	store  loginFailureStorage
+	config config.LoginConfig
+}
+
+func NewLoginThrottler(store loginFailureStorage, loginConfig *config.LoginConfig) *LoginThrottler {
+	return &LoginThrottler{store, *loginConfig}
+}
+
+// Check returns a ResourceExhausted status with a RetryInfo detail if the
+// username or the address is locked. Unknown usernames are locked the same
+// way as existing ones, so the answer tells nothing about the account.
+func (throttler *LoginThrottler) Check(username string, address string) error {
+	now := time.Now().UTC()
+	lockedUntil, err := throttler.store.FindLoginLock(throttler.keys(username, address), now)
+	if err != nil {
+		return status.Error(codes.Internal, "failed to check login attempts")
+	}
+	if lockedUntil.IsZero() {
+		return nil
+	}
+
+	st := status.New(codes.ResourceExhausted, "too many failed login attempts, try again later")
+	retryInfo := &errdetails.RetryInfo{RetryDelay: ptypes.DurationProto(lockedUntil.Sub(now).Round(time.Second))}
+	if detailed, err := st.WithDetails(retryInfo); err == nil {
+		st = detailed
+	}
+	return st.Err()
+}
+
+// Fail records a failed login for the username and the address, locking them
+// once they run out of attempts.
+func (throttler *LoginThrottler) Fail(username string, address string) error {
+	now := time.Now().UTC()
+	windowStart := now.Add(-throttler.config.AttemptWindow)
+
+	limits := map[string]int{userLoginKey(username): throttler.config.MaxAttempts}
+	if address != "" {
+		limits[addressLoginKey(address)] = throttler.config.MaxAttemptsPerIP
+	}
+	for key, limit := range limits {
+		failures, err := throttler.store.RecordLoginFailure(key, now, windowStart)
+		if err != nil {
+			return err
+		}
+		if int(failures) < limit {
+			continue
+		}
+		if err := throttler.store.LockLogin(key, now.Add(throttler.lockout(int(failures)-limit))); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Succeed forgets the failed logins of the username. The address counter is
+// kept, otherwise a single valid account would reset the limit of the address.
+func (throttler *LoginThrottler) Succeed(username string) error {
+	return throttler.store.ClearLoginFailures(userLoginKey(username))
+}
+
+// Unlock lifts the lock and forgets the failed logins of the username.
+func (throttler *LoginThrottler) Unlock(username string) error {
+	return throttler.store.ClearLoginFailures(userLoginKey(username))
+}
+
+// lockout doubles the base lockout for every failure beyond the limit.
+func (throttler *LoginThrottler) lockout(excess int) time.Duration {
+	lockout := throttler.config.LockoutBase
+	for i := 0; i < excess && lockout < throttler.config.LockoutMax; i++ {
+		lockout *= 2
+	}
+	if lockout > throttler.config.LockoutMax {
+		lockout = throttler.config.LockoutMax
+	}
+	return lockout
+}
+
+func (throttler *LoginThrottler) keys(username string, address string) []string {
+	keys := []string{userLoginKey(username)}
+	if address != "" {
+		keys = append(keys, addressLoginKey(address))
+	}
+	return keys
+}
+
+func userLoginKey(username string) string {
+	return "user:" + strings.ToLower(username)
+}
+
+func addressLoginKey(address string) string {
+	return "ip:" + address
+}
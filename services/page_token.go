@@ -0,0 +1,64 @@
+package services
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/ranabd36/project-qa/database/store"
+	"hash/fnv"
+	"strconv"
+)
+
+// pageToken is the decoded form of the opaque page tokens handed to clients.
+// Sort and Desc pin the ordering the cursor belongs to, Filter the listing.
+type pageToken struct {
+	Sort   int32  `json:"s"`
+	Desc   bool   `json:"d"`
+	Filter string `json:"f,omitempty"`
+	Value  string `json:"v,omitempty"`
+	ID     int64  `json:"i"`
+}
+
+var errInvalidPageToken = errors.New("invalid page token")
+
+// filterKey condenses the values of a listing filter into the Filter of its
+// page tokens.
+func filterKey(values ...interface{}) string {
+	hash := fnv.New64a()
+	for _, value := range values {
+		fmt.Fprintf(hash, "%v\x00", value)
+	}
+	return strconv.FormatUint(hash.Sum64(), 36)
+}
+
+func encodePageToken(token pageToken) string {
+	data, _ := json.Marshal(token)
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+// decodePageToken returns the cursor of the token, or nil for an empty token.
+// Tokens issued for a different ordering are rejected.
+func decodePageToken(encoded string, sort int32, desc bool) (*store.Cursor, error) {
+	return decodeFilteredPageToken(encoded, sort, desc, "")
+}
+
+// decodeFilteredPageToken is decodePageToken for listings recording their
+// filter in the tokens. Tokens issued for a different filter are rejected.
+func decodeFilteredPageToken(encoded string, sort int32, desc bool, filter string) (*store.Cursor, error) {
+	if encoded == "" {
+		return nil, nil
+	}
+	data, err := base64.RawURLEncoding.DecodeString(encoded)
+	if err != nil {
+		return nil, errInvalidPageToken
+	}
+	var token pageToken
+	if err := json.Unmarshal(data, &token); err != nil {
+		return nil, errInvalidPageToken
+	}
+	if token.Sort != sort || token.Desc != desc || token.Filter != filter || token.ID <= 0 {
+		return nil, errInvalidPageToken
+	}
+	return &store.Cursor{Value: token.Value, ID: token.ID}, nil
+}
@@ -0,0 +1,143 @@
+package services
+
+import (
+	"bufio"
+	"fmt"
+	"github.com/ranabd36/project-qa/config"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"os"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// bcrypt ignores everything after the 72nd byte of a password.
+const maxBcryptPasswordLength = 72
+
+// minIdentityLength keeps very short usernames from rejecting half of all passwords.
+const minIdentityLength = 3
+
+// PasswordPolicy is the single place deciding whether a password is acceptable.
+// Every RPC accepting a new password validates it here.
+type PasswordPolicy struct {
+	config    config.PasswordConfig
+	blocklist map[string]bool
+}
+
+func NewPasswordPolicy(passwordConfig *config.PasswordConfig) (*PasswordPolicy, error) {
+	policy := &PasswordPolicy{
+		config:    *passwordConfig,
+		blocklist: make(map[string]bool),
+	}
+	if policy.config.MinLength < 1 {
+		return nil, fmt.Errorf("password min length must be at least 1")
+	}
+	if policy.config.MaxLength < policy.config.MinLength || policy.config.MaxLength > maxBcryptPasswordLength {
+		return nil, fmt.Errorf("password max length must be between min length and %v", maxBcryptPasswordLength)
+	}
+	if policy.config.BlocklistFile != "" {
+		if err := policy.loadBlocklist(policy.config.BlocklistFile); err != nil {
+			return nil, fmt.Errorf("failed to load password blocklist: %w", err)
+		}
+	}
+	return policy, nil
+}
+
+// loadBlocklist reads one password per line, ignoring empty lines and lines starting with #.
+func (policy *PasswordPolicy) loadBlocklist(path string) error {
+	file, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		policy.blocklist[strings.ToLower(line)] = true
+	}
+	return scanner.Err()
+}
+
+// Violations returns a description of every rule the password breaks.
+// identities are the username, email and similar values the password must not contain.
+func (policy *PasswordPolicy) Violations(password string, identities ...string) []string {
+	var violations []string
+
+	length := utf8.RuneCountInString(password)
+	if length < policy.config.MinLength {
+		violations = append(violations, fmt.Sprintf("must be at least %v characters long", policy.config.MinLength))
+	}
+	if len(password) > policy.config.MaxLength {
+		violations = append(violations, fmt.Sprintf("must be at most %v bytes long", policy.config.MaxLength))
+	}
+
+	var hasUpper, hasLower, hasDigit, hasSymbol bool
+	for _, r := range password {
+		switch {
+		case unicode.IsUpper(r):
+			hasUpper = true
+		case unicode.IsLower(r):
+			hasLower = true
+		case unicode.IsDigit(r):
+			hasDigit = true
+		case unicode.IsPunct(r) || unicode.IsSymbol(r) || unicode.IsSpace(r):
+			hasSymbol = true
+		}
+	}
+	if policy.config.RequireUpper && !hasUpper {
+		violations = append(violations, "must contain an upper case letter")
+	}
+	if policy.config.RequireLower && !hasLower {
+		violations = append(violations, "must contain a lower case letter")
+	}
+	if policy.config.RequireDigit && !hasDigit {
+		violations = append(violations, "must contain a digit")
+	}
+	if policy.config.RequireSymbol && !hasSymbol {
+		violations = append(violations, "must contain a symbol")
+	}
+
+	lowered := strings.ToLower(password)
+	if policy.blocklist[lowered] {
+		violations = append(violations, "is too common, choose a less guessable password")
+	}
+	if policy.config.DisallowIdentity {
+		for _, identity := range identities {
+			// only the local part of an email is something people put in passwords
+			identity = strings.ToLower(strings.SplitN(identity, "@", 2)[0])
+			if utf8.RuneCountInString(identity) >= minIdentityLength && strings.Contains(lowered, identity) {
+				violations = append(violations, "must not contain your username or email")
+				break
+			}
+		}
+	}
+	return violations
+}
+
+// Validate returns an InvalidArgument status carrying a BadRequest detail with
+// one field violation per broken rule, or nil when the password is acceptable.
+func (policy *PasswordPolicy) Validate(field string, password string, identities ...string) error {
+	violations := policy.Violations(password, identities...)
+	if len(violations) == 0 {
+		return nil
+	}
+
+	badRequest := &errdetails.BadRequest{}
+	for _, violation := range violations {
+		badRequest.FieldViolations = append(badRequest.FieldViolations, &errdetails.BadRequest_FieldViolation{
+			Field:       field,
+			Description: violation,
+		})
+	}
+	st := status.New(codes.InvalidArgument, fmt.Sprintf("%v %v", strings.ReplaceAll(field, "_", " "), strings.Join(violations, ", ")))
+	if detailed, err := st.WithDetails(badRequest); err == nil {
+		st = detailed
+	}
+	return st.Err()
+}
@@ -0,0 +1,527 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/ranabd36/project-qa/database/store"
+	"github.com/ranabd36/project-qa/pb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	maxQuestionTags  = 5
+	maxTagLength     = 40
+	defaultPageSize  = 20
+	maxPageSize      = 100
+	defaultTagsLimit = 10
+)
+
+var tagPattern = regexp.MustCompile(`^[a-z0-9][a-z0-9+#.-]*$`)
+
+type questionStorage interface {
+	SaveQuestion(question *pb.Question) error
+	FindQuestion(id int32) (*pb.Question, error)
+	UpdateQuestion(question *pb.Question, editorID int32) error
+	DeleteQuestion(id int32) error
+	PublishQuestion(id int32) error
+	UnpublishQuestion(id int32) error
+	ListQuestions(filter store.QuestionFilter) ([]*pb.Question, error)
+	ListTags(prefix string, limit int32) ([]*pb.Tag, error)
+}
+
+// QuestionWatcher subscribes to the events of a question. It is implemented by
+// events.Hub.
+type QuestionWatcher interface {
+	Subscribe(questionID int32) (<-chan *store.QuestionEvent, func())
+}
+
+var questionEventTypes = map[string]pb.QuestionEvent_Type{
+	store.EventAnswerPosted:        pb.QuestionEvent_ANSWER_POSTED,
+	store.EventAnswerUpdated:       pb.QuestionEvent_ANSWER_UPDATED,
+	store.EventAnswerDeleted:       pb.QuestionEvent_ANSWER_DELETED,
+	store.EventAnswerAccepted:      pb.QuestionEvent_ANSWER_ACCEPTED,
+	store.EventQuestionUpdated:     pb.QuestionEvent_QUESTION_UPDATED,
+	store.EventQuestionDeleted:     pb.QuestionEvent_QUESTION_DELETED,
+	store.EventQuestionPublished:   pb.QuestionEvent_QUESTION_PUBLISHED,
+	store.EventQuestionUnpublished: pb.QuestionEvent_QUESTION_UNPUBLISHED,
+	store.EventScoreChanged:        pb.QuestionEvent_SCORE_CHANGED,
+}
+
+// watchCheckInterval is how often the credentials and account of watchers are
+// checked again.
+const watchCheckInterval = time.Minute
+
+type QuestionServiceServer struct {
+	questionStore questionStorage
+	answerStore   answerStorage
+	userStore     userStorage
+	watcher       QuestionWatcher
+}
+
+func NewQuestionServiceServer(questionStore questionStorage, answerStore answerStorage, userStore userStorage, watcher QuestionWatcher) *QuestionServiceServer {
+	return &QuestionServiceServer{questionStore, answerStore, userStore, watcher}
+}
+
+func (server *QuestionServiceServer) CreateQuestion(ctx context.Context, req *pb.CreateQuestionRequest) (*pb.CreateQuestionResponse, error) {
+	if err := server.validateQuestion(req.GetQuestion()); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	user, err := currentUser(ctx, server.userStore)
+	if err != nil {
+		return nil, err
+	}
+
+	question := req.GetQuestion()
+	question.UserId = user.GetId()
+	if err := server.questionStore.SaveQuestion(question); err != nil {
+		if err == store.ErrAlreadyExists {
+			return nil, status.Error(codes.AlreadyExists, "question with this title already exists!")
+		}
+		return nil, status.Error(codes.Internal, "unable to save question")
+	}
+	return &pb.CreateQuestionResponse{
+		Id: question.GetId(),
+	}, nil
+}
+
+func (server *QuestionServiceServer) GetQuestion(ctx context.Context, req *pb.GetQuestionRequest) (*pb.GetQuestionResponse, error) {
+	questionID := req.GetId()
+	if err := server.validateQuestionId(questionID); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	question, err := server.questionStore.FindQuestion(questionID)
+	if err != nil {
+		return nil, status.Errorf(codes.NotFound, "question not found with ID: %v", questionID)
+	}
+
+	// unpublished questions are drafts, only visible to the owner and moderators
+	if question.GetPublishedAt() == nil {
+		if _, err := server.authorizeOwner(ctx, question); err != nil {
+			return nil, status.Errorf(codes.NotFound, "question not found with ID: %v", questionID)
+		}
+	}
+	return &pb.GetQuestionResponse{
+		Question: question,
+	}, nil
+}
+
+func (server *QuestionServiceServer) UpdateQuestion(ctx context.Context, req *pb.UpdateQuestionRequest) (*pb.UpdateQuestionResponse, error) {
+	questionID := req.GetQuestion().GetId()
+	if err := server.validateQuestionId(questionID); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if err := server.validateQuestion(req.GetQuestion()); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	question, err := server.questionStore.FindQuestion(questionID)
+	if err != nil {
+		return nil, status.Errorf(codes.NotFound, "question not found with ID: %v", questionID)
+	}
+
+	editor, err := server.authorizeOwner(ctx, question)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := server.questionStore.UpdateQuestion(req.GetQuestion(), editor.GetId()); err != nil {
+		if err == store.ErrAlreadyExists {
+			return nil, status.Error(codes.AlreadyExists, "question with this title already exists!")
+		}
+		return nil, status.Error(codes.Internal, "failed to update question")
+	}
+
+	return &pb.UpdateQuestionResponse{
+		IsUpdated: true,
+	}, nil
+}
+
+func (server *QuestionServiceServer) DeleteQuestion(ctx context.Context, req *pb.DeleteQuestionRequest) (*pb.DeleteQuestionResponse, error) {
+	questionID := req.GetId()
+	if err := server.validateQuestionId(questionID); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	question, err := server.questionStore.FindQuestion(questionID)
+	if err != nil {
+		return nil, status.Errorf(codes.NotFound, "question not found with ID: %v", questionID)
+	}
+
+	if _, err := server.authorizeOwner(ctx, question); err != nil {
+		return nil, err
+	}
+
+	if err := server.questionStore.DeleteQuestion(questionID); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to delete question with ID: %v", questionID)
+	}
+
+	return &pb.DeleteQuestionResponse{
+		IsDeleted: true,
+	}, nil
+}
+
+func (server *QuestionServiceServer) PublishQuestion(ctx context.Context, req *pb.PublishQuestionRequest) (*pb.PublishQuestionResponse, error) {
+	questionID := req.GetId()
+	if err := server.validateQuestionId(questionID); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	question, err := server.questionStore.FindQuestion(questionID)
+	if err != nil {
+		return nil, status.Errorf(codes.NotFound, "question not found with ID: %v", questionID)
+	}
+
+	if _, err := server.authorizeOwner(ctx, question); err != nil {
+		return nil, err
+	}
+
+	if question.GetPublishedAt() != nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "question with ID: %v is already published", questionID)
+	}
+
+	if err := server.questionStore.PublishQuestion(questionID); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to publish question with ID: %v", questionID)
+	}
+
+	return &pb.PublishQuestionResponse{
+		IsPublished: true,
+	}, nil
+}
+
+func (server *QuestionServiceServer) UnpublishQuestion(ctx context.Context, req *pb.UnpublishQuestionRequest) (*pb.UnpublishQuestionResponse, error) {
+	questionID := req.GetId()
+	if err := server.validateQuestionId(questionID); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	question, err := server.questionStore.FindQuestion(questionID)
+	if err != nil {
+		return nil, status.Errorf(codes.NotFound, "question not found with ID: %v", questionID)
+	}
+
+	if _, err := server.authorizeOwner(ctx, question); err != nil {
+		return nil, err
+	}
+
+	if question.GetPublishedAt() == nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "question with ID: %v is not published", questionID)
+	}
+
+	if err := server.questionStore.UnpublishQuestion(questionID); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to unpublish question with ID: %v", questionID)
+	}
+
+	return &pb.UnpublishQuestionResponse{
+		IsUnpublished: true,
+	}, nil
+}
+
+// ListQuestions returns a page of the published questions of the feed. The
+// author listing their own questions also gets their unpublished ones. The
+// trending feed is paged by the trending score of the last question as of
+// the request, so votes cast in between can move questions across pages.
+func (server *QuestionServiceServer) ListQuestions(ctx context.Context, req *pb.ListQuestionsRequest) (*pb.ListQuestionsResponse, error) {
+	filter, err := server.questionFilter(ctx, req)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	// fetch one extra row to find out whether there is a next page
+	limit := filter.Limit
+	filter.Limit++
+	questions, err := server.questionStore.ListQuestions(filter)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to list questions")
+	}
+
+	res := &pb.ListQuestionsResponse{}
+	if int32(len(questions)) > limit {
+		questions = questions[:limit]
+		res.NextPageToken = server.nextPageToken(filter, questions[len(questions)-1])
+	}
+	res.Questions = questions
+	return res, nil
+}
+
+func (server *QuestionServiceServer) questionFilter(ctx context.Context, req *pb.ListQuestionsRequest) (store.QuestionFilter, error) {
+	filter := store.QuestionFilter{
+		Feed:         store.QuestionFeed(req.GetFeed()),
+		MatchAllTags: req.GetTagMatch() == pb.ListQuestionsRequest_ALL,
+		Limit:        pageSize(req.GetPageSize()),
+	}
+	if _, ok := pb.ListQuestionsRequest_Feed_name[int32(req.GetFeed())]; !ok {
+		return filter, errors.New("invalid feed given")
+	}
+	if req.GetFeed() == pb.ListQuestionsRequest_BY_USER {
+		if req.GetUserId() <= 0 {
+			return filter, errors.New("invalid user id given")
+		}
+		filter.UserID = req.GetUserId()
+		claims, ok := ClaimsFromContext(ctx)
+		filter.Drafts = ok && claims.UserID() == req.GetUserId()
+	}
+	tags, err := normalizeTags(req.GetTags())
+	if err != nil {
+		return filter, err
+	}
+	filter.Tags = tags
+
+	after, err := decodeFilteredPageToken(req.GetPageToken(), int32(req.GetFeed()), true, questionFilterKey(filter))
+	if err != nil {
+		return filter, err
+	}
+	if after != nil && filter.Feed == store.QuestionFeedTrending {
+		if _, err := strconv.ParseInt(after.Value, 10, 64); err != nil {
+			return filter, errInvalidPageToken
+		}
+	}
+	filter.After = after
+	return filter, nil
+}
+
+func (server *QuestionServiceServer) nextPageToken(filter store.QuestionFilter, last *pb.Question) string {
+	token := pageToken{
+		Sort:   int32(filter.Feed),
+		Desc:   true,
+		Filter: questionFilterKey(filter),
+		ID:     int64(last.GetId()),
+	}
+	publishedAt, _ := ptypes.Timestamp(last.GetPublishedAt())
+	switch {
+	case filter.Feed == store.QuestionFeedTrending:
+		token.Value = strconv.FormatInt(publishedAt.Unix()+int64(last.GetScore())*store.TrendingVoteSeconds, 10)
+	case last.GetPublishedAt() == nil:
+		// drafts are ordered by their creation
+		createdAt, _ := ptypes.Timestamp(last.GetCreatedAt())
+		token.Value = createdAt.UTC().Format(store.CursorTimeLayout)
+	default:
+		token.Value = publishedAt.UTC().Format(store.CursorTimeLayout)
+	}
+	return encodePageToken(token)
+}
+
+// questionFilterKey identifies the questions selected by the filter, so page
+// tokens cannot be replayed against another listing.
+func questionFilterKey(filter store.QuestionFilter) string {
+	tags := append([]string(nil), filter.Tags...)
+	sort.Strings(tags)
+	return filterKey(filter.UserID, filter.Drafts, filter.MatchAllTags, strings.Join(tags, ","))
+}
+
+func (server *QuestionServiceServer) ListTags(ctx context.Context, req *pb.ListTagsRequest) (*pb.ListTagsResponse, error) {
+	limit := req.GetLimit()
+	if limit <= 0 {
+		limit = defaultTagsLimit
+	} else if limit > maxPageSize {
+		limit = maxPageSize
+	}
+
+	prefix := strings.ToLower(strings.TrimSpace(req.GetPrefix()))
+	tags, err := server.questionStore.ListTags(prefix, limit)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to list tags")
+	}
+	return &pb.ListTagsResponse{
+		Tags: tags,
+	}, nil
+}
+
+// WatchQuestion streams the events of the question until the client goes away
+// or the question is deleted. Unpublished questions can only be watched by
+// the owner and moderators, other watchers are sent QUESTION_UNPUBLISHED as
+// the last event once the question is unpublished.
+func (server *QuestionServiceServer) WatchQuestion(req *pb.WatchQuestionRequest, stream pb.QuestionService_WatchQuestionServer) error {
+	questionID := req.GetQuestionId()
+	if err := server.validateQuestionId(questionID); err != nil {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	// subscribe first, so no event between the check and the subscription is missed
+	events, cancel := server.watcher.Subscribe(questionID)
+	defer cancel()
+
+	ctx := stream.Context()
+	visible, err := server.canWatch(ctx, questionID)
+	if err != nil || !visible {
+		return status.Errorf(codes.NotFound, "question not found with ID: %v", questionID)
+	}
+
+	ticker := time.NewTicker(watchCheckInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+			if err := server.checkWatcher(ctx); err != nil {
+				return err
+			}
+		case event, ok := <-events:
+			if !ok {
+				return status.Error(codes.Aborted, "fell behind the events of the question, watch it again")
+			}
+			if event.Type == store.EventQuestionDeleted {
+				return stream.Send(&pb.QuestionEvent{Type: pb.QuestionEvent_QUESTION_DELETED, QuestionId: questionID})
+			}
+			// the question may have been unpublished since the last event
+			visible, err := server.canWatch(ctx, questionID)
+			if err != nil {
+				continue
+			}
+			if !visible {
+				return stream.Send(&pb.QuestionEvent{Type: pb.QuestionEvent_QUESTION_UNPUBLISHED, QuestionId: questionID})
+			}
+			res, ok := server.questionEvent(event)
+			if !ok {
+				continue
+			}
+			if err := stream.Send(res); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// canWatch reports whether the caller may see the events of the question. It
+// fails when the question cannot be loaded, e.g. because it was deleted.
+func (server *QuestionServiceServer) canWatch(ctx context.Context, questionID int32) (bool, error) {
+	question, err := server.questionStore.FindQuestion(questionID)
+	if err != nil {
+		return false, err
+	}
+	if question.GetPublishedAt() != nil {
+		return true, nil
+	}
+	_, err = server.authorizeOwner(ctx, question)
+	return err == nil, nil
+}
+
+// checkWatcher fails when the credentials of the watcher expired or were
+// revoked, or when their account was deleted or deactivated.
+func (server *QuestionServiceServer) checkWatcher(ctx context.Context) error {
+	if err := reauthorize(ctx); err != nil {
+		return err
+	}
+	user, err := currentUser(ctx, server.userStore)
+	if err != nil {
+		return err
+	}
+	if !user.GetIsActive() {
+		return status.Error(codes.Unauthenticated, "user account is deactivated")
+	}
+	return nil
+}
+
+// questionEvent loads the rows changed by the event. It reports false when
+// they are gone since.
+func (server *QuestionServiceServer) questionEvent(event *store.QuestionEvent) (*pb.QuestionEvent, bool) {
+	eventType, ok := questionEventTypes[event.Type]
+	if !ok {
+		return nil, false
+	}
+	res := &pb.QuestionEvent{
+		Type:       eventType,
+		QuestionId: event.QuestionID,
+		AnswerId:   event.AnswerID,
+		Score:      event.Score,
+	}
+	switch eventType {
+	case pb.QuestionEvent_ANSWER_POSTED, pb.QuestionEvent_ANSWER_UPDATED, pb.QuestionEvent_ANSWER_ACCEPTED:
+		answer, err := server.answerStore.FindAnswer(event.AnswerID)
+		if err != nil {
+			return nil, false
+		}
+		res.Answer = answer
+	case pb.QuestionEvent_QUESTION_UPDATED, pb.QuestionEvent_QUESTION_PUBLISHED, pb.QuestionEvent_QUESTION_UNPUBLISHED:
+		question, err := server.questionStore.FindQuestion(event.QuestionID)
+		if err != nil {
+			return nil, false
+		}
+		res.Question = question
+	}
+	return res, true
+}
+
+// authorizeOwner allows the question owner and moderators to manage the
+// question. It returns the current user.
+func (server *QuestionServiceServer) authorizeOwner(ctx context.Context, question *pb.Question) (*pb.User, error) {
+	user, err := currentUser(ctx, server.userStore)
+	if err != nil {
+		return nil, err
+	}
+	if hasPermission(ctx, PermissionModerateQuestions) || user.GetId() == question.GetUserId() {
+		return user, nil
+	}
+	return nil, status.Error(codes.PermissionDenied, "only the owner or a moderator can change this question")
+}
+
+func (server *QuestionServiceServer) validateQuestionId(questionID int32) error {
+	if questionID <= 0 {
+		return errors.New("invalid question id given")
+	}
+	return nil
+}
+
+func (server *QuestionServiceServer) validateQuestion(question *pb.Question) error {
+	err := ""
+	if question == nil {
+		err = "question is required"
+	} else if question.GetTitle() == "" {
+		err = "title is required"
+	} else if len(question.GetTitle()) > 255 {
+		err = "title must be less than or equal to 255 characters."
+	} else if question.GetDescription() == "" {
+		err = "description is required"
+	} else if tags, tagErr := normalizeTags(question.GetTags()); tagErr != nil {
+		err = tagErr.Error()
+	} else if len(tags) > maxQuestionTags {
+		err = "a question can have at most 5 tags."
+	} else {
+		question.Tags = tags
+	}
+
+	if len(err) > 0 {
+		return errors.New(err)
+	}
+	return nil
+}
+
+// normalizeTags lower-cases, trims and de-duplicates the given tags.
+func normalizeTags(tags []string) ([]string, error) {
+	seen := make(map[string]bool, len(tags))
+	var normalized []string
+	for _, tag := range tags {
+		tag = strings.ToLower(strings.TrimSpace(tag))
+		if tag == "" || seen[tag] {
+			continue
+		}
+		if len(tag) > maxTagLength {
+			return nil, errors.New("tag must be less than or equal to 40 characters.")
+		}
+		if !tagPattern.MatchString(tag) {
+			return nil, errors.New("tag may only contain letters, digits and the characters + # . -")
+		}
+		seen[tag] = true
+		normalized = append(normalized, tag)
+	}
+	return normalized, nil
+}
+
+func pageSize(size int32) int32 {
+	if size <= 0 {
+		return defaultPageSize
+	}
+	if size > maxPageSize {
+		return maxPageSize
+	}
+	return size
+}
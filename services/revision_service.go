@@ -0,0 +1,191 @@
+package services
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"github.com/ranabd36/project-qa/database/store"
+	"github.com/ranabd36/project-qa/pb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"strconv"
+)
+
+type revisionStorage interface {
+	ListRevisions(target pb.Revision_Target, targetID int32, before int32, limit int32) ([]*pb.Revision, error)
+	FindRevision(target pb.Revision_Target, targetID int32, revision int32) (*pb.Revision, error)
+	RollbackToRevision(target pb.Revision_Target, targetID int32, revision int32, editorID int32) (*pb.Revision, error)
+}
+
+type RevisionServiceServer struct {
+	revisionStore revisionStorage
+	questionStore questionStorage
+	answerStore   answerStorage
+	userStore     userStorage
+}
+
+func NewRevisionServiceServer(revisionStore revisionStorage, questionStore questionStorage, answerStore answerStorage, userStore userStorage) *RevisionServiceServer {
+	return &RevisionServiceServer{revisionStore, questionStore, answerStore, userStore}
+}
+
+// ListRevisions returns the revisions of a question or answer, newest first.
+// Revisions of unpublished questions are only visible to the owner and
+// moderators, like the questions themselves.
+func (server *RevisionServiceServer) ListRevisions(ctx context.Context, req *pb.ListRevisionsRequest) (*pb.ListRevisionsResponse, error) {
+	if err := server.validateTarget(req.GetTarget(), req.GetTargetId()); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	// the token is bound to the question or answer it was issued for
+	after, err := decodePageToken(req.GetPageToken(), int32(req.GetTarget()), true)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	var before int32
+	if after != nil {
+		if after.Value != strconv.Itoa(int(req.GetTargetId())) {
+			return nil, status.Error(codes.InvalidArgument, errInvalidPageToken.Error())
+		}
+		before = int32(after.ID)
+	}
+
+	if _, err := server.findTarget(ctx, req.GetTarget(), req.GetTargetId()); err != nil {
+		return nil, err
+	}
+
+	// fetch one extra row to find out whether there is a next page
+	limit := pageSize(req.GetPageSize())
+	revisions, err := server.revisionStore.ListRevisions(req.GetTarget(), req.GetTargetId(), before, limit+1)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to list revisions")
+	}
+
+	res := &pb.ListRevisionsResponse{}
+	if int32(len(revisions)) > limit {
+		revisions = revisions[:limit]
+		res.NextPageToken = encodePageToken(pageToken{
+			Sort:  int32(req.GetTarget()),
+			Desc:  true,
+			Value: strconv.Itoa(int(req.GetTargetId())),
+			ID:    int64(revisions[len(revisions)-1].GetRevision()),
+		})
+	}
+	res.Revisions = revisions
+	return res, nil
+}
+
+func (server *RevisionServiceServer) GetRevision(ctx context.Context, req *pb.GetRevisionRequest) (*pb.GetRevisionResponse, error) {
+	if err := server.validateTarget(req.GetTarget(), req.GetTargetId()); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if req.GetRevision() <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "invalid revision given")
+	}
+
+	if _, err := server.findTarget(ctx, req.GetTarget(), req.GetTargetId()); err != nil {
+		return nil, err
+	}
+
+	revision, err := server.revisionStore.FindRevision(req.GetTarget(), req.GetTargetId(), req.GetRevision())
+	if err != nil {
+		return nil, status.Errorf(codes.NotFound, "revision %v not found of %v with ID: %v", req.GetRevision(), revisionTargetName(req.GetTarget()), req.GetTargetId())
+	}
+	return &pb.GetRevisionResponse{
+		Revision: revision,
+	}, nil
+}
+
+// RollbackToRevision restores the text of an earlier revision as a new
+// revision. Only the owner and moderators can roll back. The method requires
+// questions.write, rolling back answers requires answers.write as well.
+func (server *RevisionServiceServer) RollbackToRevision(ctx context.Context, req *pb.RollbackToRevisionRequest) (*pb.RollbackToRevisionResponse, error) {
+	if err := server.validateTarget(req.GetTarget(), req.GetTargetId()); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if req.GetRevision() <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "invalid revision given")
+	}
+	auditTarget(ctx, revisionTargetName(req.GetTarget()), req.GetTargetId())
+	if req.GetTarget() == pb.Revision_ANSWER && !hasPermission(ctx, PermissionWriteAnswers) {
+		return nil, status.Error(codes.PermissionDenied, "no permission to change answers")
+	}
+
+	user, err := currentUser(ctx, server.userStore)
+	if err != nil {
+		return nil, err
+	}
+	ownerID, err := server.findTarget(ctx, req.GetTarget(), req.GetTargetId())
+	if err != nil {
+		return nil, err
+	}
+	moderate := PermissionModerateQuestions
+	if req.GetTarget() == pb.Revision_ANSWER {
+		moderate = PermissionModerateAnswers
+	}
+	if !hasPermission(ctx, moderate) && user.GetId() != ownerID {
+		return nil, status.Errorf(codes.PermissionDenied, "only the owner or a moderator can change this %v", revisionTargetName(req.GetTarget()))
+	}
+
+	revision, err := server.revisionStore.RollbackToRevision(req.GetTarget(), req.GetTargetId(), req.GetRevision(), user.GetId())
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, status.Errorf(codes.NotFound, "revision %v not found of %v with ID: %v", req.GetRevision(), revisionTargetName(req.GetTarget()), req.GetTargetId())
+		}
+		if err == store.ErrAlreadyExists {
+			return nil, status.Error(codes.AlreadyExists, "question with this title already exists!")
+		}
+		return nil, status.Error(codes.Internal, "failed to roll back")
+	}
+	auditChange(ctx, map[string]interface{}{"revision": req.GetRevision()}, map[string]interface{}{"revision": revision.GetRevision()})
+
+	return &pb.RollbackToRevisionResponse{
+		Revision: revision,
+	}, nil
+}
+
+// findTarget returns the owner of the question or answer. Targets of
+// unpublished questions are not found for anyone but the owner of the
+// question and moderators.
+func (server *RevisionServiceServer) findTarget(ctx context.Context, target pb.Revision_Target, targetID int32) (int32, error) {
+	notFound := status.Errorf(codes.NotFound, "%v not found with ID: %v", revisionTargetName(target), targetID)
+
+	questionID, ownerID := targetID, int32(0)
+	if target == pb.Revision_ANSWER {
+		answer, err := server.answerStore.FindAnswer(targetID)
+		if err != nil {
+			return 0, notFound
+		}
+		questionID, ownerID = answer.GetQuestionId(), answer.GetUserId()
+	}
+	question, err := server.questionStore.FindQuestion(questionID)
+	if err != nil {
+		return 0, notFound
+	}
+	if target == pb.Revision_QUESTION {
+		ownerID = question.GetUserId()
+	}
+
+	if question.GetPublishedAt() == nil {
+		claims, ok := ClaimsFromContext(ctx)
+		if !ok || (!claims.HasPermission(PermissionModerateQuestions) && claims.UserID() != question.GetUserId()) {
+			return 0, notFound
+		}
+	}
+	return ownerID, nil
+}
+
+func (server *RevisionServiceServer) validateTarget(target pb.Revision_Target, targetID int32) error {
+	if _, ok := pb.Revision_Target_name[int32(target)]; !ok {
+		return errors.New("invalid target given")
+	}
+	if targetID <= 0 {
+		return errors.New("invalid target id given")
+	}
+	return nil
+}
+
+func revisionTargetName(target pb.Revision_Target) string {
+	if target == pb.Revision_ANSWER {
+		return "answer"
+	}
+	return "question"
+}
@@ -0,0 +1,130 @@
+package services
+
+import (
+	"log"
+	"sync"
+	"time"
+)
+
+type revocationStorage interface {
+	RevokeToken(jti string, username string, expiresAt time.Time) error
+	RevokeUserTokens(username string, before time.Time) error
+	ListRevokedTokens(now time.Time) (map[string]time.Time, error)
+	ListUserTokenRevocations() (map[string]time.Time, error)
+	DeleteExpiredRevokedTokens(now time.Time) error
+}
+
+// RevocationList keeps an in-memory copy of the revoked access tokens so the
+// interceptor can reject them without a database round trip. Writes go to the
+// store first; Watch reloads the copy to pick up revocations of other instances.
+type RevocationList struct {
+	store  revocationStorage
+	mutex  sync.RWMutex
+	tokens map[string]time.Time
+	users  map[string]time.Time
+}
+
+func NewRevocationList(store revocationStorage) *RevocationList {
+	return &RevocationList{
+		store:  store,
+		tokens: make(map[string]time.Time),
+		users:  make(map[string]time.Time),
+	}
+}
+
+// Load replaces the in-memory copy with the revocations from the store.
+func (list *RevocationList) Load() error {
+	now := time.Now().UTC()
+	tokens, err := list.store.ListRevokedTokens(now)
+	if err != nil {
+		return err
+	}
+	users, err := list.store.ListUserTokenRevocations()
+	if err != nil {
+		return err
+	}
+
+	list.mutex.Lock()
+	defer list.mutex.Unlock()
+	list.tokens = tokens
+	list.users = users
+	return nil
+}
+
+// Watch reloads the revocations every interval until stop is closed.
+func (list *RevocationList) Watch(interval time.Duration, stop <-chan struct{}) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-stop:
+			return
+		case <-ticker.C:
+			if err := list.store.DeleteExpiredRevokedTokens(time.Now().UTC()); err != nil {
+				log.Printf("failed to delete expired revoked tokens: %v", err)
+			}
+			if err := list.Load(); err != nil {
+				log.Printf("failed to reload revoked tokens: %v", err)
+			}
+		}
+	}
+}
+
+// IsRevoked reports whether the token was revoked on its own or together with
+// all sessions of its user.
+func (list *RevocationList) IsRevoked(claims *UserClaims) bool {
+	list.mutex.RLock()
+	defer list.mutex.RUnlock()
+	if _, ok := list.tokens[claims.Id]; ok {
+		return true
+	}
+	revokedBefore, ok := list.users[claims.Username]
+	if !ok {
+		return false
+	}
+	if claims.IssuedAtUs != 0 {
+		// revocations are stored with microsecond precision, so a token issued
+		// right after the revocation stays valid
+		return claims.IssuedAtUs <= revokedBefore.UnixNano()/int64(time.Microsecond)
+	}
+	// tokens without the precise issue time only have seconds, one issued
+	// within the revocation second is revoked as well
+	return claims.IssuedAt <= revokedBefore.Unix()
+}
+
+// IsUserRevokedSince reports whether all sessions of the user were revoked at
+// or after issuedAt, which revokes credentials like API keys issued by then.
+func (list *RevocationList) IsUserRevokedSince(username string, issuedAt time.Time) bool {
+	list.mutex.RLock()
+	defer list.mutex.RUnlock()
+	revokedBefore, ok := list.users[username]
+	return ok && !issuedAt.After(revokedBefore)
+}
+
+// RevokeToken revokes a single access token until it expires.
+func (list *RevocationList) RevokeToken(claims *UserClaims) error {
+	expiresAt := time.Unix(claims.ExpiresAt, 0).UTC()
+	if err := list.store.RevokeToken(claims.Id, claims.Username, expiresAt); err != nil {
+		return err
+	}
+
+	list.mutex.Lock()
+	defer list.mutex.Unlock()
+	list.tokens[claims.Id] = expiresAt
+	return nil
+}
+
+// RevokeUser revokes every access token issued to the user so far.
+func (list *RevocationList) RevokeUser(username string) error {
+	now := time.Now().UTC()
+	if err := list.store.RevokeUserTokens(username, now); err != nil {
+		return err
+	}
+
+	list.mutex.Lock()
+	defer list.mutex.Unlock()
+	if now.After(list.users[username]) {
+		list.users[username] = now
+	}
+	return nil
+}
@@ -0,0 +1,272 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"github.com/ranabd36/project-qa/database/store"
+	"github.com/ranabd36/project-qa/pb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"log"
+	"regexp"
+	"strings"
+)
+
+var roleNamePattern = regexp.MustCompile(`^[a-z][a-z0-9_-]{1,49}$`)
+
+type roleStorage interface {
+	ListRoles() ([]*pb.Role, error)
+	FindRole(id int32) (*pb.Role, error)
+	ListPermissions() ([]*pb.Permission, error)
+	SaveRole(role *pb.Role) error
+	UpdateRole(role *pb.Role) error
+	DeleteRole(id int32) error
+	AssignRole(userID int32, roleID int32) error
+	UnassignRole(userID int32, roleID int32) error
+}
+
+type RoleServiceServer struct {
+	roleStore      roleStorage
+	userStore      userStorage
+	revocationList *RevocationList
+	accessPolicy   *AccessPolicy
+}
+
+func NewRoleServiceServer(roleStore roleStorage, userStore userStorage, revocationList *RevocationList, accessPolicy *AccessPolicy) *RoleServiceServer {
+	return &RoleServiceServer{roleStore, userStore, revocationList, accessPolicy}
+}
+
+func (server *RoleServiceServer) ListRoles(ctx context.Context, req *pb.ListRolesRequest) (*pb.ListRolesResponse, error) {
+	roles, err := server.roleStore.ListRoles()
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to list roles")
+	}
+	return &pb.ListRolesResponse{
+		Roles: roles,
+	}, nil
+}
+
+func (server *RoleServiceServer) ListPermissions(ctx context.Context, req *pb.ListPermissionsRequest) (*pb.ListPermissionsResponse, error) {
+	permissions, err := server.roleStore.ListPermissions()
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to list permissions")
+	}
+	return &pb.ListPermissionsResponse{
+		Permissions: permissions,
+	}, nil
+}
+
+func (server *RoleServiceServer) CreateRole(ctx context.Context, req *pb.CreateRoleRequest) (*pb.CreateRoleResponse, error) {
+	role := req.GetRole()
+	if role != nil {
+		role.Name = strings.ToLower(strings.TrimSpace(role.GetName()))
+		role.Description = strings.TrimSpace(role.GetDescription())
+	}
+	if err := server.validateRole(role); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if err := server.validatePermissions(role); err != nil {
+		return nil, err
+	}
+
+	if err := server.roleStore.SaveRole(role); err != nil {
+		if err == store.ErrAlreadyExists {
+			return nil, status.Error(codes.AlreadyExists, "role already exists!")
+		}
+		return nil, status.Error(codes.Internal, "unable to save role")
+	}
+	auditTarget(ctx, "role", role.GetId())
+	auditChange(ctx, nil, auditRole(role))
+	server.reloadPolicy()
+	return &pb.CreateRoleResponse{
+		Id: role.GetId(),
+	}, nil
+}
+
+// UpdateRole replaces the description and the permissions of a role. The
+// admin role always keeps every permission.
+func (server *RoleServiceServer) UpdateRole(ctx context.Context, req *pb.UpdateRoleRequest) (*pb.UpdateRoleResponse, error) {
+	if req.GetRole() == nil {
+		return nil, status.Error(codes.InvalidArgument, "role is required")
+	}
+	roleID := req.GetRole().GetId()
+	if err := server.validateRoleId(roleID); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	auditTarget(ctx, "role", roleID)
+
+	role, err := server.roleStore.FindRole(roleID)
+	if err != nil {
+		return nil, status.Errorf(codes.NotFound, "role not found with ID: %v", roleID)
+	}
+	if role.GetName() == store.AdminRole {
+		return nil, status.Error(codes.FailedPrecondition, "the admin role cannot be changed")
+	}
+
+	before := auditRole(role)
+	role.Description = strings.TrimSpace(req.GetRole().GetDescription())
+	role.Permissions = req.GetRole().GetPermissions()
+	if err := server.validateRole(role); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if err := server.validatePermissions(role); err != nil {
+		return nil, err
+	}
+
+	if err := server.roleStore.UpdateRole(role); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to update role with ID: %v", roleID)
+	}
+	auditChange(ctx, before, auditRole(role))
+	server.reloadPolicy()
+	return &pb.UpdateRoleResponse{
+		IsUpdated: true,
+	}, nil
+}
+
+func (server *RoleServiceServer) DeleteRole(ctx context.Context, req *pb.DeleteRoleRequest) (*pb.DeleteRoleResponse, error) {
+	roleID := req.GetId()
+	if err := server.validateRoleId(roleID); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	auditTarget(ctx, "role", roleID)
+
+	role, err := server.roleStore.FindRole(roleID)
+	if err != nil {
+		return nil, status.Errorf(codes.NotFound, "role not found with ID: %v", roleID)
+	}
+	if role.GetName() == store.AdminRole || role.GetName() == store.DefaultRole {
+		return nil, status.Errorf(codes.FailedPrecondition, "the built-in role %v cannot be deleted", role.GetName())
+	}
+
+	if err := server.roleStore.DeleteRole(roleID); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to delete role with ID: %v", roleID)
+	}
+	auditChange(ctx, auditRole(role), nil)
+	server.reloadPolicy()
+	return &pb.DeleteRoleResponse{
+		IsDeleted: true,
+	}, nil
+}
+
+// AssignRole grants a role to a user. The access tokens of the user are
+// revoked, so the next refreshed token carries the new role.
+func (server *RoleServiceServer) AssignRole(ctx context.Context, req *pb.AssignRoleRequest) (*pb.AssignRoleResponse, error) {
+	auditTarget(ctx, "user", req.GetUserId())
+	user, role, err := server.findUserRole(req.GetUserId(), req.GetRoleId())
+	if err != nil {
+		return nil, err
+	}
+
+	if err := server.roleStore.AssignRole(user.GetId(), role.GetId()); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to assign role with ID: %v", role.GetId())
+	}
+	auditChange(ctx, nil, map[string]string{"role": role.GetName()})
+	if err := server.revocationList.RevokeUser(user.GetUsername()); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to revoke access tokens of user with ID: %v", user.GetId())
+	}
+	return &pb.AssignRoleResponse{
+		IsAssigned: true,
+	}, nil
+}
+
+// UnassignRole takes a role away from a user and revokes their access tokens.
+// The default role cannot be taken away, nor the admin role from the last
+// active admin. Unassigning a role the user does not have succeeds.
+func (server *RoleServiceServer) UnassignRole(ctx context.Context, req *pb.UnassignRoleRequest) (*pb.UnassignRoleResponse, error) {
+	auditTarget(ctx, "user", req.GetUserId())
+	user, role, err := server.findUserRole(req.GetUserId(), req.GetRoleId())
+	if err != nil {
+		return nil, err
+	}
+	if role.GetName() == store.DefaultRole {
+		return nil, status.Error(codes.FailedPrecondition, "the default role cannot be unassigned")
+	}
+
+	if err := server.roleStore.UnassignRole(user.GetId(), role.GetId()); err != nil {
+		if err == store.ErrLastAdmin {
+			return nil, status.Error(codes.FailedPrecondition, "the admin role cannot be unassigned from the last active admin")
+		}
+		return nil, status.Errorf(codes.Internal, "failed to unassign role with ID: %v", role.GetId())
+	}
+	auditChange(ctx, map[string]string{"role": role.GetName()}, nil)
+	if err := server.revocationList.RevokeUser(user.GetUsername()); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to revoke access tokens of user with ID: %v", user.GetId())
+	}
+	return &pb.UnassignRoleResponse{
+		IsUnassigned: true,
+	}, nil
+}
+
+func (server *RoleServiceServer) findUserRole(userID int32, roleID int32) (*pb.User, *pb.Role, error) {
+	if userID <= 0 {
+		return nil, nil, status.Error(codes.InvalidArgument, "invalid user id given")
+	}
+	if err := server.validateRoleId(roleID); err != nil {
+		return nil, nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	user, err := server.userStore.Find(userID)
+	if err != nil {
+		return nil, nil, status.Errorf(codes.NotFound, "user not found with ID: %v", userID)
+	}
+	role, err := server.roleStore.FindRole(roleID)
+	if err != nil {
+		return nil, nil, status.Errorf(codes.NotFound, "role not found with ID: %v", roleID)
+	}
+	return user, role, nil
+}
+
+// reloadPolicy applies changed roles on this instance right away, other
+// instances pick them up on their next reload.
+func (server *RoleServiceServer) reloadPolicy() {
+	if err := server.accessPolicy.Load(); err != nil {
+		log.Printf("failed to reload permissions: %v", err)
+	}
+}
+
+// auditRole returns the role fields recorded in the audit log.
+func auditRole(role *pb.Role) map[string]interface{} {
+	return map[string]interface{}{
+		"name":        role.GetName(),
+		"description": role.GetDescription(),
+		"permissions": role.GetPermissions(),
+	}
+}
+
+func (server *RoleServiceServer) validateRoleId(roleID int32) error {
+	if roleID <= 0 {
+		return errors.New("invalid role id given")
+	}
+	return nil
+}
+
+func (server *RoleServiceServer) validateRole(role *pb.Role) error {
+	if role == nil {
+		return errors.New("role is required")
+	}
+	if !roleNamePattern.MatchString(role.GetName()) {
+		return errors.New("role name must be 2 to 50 lower case letters, digits, dashes or underscores, starting with a letter")
+	}
+	if len(role.GetDescription()) > 255 {
+		return errors.New("role description must be less than or equal to 255 characters.")
+	}
+	return nil
+}
+
+// validatePermissions makes sure every permission of the role exists.
+func (server *RoleServiceServer) validatePermissions(role *pb.Role) error {
+	permissions, err := server.roleStore.ListPermissions()
+	if err != nil {
+		return status.Error(codes.Internal, "failed to list permissions")
+	}
+	known := make(map[string]bool, len(permissions))
+	for _, permission := range permissions {
+		known[permission.GetName()] = true
+	}
+	for _, permission := range role.GetPermissions() {
+		if !known[permission] {
+			return status.Errorf(codes.InvalidArgument, "unknown permission: %v", permission)
+		}
+	}
+	return nil
+}
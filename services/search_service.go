@@ -0,0 +1,123 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"github.com/ranabd36/project-qa/database/store"
+	"github.com/ranabd36/project-qa/pb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"strings"
+)
+
+const (
+	maxSearchQueryLength = 200
+	// maxSearchOffset bounds how deep clients can page, ranked results
+	// cannot use keyset pagination
+	maxSearchOffset = 1000
+)
+
+var searchKinds = map[pb.SearchRequest_Kind]string{
+	pb.SearchRequest_ALL:       "",
+	pb.SearchRequest_QUESTIONS: store.SearchQuestion,
+	pb.SearchRequest_ANSWERS:   store.SearchAnswer,
+}
+
+// Searcher runs full-text searches over published questions and answers. It is
+// implemented by the Postgres store and by search.MemoryIndex.
+type Searcher interface {
+	Search(query store.SearchQuery) ([]*store.SearchResult, error)
+}
+
+type SearchServiceServer struct {
+	searcher Searcher
+}
+
+func NewSearchServiceServer(searcher Searcher) *SearchServiceServer {
+	return &SearchServiceServer{searcher}
+}
+
+func (server *SearchServiceServer) Search(ctx context.Context, req *pb.SearchRequest) (*pb.SearchResponse, error) {
+	query, err := server.searchQuery(req)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	// fetch one extra row to find out whether there is a next page
+	limit := query.Limit
+	query.Limit++
+	results, err := server.searcher.Search(query)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to search")
+	}
+
+	res := &pb.SearchResponse{}
+	if int32(len(results)) > limit {
+		results = results[:limit]
+		if next := query.Offset + limit; next < maxSearchOffset {
+			res.NextPageToken = encodePageToken(pageToken{
+				Sort:  int32(req.GetKind()),
+				Value: query.Text,
+				ID:    int64(next),
+			})
+		}
+	}
+	for _, result := range results {
+		res.Results = append(res.Results, searchResultProto(result))
+	}
+	return res, nil
+}
+
+func (server *SearchServiceServer) searchQuery(req *pb.SearchRequest) (store.SearchQuery, error) {
+	query := store.SearchQuery{
+		Text:     strings.TrimSpace(req.GetQuery()),
+		AuthorID: req.GetAuthorId(),
+		Limit:    pageSize(req.GetPageSize()),
+	}
+	if query.Text == "" {
+		return query, errors.New("query is required")
+	} else if len(query.Text) > maxSearchQueryLength {
+		return query, errors.New("query must be less than or equal to 200 characters.")
+	}
+	kind, ok := searchKinds[req.GetKind()]
+	if !ok {
+		return query, errors.New("invalid kind given")
+	}
+	query.Kind = kind
+	if query.AuthorID < 0 {
+		return query, errors.New("invalid author id given")
+	}
+	tags, err := normalizeTags(req.GetTags())
+	if err != nil {
+		return query, err
+	}
+	query.Tags = tags
+
+	// the token carries the offset of the next page and the query it belongs to
+	cursor, err := decodePageToken(req.GetPageToken(), int32(req.GetKind()), false)
+	if err != nil {
+		return query, err
+	}
+	if cursor != nil {
+		if cursor.Value != query.Text || cursor.ID >= maxSearchOffset {
+			return query, errInvalidPageToken
+		}
+		query.Offset = int32(cursor.ID)
+	}
+	return query, nil
+}
+
+func searchResultProto(result *store.SearchResult) *pb.SearchResult {
+	res := &pb.SearchResult{
+		Id:         result.ID,
+		QuestionId: result.QuestionID,
+		AuthorId:   result.AuthorID,
+		Title:      result.Title,
+		Snippet:    result.Snippet,
+		Rank:       result.Rank,
+	}
+	if result.Kind == store.SearchAnswer {
+		res.Kind = pb.SearchResult_ANSWER
+	}
+	return res
+}
@@ -0,0 +1,159 @@
+package services
+
+import (
+	"crypto/subtle"
+	"database/sql"
+	"encoding/base32"
+	"fmt"
+	"github.com/pquerna/otp"
+	"github.com/pquerna/otp/totp"
+	"github.com/ranabd36/project-qa/database/store"
+	"github.com/ranabd36/project-qa/pb"
+	"strings"
+	"time"
+)
+
+const (
+	totpPeriod        = 30
+	totpSkew          = 1
+	recoveryCodeCount = 10
+)
+
+// totpOpts are the parameters of the codes, the ones authenticator apps assume.
+var totpOpts = totp.ValidateOpts{
+	Period:    totpPeriod,
+	Digits:    otp.DigitsSix,
+	Algorithm: otp.AlgorithmSHA1,
+}
+
+type totpStorage interface {
+	FindTOTP(userID int32) (*store.TOTP, error)
+	SaveTOTPSecret(userID int32, secret string) error
+	EnableTOTP(userID int32, step int64, recoveryCodeHashes []string) error
+	UseTOTPStep(userID int32, step int64) error
+	UseRecoveryCode(userID int32, codeHash string, now time.Time) error
+}
+
+// TOTPManager handles the enrollment and verification of time-based one-time
+// passwords (RFC 6238) used as second login factor.
+type TOTPManager struct {
+	store  totpStorage
+	issuer string
+}
+
+func NewTOTPManager(store totpStorage, issuer string) *TOTPManager {
+	return &TOTPManager{store, issuer}
+}
+
+// Begin starts an enrollment for the user and returns the new secret and the
+// otpauth URI to show as QR code. The enrollment takes effect on Confirm.
+func (manager *TOTPManager) Begin(user *pb.User) (string, string, error) {
+	key, err := totp.Generate(totp.GenerateOpts{
+		Issuer:      manager.issuer,
+		AccountName: user.GetUsername(),
+		Period:      totpPeriod,
+		Digits:      totpOpts.Digits,
+		Algorithm:   totpOpts.Algorithm,
+	})
+	if err != nil {
+		return "", "", err
+	}
+	if err := manager.store.SaveTOTPSecret(user.GetId(), key.Secret()); err != nil {
+		return "", "", err
+	}
+	return key.Secret(), key.URL(), nil
+}
+
+// Confirm enables the pending enrollment when the code matches its secret and
+// returns the recovery codes of the user. It reports false for a wrong code.
+func (manager *TOTPManager) Confirm(userID int32, code string) ([]string, bool, error) {
+	setup, err := manager.store.FindTOTP(userID)
+	if err != nil {
+		return nil, false, err
+	}
+	if setup.Enabled || setup.Secret == "" {
+		return nil, false, fmt.Errorf("no pending TOTP enrollment")
+	}
+
+	step, ok := matchTOTP(setup.Secret, code, time.Now())
+	if !ok {
+		return nil, false, nil
+	}
+
+	codes := make([]string, recoveryCodeCount)
+	hashes := make([]string, recoveryCodeCount)
+	for i := range codes {
+		code, err := newRecoveryCode()
+		if err != nil {
+			return nil, false, err
+		}
+		codes[i] = code
+		hashes[i] = hashToken(normalizeRecoveryCode(code))
+	}
+	if err := manager.store.EnableTOTP(userID, step, hashes); err != nil {
+		return nil, false, err
+	}
+	return codes, true, nil
+}
+
+// Verify checks a TOTP code or, failing that, a recovery code of the user.
+// Every accepted code is used up.
+func (manager *TOTPManager) Verify(userID int32, code string) (bool, error) {
+	setup, err := manager.store.FindTOTP(userID)
+	if err != nil {
+		return false, err
+	}
+	if !setup.Enabled {
+		return false, nil
+	}
+
+	if step, ok := matchTOTP(setup.Secret, code, time.Now()); ok {
+		if err := manager.store.UseTOTPStep(userID, step); err != nil {
+			if err == store.ErrAlreadyUsed {
+				return false, nil
+			}
+			return false, err
+		}
+		return true, nil
+	}
+
+	err = manager.store.UseRecoveryCode(userID, hashToken(normalizeRecoveryCode(code)), time.Now().UTC())
+	if err == sql.ErrNoRows {
+		return false, nil
+	}
+	return err == nil, err
+}
+
+// matchTOTP returns the time step the code belongs to, allowing for a clock
+// skew of one period in both directions.
+func matchTOTP(secret string, code string, now time.Time) (int64, bool) {
+	code = strings.TrimSpace(code)
+	if len(code) != totpOpts.Digits.Length() {
+		return 0, false
+	}
+	current := now.Unix() / totpPeriod
+	for step := current - totpSkew; step <= current+totpSkew; step++ {
+		expected, err := totp.GenerateCodeCustom(secret, time.Unix(step*totpPeriod, 0), totpOpts)
+		if err != nil {
+			return 0, false
+		}
+		if subtle.ConstantTimeCompare([]byte(expected), []byte(code)) == 1 {
+			return step, true
+		}
+	}
+	return 0, false
+}
+
+// newRecoveryCode returns a code like "ABCDE-FGHIJ".
+func newRecoveryCode() (string, error) {
+	data, err := randomBytes(7)
+	if err != nil {
+		return "", err
+	}
+	code := base32.StdEncoding.WithPadding(base32.NoPadding).EncodeToString(data)[:10]
+	return code[:5] + "-" + code[5:], nil
+}
+
+func normalizeRecoveryCode(code string) string {
+	return strings.ToUpper(strings.ReplaceAll(strings.TrimSpace(code), "-", ""))
+}
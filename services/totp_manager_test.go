@@ -0,0 +1,54 @@
+package services
+
+import (
+	"encoding/base32"
+	"testing"
+	"time"
+)
+
+// rfc6238Secret is the SHA1 secret of the test vectors of RFC 6238, appendix B.
+var rfc6238Secret = base32.StdEncoding.EncodeToString([]byte("12345678901234567890"))
+
+func TestMatchTOTP(t *testing.T) {
+	// the RFC lists 8 digit codes, authenticator apps use their last 6 digits
+	tests := []struct {
+		unix int64
+		code string
+	}{
+		{59, "287082"},
+		{1111111109, "081804"},
+		{1111111111, "050471"},
+		{1234567890, "005924"},
+		{2000000000, "279037"},
+	}
+	for _, test := range tests {
+		step, ok := matchTOTP(rfc6238Secret, test.code, time.Unix(test.unix, 0))
+		if !ok {
+			t.Errorf("code %v at %v: not matched", test.code, test.unix)
+			continue
+		}
+		if want := test.unix / totpPeriod; step != want {
+			t.Errorf("code %v at %v: step = %v, want %v", test.code, test.unix, step, want)
+		}
+	}
+}
+
+func TestMatchTOTPSkew(t *testing.T) {
+	// the code of 59 belongs to step 1, accepted one period before and after
+	for _, unix := range []int64{30, 89} {
+		if step, ok := matchTOTP(rfc6238Secret, "287082", time.Unix(unix, 0)); !ok || step != 1 {
+			t.Errorf("at %v: step = %v, ok = %v, want 1, true", unix, step, ok)
+		}
+	}
+	if _, ok := matchTOTP(rfc6238Secret, "287082", time.Unix(120, 0)); ok {
+		t.Error("code matched two periods after its step")
+	}
+}
+
+func TestMatchTOTPRejectsInvalidCodes(t *testing.T) {
+	for _, code := range []string{"", "0", "000000", "28708", "2870820", "94287082"} {
+		if _, ok := matchTOTP(rfc6238Secret, code, time.Unix(59, 0)); ok {
+			t.Errorf("code %q matched", code)
+		}
+	}
+}
@@ -2,13 +2,18 @@ package services
 
 import (
 	"context"
+	"database/sql"
 	"errors"
+	"github.com/golang/protobuf/ptypes"
 	"github.com/ranabd36/project-qa/database/store"
 	"github.com/ranabd36/project-qa/pb"
 	"golang.org/x/crypto/bcrypt"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	"regexp"
+	"strconv"
+	"strings"
+	"time"
 )
 
 type userStorage interface {
@@ -16,19 +21,36 @@ type userStorage interface {
 	Find(id int32) (*pb.User, error)
 	Update(user *pb.User) error
 	Delete(id int32) error
+	Restore(id int32) error
+	Purge(id int32) error
 	UpdatePassword(id int32, newPassword string) error
 	ToggleAdmin(id int32) error
 	ToggleActive(id int32) error
+	SetUserFlags(id int32, isActive *bool, isAdmin *bool, version int32) error
 	FindByUsername(username string) (*pb.User, error)
 	FindByEmail(email string) (*pb.User, error)
+	ListUsers(filter store.UserFilter) ([]*pb.User, error)
+	VerifyEmail(id int32) error
+	DeleteUnverified(id int32) error
 }
 
+// serviceAccountEmailDomain is reserved (RFC 2606), no email is ever delivered to it.
+const serviceAccountEmailDomain = "service-accounts.invalid"
+
+var emailPattern = regexp.MustCompile(
+	"^[a-zA-Z0-9.!#$%&'*+/=?^_`{|}~-]+@[a-zA-Z0-9](?:[a-zA-Z0-9-]{0,61}[a-zA-Z0-9])?(?:\\.[a-zA-Z0-9](?:[a-zA-Z0-9-]{0,61}[a-zA-Z0-9])?)*$",
+)
+
+var errLastAdmin = status.Error(codes.FailedPrecondition, "the last active admin cannot be removed")
+
 type UserServiceServer struct {
-	userStore userStorage
+	userStore      userStorage
+	revocationList *RevocationList
+	passwordPolicy *PasswordPolicy
 }
 
-func NewUserServiceServer(userStore userStorage) *UserServiceServer {
-	return &UserServiceServer{userStore}
+func NewUserServiceServer(userStore userStorage, revocationList *RevocationList, passwordPolicy *PasswordPolicy) *UserServiceServer {
+	return &UserServiceServer{userStore, revocationList, passwordPolicy}
 }
 
 func (server *UserServiceServer) ToggleActive(ctx context.Context, req *pb.ToggleActiveRequest) (*pb.ToggleActiveResponse, error) {
@@ -36,15 +58,27 @@ func (server *UserServiceServer) ToggleActive(ctx context.Context, req *pb.Toggl
 	if err := server.validateUserId(userID); err != nil {
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
+	auditTarget(ctx, "user", userID)
 	
-	_, err := server.userStore.Find(userID)
+	user, err := server.userStore.Find(userID)
 	if err != nil {
 		return nil, status.Errorf(codes.NotFound, "user not found with ID: %v", userID)
 	}
 	
 	if err := server.userStore.ToggleActive(userID); err != nil {
+		if err == store.ErrLastAdmin {
+			return nil, errLastAdmin
+		}
 		return nil, status.Errorf(codes.Internal, "failed to toggle active status with ID: %v", userID)
 	}
+	auditChange(ctx, map[string]bool{"is_active": user.GetIsActive()}, map[string]bool{"is_active": !user.GetIsActive()})
+	
+	// a deactivated user must lose access right away, not when the token expires
+	if user.GetIsActive() {
+		if err := server.revocationList.RevokeUser(user.GetUsername()); err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to revoke access tokens of user with ID: %v", userID)
+		}
+	}
 	return &pb.ToggleActiveResponse{
 		IsUpdated: true,
 	}, nil
@@ -55,27 +89,108 @@ func (server *UserServiceServer) ToggleAdmin(ctx context.Context, req *pb.Toggle
 	if err := server.validateUserId(userID); err != nil {
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
+	auditTarget(ctx, "user", userID)
 	
-	_, err := server.userStore.Find(userID)
+	user, err := server.userStore.Find(userID)
 	if err != nil {
 		return nil, status.Errorf(codes.NotFound, "user not found with ID: %v", userID)
 	}
 	
 	if err := server.userStore.ToggleAdmin(userID); err != nil {
+		if err == store.ErrLastAdmin {
+			return nil, errLastAdmin
+		}
 		return nil, status.Errorf(codes.Internal, "failed to toggle admin status with ID: %v", userID)
 	}
+	auditChange(ctx, map[string]bool{"is_admin": user.GetIsAdmin()}, map[string]bool{"is_admin": !user.GetIsAdmin()})
+	
+	// the roles are part of the access token, the user has to refresh it to get the new ones
+	if err := server.revocationList.RevokeUser(user.GetUsername()); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to revoke access tokens of user with ID: %v", userID)
+	}
 	return &pb.ToggleAdminResponse{
 		IsUpdated: true,
 	}, nil
 }
 
+// SetUserFlags sets the active and admin flags of a user to the given values,
+// so a retried call changes nothing. Callers pass the version of the user they
+// looked at to fail instead of overwriting a concurrent change.
+func (server *UserServiceServer) SetUserFlags(ctx context.Context, req *pb.SetUserFlagsRequest) (*pb.SetUserFlagsResponse, error) {
+	userID := req.GetId()
+	if err := server.validateUserId(userID); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	auditTarget(ctx, "user", userID)
+	if req.GetIsActive() == nil && req.GetIsAdmin() == nil {
+		return nil, status.Error(codes.InvalidArgument, "is active or is admin is required")
+	}
+	if req.GetVersion() < 0 {
+		return nil, status.Error(codes.InvalidArgument, "invalid version given")
+	}
+	if req.GetIsAdmin() != nil && !hasPermission(ctx, PermissionManageRoles) {
+		return nil, status.Error(codes.PermissionDenied, "no permission to change the admin flag")
+	}
+	
+	user, err := server.userStore.Find(userID)
+	if err != nil {
+		return nil, status.Errorf(codes.NotFound, "user not found with ID: %v", userID)
+	}
+	
+	var isActive, isAdmin *bool
+	if req.GetIsActive() != nil {
+		value := req.GetIsActive().GetValue()
+		isActive = &value
+	}
+	if req.GetIsAdmin() != nil {
+		value := req.GetIsAdmin().GetValue()
+		isAdmin = &value
+	}
+	if err := server.userStore.SetUserFlags(userID, isActive, isAdmin, req.GetVersion()); err != nil {
+		switch err {
+		case store.ErrVersionConflict:
+			return nil, status.Errorf(codes.Aborted, "user with ID %v was changed since version %v, reload it and try again", userID, req.GetVersion())
+		case store.ErrLastAdmin:
+			return nil, errLastAdmin
+		case sql.ErrNoRows:
+			return nil, status.Errorf(codes.NotFound, "user not found with ID: %v", userID)
+		}
+		return nil, status.Errorf(codes.Internal, "failed to set flags of user with ID: %v", userID)
+	}
+	
+	updated, err := server.userStore.Find(userID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to load user with ID: %v", userID)
+	}
+	auditChange(ctx,
+		map[string]bool{"is_active": user.GetIsActive(), "is_admin": user.GetIsAdmin()},
+		map[string]bool{"is_active": updated.GetIsActive(), "is_admin": updated.GetIsAdmin()},
+	)
+	
+	// like the toggles, a deactivated user loses access and a changed admin flag needs new tokens
+	if (user.GetIsActive() && !updated.GetIsActive()) || user.GetIsAdmin() != updated.GetIsAdmin() {
+		if err := server.revocationList.RevokeUser(user.GetUsername()); err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to revoke access tokens of user with ID: %v", userID)
+		}
+	}
+	updated.Password = ""
+	return &pb.SetUserFlagsResponse{
+		User: updated,
+	}, nil
+}
+
 func (server *UserServiceServer) ChangePassword(ctx context.Context, req *pb.ChangePasswordRequest) (*pb.ChangePasswordResponse, error) {
 	userID := req.GetId()
 	if err := server.validateUserId(userID); err != nil {
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
+	auditTarget(ctx, "user", userID)
+	
+	if err := authorizeSelfOrAdmin(ctx, userID); err != nil {
+		return nil, err
+	}
 	
-	if err := server.validatePassword(req.GetNewPassword(), req.GetRetypeNewPassword()); err != nil {
+	if err := validatePassword(req.GetNewPassword(), req.GetRetypeNewPassword()); err != nil {
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
 	
@@ -88,6 +203,10 @@ func (server *UserServiceServer) ChangePassword(ctx context.Context, req *pb.Cha
 		return nil, status.Error(codes.InvalidArgument, "current password does not match")
 	}
 	
+	if err := server.passwordPolicy.Validate("new_password", req.GetNewPassword(), user.GetUsername(), user.GetEmail()); err != nil {
+		return nil, err
+	}
+	
 	if err := server.userStore.UpdatePassword(userID, req.GetNewPassword()); err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to change user password with ID: %v", userID)
 	}
@@ -97,35 +216,86 @@ func (server *UserServiceServer) ChangePassword(ctx context.Context, req *pb.Cha
 	}, nil
 }
 
+// DeleteUser marks the user as deleted and signs them out. Until the user is
+// purged, their username and email stay taken so RestoreUser can bring them back.
 func (server *UserServiceServer) DeleteUser(ctx context.Context, req *pb.DeleteUserRequest) (*pb.DeleteUserResponse, error) {
 	userID := req.GetId()
 	if err := server.validateUserId(userID); err != nil {
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
+	auditTarget(ctx, "user", userID)
 	
-	_, err := server.userStore.Find(userID)
+	user, err := server.userStore.Find(userID)
 	if err != nil {
 		return nil, status.Errorf(codes.NotFound, "user not found with ID: %v", userID)
 	}
 	
 	if err := server.userStore.Delete(userID); err != nil {
+		if err == store.ErrLastAdmin {
+			return nil, errLastAdmin
+		}
 		return nil, status.Errorf(codes.Internal, "failed to delete user with ID: %v", userID)
 	}
+	auditChange(ctx, auditUser(user), nil)
+	
+	if err := server.revocationList.RevokeUser(user.GetUsername()); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to revoke access tokens of user with ID: %v", userID)
+	}
 	
 	return &pb.DeleteUserResponse{
 		IsDeleted: true,
 	}, nil
 }
 
+// RestoreUser brings back a deleted user. Purged users cannot be restored.
+func (server *UserServiceServer) RestoreUser(ctx context.Context, req *pb.RestoreUserRequest) (*pb.RestoreUserResponse, error) {
+	userID := req.GetId()
+	if err := server.validateUserId(userID); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	auditTarget(ctx, "user", userID)
+	
+	if err := server.userStore.Restore(userID); err != nil {
+		return nil, status.Errorf(codes.NotFound, "deleted user not found with ID: %v", userID)
+	}
+	
+	return &pb.RestoreUserResponse{
+		IsRestored: true,
+	}, nil
+}
+
+// PurgeUser anonymizes the personal data of a deleted user. Their questions
+// and answers are kept and shown as authored by a deleted user.
+func (server *UserServiceServer) PurgeUser(ctx context.Context, req *pb.PurgeUserRequest) (*pb.PurgeUserResponse, error) {
+	userID := req.GetId()
+	if err := server.validateUserId(userID); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	auditTarget(ctx, "user", userID)
+	
+	if err := server.userStore.Purge(userID); err != nil {
+		return nil, status.Errorf(codes.NotFound, "deleted user not found with ID: %v", userID)
+	}
+	
+	return &pb.PurgeUserResponse{
+		IsPurged: true,
+	}, nil
+}
+
 func (server *UserServiceServer) UpdateUser(ctx context.Context, req *pb.UpdateUserRequest) (*pb.UpdateUserResponse, error) {
 	userID := req.User.GetId()
 	if err := server.validateUserId(userID); err != nil {
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
-	if err := server.validateUser(req.User); err != nil {
+	auditTarget(ctx, "user", userID)
+	if err := authorizeSelfOrAdmin(ctx, userID); err != nil {
+		return nil, err
+	}
+	normalizeUser(req.User)
+	if err := validateUser(req.User); err != nil {
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
-	_, err := server.userStore.Find(userID)
+	user, err := server.userStore.Find(userID)
 	if err != nil {
 		return nil, status.Errorf(codes.NotFound, "user not found with ID: %v", userID)
 	}
@@ -133,6 +303,7 @@ func (server *UserServiceServer) UpdateUser(ctx context.Context, req *pb.UpdateU
 	if err := server.userStore.Update(req.User); err != nil {
 		return nil, status.Error(codes.Internal, "failed to update user")
 	}
+	auditChange(ctx, auditUser(user), auditUser(req.User))
 	
 	return &pb.UpdateUserResponse{
 		IsUpdated: true,
@@ -144,6 +315,9 @@ func (server *UserServiceServer) FindUser(ctx context.Context, req *pb.FindUserR
 	if err := server.validateUserId(userID); err != nil {
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
+	if err := authorizeSelfOrAdmin(ctx, userID); err != nil {
+		return nil, err
+	}
 	user, err := server.userStore.Find(userID)
 	if err != nil {
 		return nil, status.Errorf(codes.NotFound, "user not found with ID: %v", userID)
@@ -154,37 +328,202 @@ func (server *UserServiceServer) FindUser(ctx context.Context, req *pb.FindUserR
 }
 
 func (server *UserServiceServer) CreateUser(ctx context.Context, req *pb.CreateUserRequest) (*pb.CreateUserResponse, error) {
-	if err := server.validateUser(req.User); err != nil {
+	normalizeUser(req.User)
+	if err := validateUser(req.User); err != nil {
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
 	
 	user := req.GetUser()
+	if err := server.passwordPolicy.Validate("password", user.GetPassword(), user.GetUsername(), user.GetEmail()); err != nil {
+		return nil, err
+	}
 	if err := server.userStore.Save(user); err != nil {
 		if err == store.ErrAlreadyExists {
 			return nil, status.Error(codes.AlreadyExists, "user already exists!")
 		}
 		return nil, status.Error(codes.Internal, "unable to save user")
 	}
+	auditTarget(ctx, "user", user.GetId())
+	auditChange(ctx, nil, auditUser(user))
 	return &pb.CreateUserResponse{
 		Id: user.GetId(),
 	}, nil
 }
 
-func (server *UserServiceServer) validatePassword(newPassword string, retypeNewPassword string) error {
-	err := ""
-	if len(newPassword) < 6 {
-		err = "new password must at least 6 characters longs."
-	} else if len(newPassword) > 12 {
-		err = "new password must be less than or equal to 12 characters."
-	} else if len(newPassword) != len(retypeNewPassword) {
-		err = "new password does not match with retype new password."
+// CreateServiceAccount creates a user for a machine client. It gets a random
+// password nobody knows and an unroutable email, so it can only authenticate
+// with API keys.
+func (server *UserServiceServer) CreateServiceAccount(ctx context.Context, req *pb.CreateServiceAccountRequest) (*pb.CreateServiceAccountResponse, error) {
+	user := &pb.User{
+		FirstName:        req.GetName(),
+		Username:         req.GetUsername(),
+		IsActive:         true,
+		IsEmailVerified:  true,
+		IsServiceAccount: true,
 	}
-	if len(err) > 0 {
-		return errors.New(err)
+	normalizeUser(user)
+	user.Email = strings.ToLower(user.GetUsername()) + "@" + serviceAccountEmailDomain
+	if user.GetUsername() == "" {
+		return nil, status.Error(codes.InvalidArgument, "username is required")
+	} else if len(user.GetUsername()) > 20 {
+		return nil, status.Error(codes.InvalidArgument, "username must be less than or equal to 20 characters.")
+	} else if !emailPattern.MatchString(user.GetEmail()) {
+		return nil, status.Error(codes.InvalidArgument, "username may only contain the characters allowed in email addresses")
+	} else if user.GetFirstName() == "" {
+		return nil, status.Error(codes.InvalidArgument, "name is required")
+	} else if len(user.GetFirstName()) > 20 {
+		return nil, status.Error(codes.InvalidArgument, "name must be less than or equal to 20 characters.")
+	}
+	
+	password, err := randomToken(32)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to generate password")
+	}
+	user.Password = password
+	if err := server.userStore.Save(user); err != nil {
+		if err == store.ErrAlreadyExists {
+			return nil, status.Error(codes.AlreadyExists, "user already exists!")
+		}
+		return nil, status.Error(codes.Internal, "unable to save user")
+	}
+	auditTarget(ctx, "user", user.GetId())
+	auditChange(ctx, nil, auditUser(user))
+	return &pb.CreateServiceAccountResponse{
+		Id: user.GetId(),
+	}, nil
+}
+
+func (server *UserServiceServer) ListUsers(ctx context.Context, req *pb.ListUsersRequest) (*pb.ListUsersResponse, error) {
+	filter, err := server.userFilter(req)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	// fetch one extra row to find out whether there is a next page
+	limit := filter.Limit
+	filter.Limit++
+	users, err := server.userStore.ListUsers(filter)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to list users")
+	}
+
+	res := &pb.ListUsersResponse{}
+	if int32(len(users)) > limit {
+		users = users[:limit]
+		res.NextPageToken = server.nextPageToken(req, filter, users[len(users)-1])
+	}
+	for _, user := range users {
+		user.Password = ""
+	}
+	res.Users = users
+	return res, nil
+}
+
+func (server *UserServiceServer) userFilter(req *pb.ListUsersRequest) (store.UserFilter, error) {
+	filter := store.UserFilter{
+		UsernamePrefix: req.GetUsernamePrefix(),
+		EmailPrefix:    req.GetEmailPrefix(),
+		SortBy:         store.UserSortField(req.GetSortBy()),
+		Descending:     req.GetDescending(),
+		Deleted:        req.GetDeleted(),
+		Limit:          pageSize(req.GetPageSize()),
+	}
+	if _, ok := pb.ListUsersRequest_SortBy_name[int32(req.GetSortBy())]; !ok {
+		return filter, errors.New("invalid sort field given")
+	}
+	if req.GetIsActive() != nil {
+		isActive := req.GetIsActive().GetValue()
+		filter.IsActive = &isActive
+	}
+	if req.GetIsAdmin() != nil {
+		isAdmin := req.GetIsAdmin().GetValue()
+		filter.IsAdmin = &isAdmin
+	}
+	if req.GetCreatedAfter() != nil {
+		createdAfter, err := ptypes.Timestamp(req.GetCreatedAfter())
+		if err != nil {
+			return filter, errors.New("invalid created after given")
+		}
+		filter.CreatedAfter = createdAfter
+	}
+	if req.GetCreatedBefore() != nil {
+		createdBefore, err := ptypes.Timestamp(req.GetCreatedBefore())
+		if err != nil {
+			return filter, errors.New("invalid created before given")
+		}
+		filter.CreatedBefore = createdBefore
+	}
+
+	after, err := decodeFilteredPageToken(req.GetPageToken(), int32(req.GetSortBy()), req.GetDescending(), userFilterKey(filter))
+	if err != nil {
+		return filter, err
+	}
+	filter.After = after
+	return filter, nil
+}
+
+func (server *UserServiceServer) nextPageToken(req *pb.ListUsersRequest, filter store.UserFilter, last *pb.User) string {
+	token := pageToken{
+		Sort:   int32(req.GetSortBy()),
+		Desc:   req.GetDescending(),
+		Filter: userFilterKey(filter),
+		ID:     int64(last.GetId()),
+	}
+	switch req.GetSortBy() {
+	case pb.ListUsersRequest_CREATED_AT:
+		createdAt, _ := ptypes.Timestamp(last.GetCreatedAt())
+		token.Value = createdAt.UTC().Format(store.CursorTimeLayout)
+	case pb.ListUsersRequest_USERNAME:
+		// usernames are ordered regardless of their case
+		token.Value = strings.ToLower(last.GetUsername())
+	case pb.ListUsersRequest_EMAIL:
+		token.Value = last.GetEmail()
+	}
+	return encodePageToken(token)
+}
+
+// userFilterKey identifies the users selected by the filter, so page tokens
+// cannot be replayed against another listing.
+func userFilterKey(filter store.UserFilter) string {
+	optional := func(value *bool) string {
+		if value == nil {
+			return ""
+		}
+		return strconv.FormatBool(*value)
+	}
+	return filterKey(
+		optional(filter.IsActive),
+		optional(filter.IsAdmin),
+		strings.ToLower(filter.UsernamePrefix),
+		strings.ToLower(filter.EmailPrefix),
+		filter.CreatedAfter.UTC().Format(time.RFC3339Nano),
+		filter.CreatedBefore.UTC().Format(time.RFC3339Nano),
+		filter.Deleted,
+	)
+}
+
+// validatePassword checks the new password was typed the same way twice.
+// Whether the password itself is acceptable is decided by the PasswordPolicy.
+func validatePassword(newPassword string, retypeNewPassword string) error {
+	if newPassword == "" {
+		return errors.New("new password is required")
+	}
+	if newPassword != retypeNewPassword {
+		return errors.New("new password does not match with retype new password.")
 	}
 	return nil
 }
 
+// auditUser returns the user fields recorded in the audit log, without the password.
+func auditUser(user *pb.User) map[string]interface{} {
+	return map[string]interface{}{
+		"first_name": user.GetFirstName(),
+		"last_name":  user.GetLastName(),
+		"username":   user.GetUsername(),
+		"email":      user.GetEmail(),
+	}
+}
+
 func (server *UserServiceServer) validateUserId(userID int32) error {
 	if userID <= 0 {
 		return errors.New("invalid user id given")
@@ -192,12 +531,20 @@ func (server *UserServiceServer) validateUserId(userID int32) error {
 	return nil
 }
 
-func (server *UserServiceServer) validateUser(user *pb.User) error {
+// normalizeUser trims the user fields and lower-cases the email, so the same
+// person cannot sign up twice by typing their email differently.
+func normalizeUser(user *pb.User) {
+	if user == nil {
+		return
+	}
+	user.FirstName = strings.TrimSpace(user.GetFirstName())
+	user.LastName = strings.TrimSpace(user.GetLastName())
+	user.Username = strings.TrimSpace(user.GetUsername())
+	user.Email = strings.ToLower(strings.TrimSpace(user.GetEmail()))
+}
+
+func validateUser(user *pb.User) error {
 	err := ""
-	regx := regexp.MustCompile(
-		"^[a-zA-Z0-9.!#$%&'*+/=?^_`{|}~-]+@[a-zA-Z0-9](?:[a-zA-Z0-9-]{0,61}[a-zA-Z0-9])?(?:\\.[a-zA-Z0-9](?:[a-zA-Z0-9-]{0,61}[a-zA-Z0-9])?)*$",
-	)
-	
 	if user == nil {
 		err = "user is required"
 	} else if user.GetFirstName() == "" {
@@ -208,12 +555,8 @@ func (server *UserServiceServer) validateUser(user *pb.User) error {
 		err = "username is required"
 	} else if user.GetEmail() == "" {
 		err = "email is required"
-	} else if !regx.MatchString(user.GetEmail()) {
+	} else if !emailPattern.MatchString(user.GetEmail()) {
 		err = "invalid email address"
-	} else if len(user.GetPassword()) < 6 {
-		err = "password must at least 6 characters longs."
-	} else if len(user.GetPassword()) > 12 {
-		err = "password must be less than or equal to 12 characters."
 	}
 	
 	if len(err) > 0 {
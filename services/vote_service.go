@@ -0,0 +1,154 @@
+package services
+
+import (
+	"context"
+	"database/sql"
+	"github.com/ranabd36/project-qa/database/store"
+	"github.com/ranabd36/project-qa/pb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type voteStorage interface {
+	SaveVote(vote *store.Vote) error
+	DeleteVote(vote *store.Vote) error
+}
+
+type VoteServiceServer struct {
+	voteStore     voteStorage
+	questionStore questionStorage
+	answerStore   answerStorage
+	userStore     userStorage
+}
+
+func NewVoteServiceServer(voteStore voteStorage, questionStore questionStorage, answerStore answerStorage, userStore userStorage) *VoteServiceServer {
+	return &VoteServiceServer{voteStore, questionStore, answerStore, userStore}
+}
+
+// Vote casts an up or down vote of the current user on a published question
+// or an answer to one. Users cannot vote on their own posts.
+func (server *VoteServiceServer) Vote(ctx context.Context, req *pb.VoteRequest) (*pb.VoteResponse, error) {
+	if req.GetTargetId() <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "invalid target id given")
+	}
+	if req.GetDirection() != pb.VoteRequest_UP && req.GetDirection() != pb.VoteRequest_DOWN {
+		return nil, status.Error(codes.InvalidArgument, "invalid direction given")
+	}
+
+	user, err := currentUser(ctx, server.userStore)
+	if err != nil {
+		return nil, err
+	}
+
+	vote, authorID, err := server.findTarget(user, req.GetTarget(), req.GetTargetId())
+	if err != nil {
+		return nil, err
+	}
+	if authorID == user.GetId() {
+		return nil, status.Error(codes.FailedPrecondition, "users cannot vote on their own posts")
+	}
+
+	vote.Value = 1
+	if req.GetDirection() == pb.VoteRequest_DOWN {
+		vote.Value = -1
+	}
+	if err := server.voteStore.SaveVote(vote); err != nil {
+		if err == store.ErrAlreadyExists {
+			return nil, status.Error(codes.Aborted, "vote was cast concurrently, try again")
+		}
+		if err == sql.ErrNoRows {
+			return nil, status.Errorf(codes.NotFound, "%v not found with ID: %v", targetName(req.GetTarget()), req.GetTargetId())
+		}
+		return nil, status.Error(codes.Internal, "unable to save vote")
+	}
+
+	score, err := server.score(req.GetTarget(), req.GetTargetId())
+	if err != nil {
+		return nil, err
+	}
+	return &pb.VoteResponse{
+		Score: score,
+	}, nil
+}
+
+// RetractVote removes the vote of the current user from the target.
+func (server *VoteServiceServer) RetractVote(ctx context.Context, req *pb.RetractVoteRequest) (*pb.RetractVoteResponse, error) {
+	if req.GetTargetId() <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "invalid target id given")
+	}
+
+	user, err := currentUser(ctx, server.userStore)
+	if err != nil {
+		return nil, err
+	}
+
+	vote, _, err := server.findTarget(user, req.GetTarget(), req.GetTargetId())
+	if err != nil {
+		return nil, err
+	}
+	if err := server.voteStore.DeleteVote(vote); err != nil {
+		if err == sql.ErrNoRows {
+			return nil, status.Errorf(codes.NotFound, "vote not found on %v with ID: %v", targetName(req.GetTarget()), req.GetTargetId())
+		}
+		return nil, status.Error(codes.Internal, "unable to retract vote")
+	}
+
+	score, err := server.score(req.GetTarget(), req.GetTargetId())
+	if err != nil {
+		return nil, err
+	}
+	return &pb.RetractVoteResponse{
+		Score: score,
+	}, nil
+}
+
+// findTarget returns the vote of the user on the target and the author of the
+// target. Only published questions and their answers can be voted on.
+func (server *VoteServiceServer) findTarget(user *pb.User, target pb.VoteTarget, targetID int32) (*store.Vote, int32, error) {
+	notFound := status.Errorf(codes.NotFound, "%v not found with ID: %v", targetName(target), targetID)
+	vote := &store.Vote{UserID: user.GetId()}
+
+	switch target {
+	case pb.VoteTarget_QUESTION:
+		question, err := server.questionStore.FindQuestion(targetID)
+		if err != nil || question.GetPublishedAt() == nil {
+			return nil, 0, notFound
+		}
+		vote.QuestionID = targetID
+		return vote, question.GetUserId(), nil
+	case pb.VoteTarget_ANSWER:
+		answer, err := server.answerStore.FindAnswer(targetID)
+		if err != nil {
+			return nil, 0, notFound
+		}
+		question, err := server.questionStore.FindQuestion(answer.GetQuestionId())
+		if err != nil || question.GetPublishedAt() == nil {
+			return nil, 0, notFound
+		}
+		vote.AnswerID = targetID
+		return vote, answer.GetUserId(), nil
+	}
+	return nil, 0, status.Error(codes.InvalidArgument, "invalid target given")
+}
+
+func (server *VoteServiceServer) score(target pb.VoteTarget, targetID int32) (int32, error) {
+	if target == pb.VoteTarget_ANSWER {
+		answer, err := server.answerStore.FindAnswer(targetID)
+		if err != nil {
+			return 0, status.Errorf(codes.NotFound, "answer not found with ID: %v", targetID)
+		}
+		return answer.GetScore(), nil
+	}
+	question, err := server.questionStore.FindQuestion(targetID)
+	if err != nil {
+		return 0, status.Errorf(codes.NotFound, "question not found with ID: %v", targetID)
+	}
+	return question.GetScore(), nil
+}
+
+func targetName(target pb.VoteTarget) string {
+	if target == pb.VoteTarget_ANSWER {
+		return "answer"
+	}
+	return "question"
+}